@@ -10,6 +10,7 @@ package sdk
 
 import (
 	// EXISTING_CODE
+	"context"
 
 	"encoding/json"
 
@@ -38,6 +39,12 @@ func (opts *AbisOptions) Abis() ([]types.Function, *types.MetaData, error) {
 	return queryAbis[types.Function](in)
 }
 
+// AbisStream is like Abis, but delivers each item as it is produced.
+func (opts *AbisOptions) AbisStream(ctx context.Context) Seq2[types.Function] {
+	in := opts.toInternal()
+	return streamAbis[types.Function](ctx, in)
+}
+
 // AbisFind implements the chifra abis --find command.
 func (opts *AbisOptions) AbisFind(val []string) ([]types.Function, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -45,6 +52,13 @@ func (opts *AbisOptions) AbisFind(val []string) ([]types.Function, *types.MetaDa
 	return queryAbis[types.Function](in)
 }
 
+// AbisFindStream is like AbisFind, but delivers each item as it is produced.
+func (opts *AbisOptions) AbisFindStream(ctx context.Context, val []string) Seq2[types.Function] {
+	in := opts.toInternal()
+	in.Find = val
+	return streamAbis[types.Function](ctx, in)
+}
+
 // AbisEncode implements the chifra abis --encode command.
 func (opts *AbisOptions) AbisEncode(val string) ([]types.Function, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -52,6 +66,13 @@ func (opts *AbisOptions) AbisEncode(val string) ([]types.Function, *types.MetaDa
 	return queryAbis[types.Function](in)
 }
 
+// AbisEncodeStream is like AbisEncode, but delivers each item as it is produced.
+func (opts *AbisOptions) AbisEncodeStream(ctx context.Context, val string) Seq2[types.Function] {
+	in := opts.toInternal()
+	in.Encode = val
+	return streamAbis[types.Function](ctx, in)
+}
+
 // No enums
 // EXISTING_CODE
 // EXISTING_CODE
@@ -11,6 +11,7 @@ package sdk
 import (
 	// EXISTING_CODE
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -103,6 +104,13 @@ func queryAbis[T abisGeneric](opts *abisOptionsInternal) ([]T, *types.MetaData,
 	}
 }
 
+func streamAbis[T abisGeneric](ctx context.Context, opts *abisOptionsInternal) Seq2[T] {
+	// EXISTING_CODE
+	// EXISTING_CODE
+
+	return streamModels[T](ctx, opts.AbisBytes)
+}
+
 // toInternal converts the SDK options to the internal options format.
 func (opts *AbisOptions) toInternal() *abisOptionsInternal {
 	return &abisOptionsInternal{
@@ -10,6 +10,7 @@ package sdk
 
 import (
 	// EXISTING_CODE
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -41,6 +42,12 @@ func (opts *BlocksOptions) Blocks() ([]types.Block, *types.MetaData, error) {
 	return queryBlocks[types.Block](in)
 }
 
+// BlocksStream is like Blocks, but delivers each item as it is produced.
+func (opts *BlocksOptions) BlocksStream(ctx context.Context) Seq2[types.Block] {
+	in := opts.toInternal()
+	return streamBlocks[types.Block](ctx, in)
+}
+
 // BlocksHashes implements the chifra blocks --hashes command.
 func (opts *BlocksOptions) BlocksHashes() ([]types.LightBlock, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -48,6 +55,13 @@ func (opts *BlocksOptions) BlocksHashes() ([]types.LightBlock, *types.MetaData,
 	return queryBlocks[types.LightBlock](in)
 }
 
+// BlocksHashesStream is like BlocksHashes, but delivers each item as it is produced.
+func (opts *BlocksOptions) BlocksHashesStream(ctx context.Context) Seq2[types.LightBlock] {
+	in := opts.toInternal()
+	in.Hashes = true
+	return streamBlocks[types.LightBlock](ctx, in)
+}
+
 // BlocksUncles implements the chifra blocks --uncles command.
 func (opts *BlocksOptions) BlocksUncles() ([]types.LightBlock, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -55,6 +69,13 @@ func (opts *BlocksOptions) BlocksUncles() ([]types.LightBlock, *types.MetaData,
 	return queryBlocks[types.LightBlock](in)
 }
 
+// BlocksUnclesStream is like BlocksUncles, but delivers each item as it is produced.
+func (opts *BlocksOptions) BlocksUnclesStream(ctx context.Context) Seq2[types.LightBlock] {
+	in := opts.toInternal()
+	in.Uncles = true
+	return streamBlocks[types.LightBlock](ctx, in)
+}
+
 // BlocksTraces implements the chifra blocks --traces command.
 func (opts *BlocksOptions) BlocksTraces() ([]types.Trace, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -62,6 +83,13 @@ func (opts *BlocksOptions) BlocksTraces() ([]types.Trace, *types.MetaData, error
 	return queryBlocks[types.Trace](in)
 }
 
+// BlocksTracesStream is like BlocksTraces, but delivers each item as it is produced.
+func (opts *BlocksOptions) BlocksTracesStream(ctx context.Context) Seq2[types.Trace] {
+	in := opts.toInternal()
+	in.Traces = true
+	return streamBlocks[types.Trace](ctx, in)
+}
+
 // BlocksUniq implements the chifra blocks --uniq command.
 func (opts *BlocksOptions) BlocksUniq() ([]types.Appearance, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -69,6 +97,13 @@ func (opts *BlocksOptions) BlocksUniq() ([]types.Appearance, *types.MetaData, er
 	return queryBlocks[types.Appearance](in)
 }
 
+// BlocksUniqStream is like BlocksUniq, but delivers each item as it is produced.
+func (opts *BlocksOptions) BlocksUniqStream(ctx context.Context) Seq2[types.Appearance] {
+	in := opts.toInternal()
+	in.Uniq = true
+	return streamBlocks[types.Appearance](ctx, in)
+}
+
 // BlocksLogs implements the chifra blocks --logs command.
 func (opts *BlocksOptions) BlocksLogs() ([]types.Log, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -76,6 +111,13 @@ func (opts *BlocksOptions) BlocksLogs() ([]types.Log, *types.MetaData, error) {
 	return queryBlocks[types.Log](in)
 }
 
+// BlocksLogsStream is like BlocksLogs, but delivers each item as it is produced.
+func (opts *BlocksOptions) BlocksLogsStream(ctx context.Context) Seq2[types.Log] {
+	in := opts.toInternal()
+	in.Logs = true
+	return streamBlocks[types.Log](ctx, in)
+}
+
 // BlocksWithdrawals implements the chifra blocks --withdrawals command.
 func (opts *BlocksOptions) BlocksWithdrawals() ([]types.Withdrawal, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -83,6 +125,13 @@ func (opts *BlocksOptions) BlocksWithdrawals() ([]types.Withdrawal, *types.MetaD
 	return queryBlocks[types.Withdrawal](in)
 }
 
+// BlocksWithdrawalsStream is like BlocksWithdrawals, but delivers each item as it is produced.
+func (opts *BlocksOptions) BlocksWithdrawalsStream(ctx context.Context) Seq2[types.Withdrawal] {
+	in := opts.toInternal()
+	in.Withdrawals = true
+	return streamBlocks[types.Withdrawal](ctx, in)
+}
+
 // BlocksCount implements the chifra blocks --count command.
 func (opts *BlocksOptions) BlocksCount() ([]types.BlockCount, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -90,6 +139,13 @@ func (opts *BlocksOptions) BlocksCount() ([]types.BlockCount, *types.MetaData, e
 	return queryBlocks[types.BlockCount](in)
 }
 
+// BlocksCountStream is like BlocksCount, but delivers each item as it is produced.
+func (opts *BlocksOptions) BlocksCountStream(ctx context.Context) Seq2[types.BlockCount] {
+	in := opts.toInternal()
+	in.Count = true
+	return streamBlocks[types.BlockCount](ctx, in)
+}
+
 type BlocksFlow int
 
 const (
@@ -11,6 +11,7 @@ package sdk
 import (
 	// EXISTING_CODE
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -123,6 +124,13 @@ func queryBlocks[T blocksGeneric](opts *blocksOptionsInternal) ([]T, *types.Meta
 	}
 }
 
+func streamBlocks[T blocksGeneric](ctx context.Context, opts *blocksOptionsInternal) Seq2[T] {
+	// EXISTING_CODE
+	// EXISTING_CODE
+
+	return streamModels[T](ctx, opts.BlocksBytes)
+}
+
 // toInternal converts the SDK options to the internal options format.
 func (opts *BlocksOptions) toInternal() *blocksOptionsInternal {
 	return &blocksOptionsInternal{
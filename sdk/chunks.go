@@ -10,6 +10,7 @@ package sdk
 
 import (
 	// EXISTING_CODE
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -52,6 +53,13 @@ func (opts *ChunksOptions) ChunksManifest() ([]types.ChunkManifest, *types.MetaD
 	return queryChunks[types.ChunkManifest](in)
 }
 
+// ChunksManifestStream is like ChunksManifest, but delivers each item as it is produced.
+func (opts *ChunksOptions) ChunksManifestStream(ctx context.Context) Seq2[types.ChunkManifest] {
+	in := opts.toInternal()
+	in.Mode = CMManifest
+	return streamChunks[types.ChunkManifest](ctx, in)
+}
+
 // ChunksIndex implements the chifra chunks index command.
 func (opts *ChunksOptions) ChunksIndex() ([]types.ChunkIndex, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -59,6 +67,13 @@ func (opts *ChunksOptions) ChunksIndex() ([]types.ChunkIndex, *types.MetaData, e
 	return queryChunks[types.ChunkIndex](in)
 }
 
+// ChunksIndexStream is like ChunksIndex, but delivers each item as it is produced.
+func (opts *ChunksOptions) ChunksIndexStream(ctx context.Context) Seq2[types.ChunkIndex] {
+	in := opts.toInternal()
+	in.Mode = CMIndex
+	return streamChunks[types.ChunkIndex](ctx, in)
+}
+
 // ChunksBlooms implements the chifra chunks blooms command.
 func (opts *ChunksOptions) ChunksBlooms() ([]types.ChunkBloom, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -66,6 +81,13 @@ func (opts *ChunksOptions) ChunksBlooms() ([]types.ChunkBloom, *types.MetaData,
 	return queryChunks[types.ChunkBloom](in)
 }
 
+// ChunksBloomsStream is like ChunksBlooms, but delivers each item as it is produced.
+func (opts *ChunksOptions) ChunksBloomsStream(ctx context.Context) Seq2[types.ChunkBloom] {
+	in := opts.toInternal()
+	in.Mode = CMBlooms
+	return streamChunks[types.ChunkBloom](ctx, in)
+}
+
 // ChunksPins implements the chifra chunks pins command.
 func (opts *ChunksOptions) ChunksPins() ([]types.ChunkPin, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -73,6 +95,13 @@ func (opts *ChunksOptions) ChunksPins() ([]types.ChunkPin, *types.MetaData, erro
 	return queryChunks[types.ChunkPin](in)
 }
 
+// ChunksPinsStream is like ChunksPins, but delivers each item as it is produced.
+func (opts *ChunksOptions) ChunksPinsStream(ctx context.Context) Seq2[types.ChunkPin] {
+	in := opts.toInternal()
+	in.Mode = CMPins
+	return streamChunks[types.ChunkPin](ctx, in)
+}
+
 // ChunksAddresses implements the chifra chunks addresses command.
 func (opts *ChunksOptions) ChunksAddresses() ([]types.ChunkAddress, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -80,6 +109,13 @@ func (opts *ChunksOptions) ChunksAddresses() ([]types.ChunkAddress, *types.MetaD
 	return queryChunks[types.ChunkAddress](in)
 }
 
+// ChunksAddressesStream is like ChunksAddresses, but delivers each item as it is produced.
+func (opts *ChunksOptions) ChunksAddressesStream(ctx context.Context) Seq2[types.ChunkAddress] {
+	in := opts.toInternal()
+	in.Mode = CMAddresses
+	return streamChunks[types.ChunkAddress](ctx, in)
+}
+
 // ChunksAppearances implements the chifra chunks appearances command.
 func (opts *ChunksOptions) ChunksAppearances() ([]types.ChunkAppearance, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -87,6 +123,13 @@ func (opts *ChunksOptions) ChunksAppearances() ([]types.ChunkAppearance, *types.
 	return queryChunks[types.ChunkAppearance](in)
 }
 
+// ChunksAppearancesStream is like ChunksAppearances, but delivers each item as it is produced.
+func (opts *ChunksOptions) ChunksAppearancesStream(ctx context.Context) Seq2[types.ChunkAppearance] {
+	in := opts.toInternal()
+	in.Mode = CMAppearances
+	return streamChunks[types.ChunkAppearance](ctx, in)
+}
+
 // ChunksStats implements the chifra chunks stats command.
 func (opts *ChunksOptions) ChunksStats() ([]types.ChunkStats, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -94,6 +137,13 @@ func (opts *ChunksOptions) ChunksStats() ([]types.ChunkStats, *types.MetaData, e
 	return queryChunks[types.ChunkStats](in)
 }
 
+// ChunksStatsStream is like ChunksStats, but delivers each item as it is produced.
+func (opts *ChunksOptions) ChunksStatsStream(ctx context.Context) Seq2[types.ChunkStats] {
+	in := opts.toInternal()
+	in.Mode = CMStats
+	return streamChunks[types.ChunkStats](ctx, in)
+}
+
 // ChunksTruncate implements the chifra chunks --truncate command.
 func (opts *ChunksOptions) ChunksTruncate(val base.Blknum) ([]types.Message, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -101,6 +151,27 @@ func (opts *ChunksOptions) ChunksTruncate(val base.Blknum) ([]types.Message, *ty
 	return queryChunks[types.Message](in)
 }
 
+// ChunksTruncateStream is like ChunksTruncate, but delivers each item as it is produced.
+func (opts *ChunksOptions) ChunksTruncateStream(ctx context.Context, val base.Blknum) Seq2[types.Message] {
+	in := opts.toInternal()
+	in.Truncate = val
+	return streamChunks[types.Message](ctx, in)
+}
+
+// ChunksShard implements the chifra chunks --shard command.
+func (opts *ChunksOptions) ChunksShard() ([]types.Message, *types.MetaData, error) {
+	in := opts.toInternal()
+	in.Shard = true
+	return queryChunks[types.Message](in)
+}
+
+// ChunksShardStream is like ChunksShard, but delivers each item as it is produced.
+func (opts *ChunksOptions) ChunksShardStream(ctx context.Context) Seq2[types.Message] {
+	in := opts.toInternal()
+	in.Shard = true
+	return streamChunks[types.Message](ctx, in)
+}
+
 // ChunksDiff implements the chifra chunks --diff command.
 func (opts *ChunksOptions) ChunksDiff() ([]types.Message, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -108,6 +179,13 @@ func (opts *ChunksOptions) ChunksDiff() ([]types.Message, *types.MetaData, error
 	return queryChunks[types.Message](in)
 }
 
+// ChunksDiffStream is like ChunksDiff, but delivers each item as it is produced.
+func (opts *ChunksOptions) ChunksDiffStream(ctx context.Context) Seq2[types.Message] {
+	in := opts.toInternal()
+	in.Diff = true
+	return streamChunks[types.Message](ctx, in)
+}
+
 // ChunksTag implements the chifra chunks --tag command.
 func (opts *ChunksOptions) ChunksTag(val string) ([]types.Message, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -115,6 +193,13 @@ func (opts *ChunksOptions) ChunksTag(val string) ([]types.Message, *types.MetaDa
 	return queryChunks[types.Message](in)
 }
 
+// ChunksTagStream is like ChunksTag, but delivers each item as it is produced.
+func (opts *ChunksOptions) ChunksTagStream(ctx context.Context, val string) Seq2[types.Message] {
+	in := opts.toInternal()
+	in.Tag = val
+	return streamChunks[types.Message](ctx, in)
+}
+
 type ChunksMode int
 
 const (
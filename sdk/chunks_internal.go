@@ -11,6 +11,7 @@ package sdk
 import (
 	// EXISTING_CODE
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -32,6 +33,7 @@ type chunksOptionsInternal struct {
 	Truncate   base.Blknum  `json:"truncate,omitempty"`
 	Remote     bool         `json:"remote,omitempty"`
 	Belongs    []string     `json:"belongs,omitempty"`
+	Shard      bool         `json:"shard,omitempty"`
 	Diff       bool         `json:"diff,omitempty"`
 	FirstBlock base.Blknum  `json:"firstBlock,omitempty"`
 	LastBlock  base.Blknum  `json:"lastBlock,omitempty"`
@@ -129,6 +131,13 @@ func queryChunks[T chunksGeneric](opts *chunksOptionsInternal) ([]T, *types.Meta
 	}
 }
 
+func streamChunks[T chunksGeneric](ctx context.Context, opts *chunksOptionsInternal) Seq2[T] {
+	// EXISTING_CODE
+	// EXISTING_CODE
+
+	return streamModels[T](ctx, opts.ChunksBytes)
+}
+
 // toInternal converts the SDK options to the internal options format.
 func (opts *ChunksOptions) toInternal() *chunksOptionsInternal {
 	return &chunksOptionsInternal{
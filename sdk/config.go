@@ -10,6 +10,7 @@ package sdk
 
 import (
 	// EXISTING_CODE
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -36,6 +37,13 @@ func (opts *ConfigOptions) ConfigPaths() ([]types.CacheItem, *types.MetaData, er
 	return queryConfig[types.CacheItem](in)
 }
 
+// ConfigPathsStream is like ConfigPaths, but delivers each item as it is produced.
+func (opts *ConfigOptions) ConfigPathsStream(ctx context.Context) Seq2[types.CacheItem] {
+	in := opts.toInternal()
+	in.Paths = true
+	return streamConfig[types.CacheItem](ctx, in)
+}
+
 type ConfigMode int
 
 const (
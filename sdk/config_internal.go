@@ -11,6 +11,7 @@ package sdk
 import (
 	// EXISTING_CODE
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -103,6 +104,13 @@ func queryConfig[T configGeneric](opts *configOptionsInternal) ([]T, *types.Meta
 	}
 }
 
+func streamConfig[T configGeneric](ctx context.Context, opts *configOptionsInternal) Seq2[T] {
+	// EXISTING_CODE
+	// EXISTING_CODE
+
+	return streamModels[T](ctx, opts.ConfigBytes)
+}
+
 // toInternal converts the SDK options to the internal options format.
 func (opts *ConfigOptions) toInternal() *configOptionsInternal {
 	return &configOptionsInternal{
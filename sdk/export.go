@@ -10,6 +10,7 @@ package sdk
 
 import (
 	// EXISTING_CODE
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -24,6 +25,7 @@ type ExportOptions struct {
 	Topics      []string    `json:"topics,omitempty"`
 	Fourbytes   []string    `json:"fourbytes,omitempty"`
 	Accounting  bool        `json:"accounting,omitempty"`
+	Basis       ExportBasis `json:"basis,omitempty"`
 	Articulate  bool        `json:"articulate,omitempty"`
 	CacheTraces bool        `json:"cacheTraces,omitempty"`
 	FirstRecord uint64      `json:"firstRecord,omitempty"`
@@ -55,6 +57,12 @@ func (opts *ExportOptions) Export() ([]types.Transaction, *types.MetaData, error
 	return queryExport[types.Transaction](in)
 }
 
+// ExportStream is like Export, but delivers each item as it is produced.
+func (opts *ExportOptions) ExportStream(ctx context.Context) Seq2[types.Transaction] {
+	in := opts.toInternal()
+	return streamExport[types.Transaction](ctx, in)
+}
+
 // ExportAppearances implements the chifra export --appearances command.
 func (opts *ExportOptions) ExportAppearances() ([]types.Appearance, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -62,6 +70,13 @@ func (opts *ExportOptions) ExportAppearances() ([]types.Appearance, *types.MetaD
 	return queryExport[types.Appearance](in)
 }
 
+// ExportAppearancesStream is like ExportAppearances, but delivers each item as it is produced.
+func (opts *ExportOptions) ExportAppearancesStream(ctx context.Context) Seq2[types.Appearance] {
+	in := opts.toInternal()
+	in.Appearances = true
+	return streamExport[types.Appearance](ctx, in)
+}
+
 // ExportReceipts implements the chifra export --receipts command.
 func (opts *ExportOptions) ExportReceipts() ([]types.Receipt, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -69,6 +84,13 @@ func (opts *ExportOptions) ExportReceipts() ([]types.Receipt, *types.MetaData, e
 	return queryExport[types.Receipt](in)
 }
 
+// ExportReceiptsStream is like ExportReceipts, but delivers each item as it is produced.
+func (opts *ExportOptions) ExportReceiptsStream(ctx context.Context) Seq2[types.Receipt] {
+	in := opts.toInternal()
+	in.Receipts = true
+	return streamExport[types.Receipt](ctx, in)
+}
+
 // ExportLogs implements the chifra export --logs command.
 func (opts *ExportOptions) ExportLogs() ([]types.Log, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -76,6 +98,13 @@ func (opts *ExportOptions) ExportLogs() ([]types.Log, *types.MetaData, error) {
 	return queryExport[types.Log](in)
 }
 
+// ExportLogsStream is like ExportLogs, but delivers each item as it is produced.
+func (opts *ExportOptions) ExportLogsStream(ctx context.Context) Seq2[types.Log] {
+	in := opts.toInternal()
+	in.Logs = true
+	return streamExport[types.Log](ctx, in)
+}
+
 // ExportTraces implements the chifra export --traces command.
 func (opts *ExportOptions) ExportTraces() ([]types.Trace, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -83,6 +112,13 @@ func (opts *ExportOptions) ExportTraces() ([]types.Trace, *types.MetaData, error
 	return queryExport[types.Trace](in)
 }
 
+// ExportTracesStream is like ExportTraces, but delivers each item as it is produced.
+func (opts *ExportOptions) ExportTracesStream(ctx context.Context) Seq2[types.Trace] {
+	in := opts.toInternal()
+	in.Traces = true
+	return streamExport[types.Trace](ctx, in)
+}
+
 // ExportNeighbors implements the chifra export --neighbors command.
 func (opts *ExportOptions) ExportNeighbors() ([]types.Message, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -90,6 +126,13 @@ func (opts *ExportOptions) ExportNeighbors() ([]types.Message, *types.MetaData,
 	return queryExport[types.Message](in)
 }
 
+// ExportNeighborsStream is like ExportNeighbors, but delivers each item as it is produced.
+func (opts *ExportOptions) ExportNeighborsStream(ctx context.Context) Seq2[types.Message] {
+	in := opts.toInternal()
+	in.Neighbors = true
+	return streamExport[types.Message](ctx, in)
+}
+
 // ExportStatements implements the chifra export --statements command.
 func (opts *ExportOptions) ExportStatements() ([]types.Statement, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -97,6 +140,27 @@ func (opts *ExportOptions) ExportStatements() ([]types.Statement, *types.MetaDat
 	return queryExport[types.Statement](in)
 }
 
+// ExportStatementsStream is like ExportStatements, but delivers each item as it is produced.
+func (opts *ExportOptions) ExportStatementsStream(ctx context.Context) Seq2[types.Statement] {
+	in := opts.toInternal()
+	in.Statements = true
+	return streamExport[types.Statement](ctx, in)
+}
+
+// ExportLots implements the chifra export --lots command.
+func (opts *ExportOptions) ExportLots() ([]types.Disposal, *types.MetaData, error) {
+	in := opts.toInternal()
+	in.Lots = true
+	return queryExport[types.Disposal](in)
+}
+
+// ExportLotsStream is like ExportLots, but delivers each item as it is produced.
+func (opts *ExportOptions) ExportLotsStream(ctx context.Context) Seq2[types.Disposal] {
+	in := opts.toInternal()
+	in.Lots = true
+	return streamExport[types.Disposal](ctx, in)
+}
+
 // ExportBalances implements the chifra export --balances command.
 func (opts *ExportOptions) ExportBalances() ([]types.State, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -104,6 +168,13 @@ func (opts *ExportOptions) ExportBalances() ([]types.State, *types.MetaData, err
 	return queryExport[types.State](in)
 }
 
+// ExportBalancesStream is like ExportBalances, but delivers each item as it is produced.
+func (opts *ExportOptions) ExportBalancesStream(ctx context.Context) Seq2[types.State] {
+	in := opts.toInternal()
+	in.Balances = true
+	return streamExport[types.State](ctx, in)
+}
+
 // ExportWithdrawals implements the chifra export --withdrawals command.
 func (opts *ExportOptions) ExportWithdrawals() ([]types.Withdrawal, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -111,6 +182,13 @@ func (opts *ExportOptions) ExportWithdrawals() ([]types.Withdrawal, *types.MetaD
 	return queryExport[types.Withdrawal](in)
 }
 
+// ExportWithdrawalsStream is like ExportWithdrawals, but delivers each item as it is produced.
+func (opts *ExportOptions) ExportWithdrawalsStream(ctx context.Context) Seq2[types.Withdrawal] {
+	in := opts.toInternal()
+	in.Withdrawals = true
+	return streamExport[types.Withdrawal](ctx, in)
+}
+
 // ExportCount implements the chifra export --count command.
 func (opts *ExportOptions) ExportCount() ([]types.Monitor, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -118,6 +196,70 @@ func (opts *ExportOptions) ExportCount() ([]types.Monitor, *types.MetaData, erro
 	return queryExport[types.Monitor](in)
 }
 
+// ExportCountStream is like ExportCount, but delivers each item as it is produced.
+func (opts *ExportOptions) ExportCountStream(ctx context.Context) Seq2[types.Monitor] {
+	in := opts.toInternal()
+	in.Count = true
+	return streamExport[types.Monitor](ctx, in)
+}
+
+type ExportBasis int
+
+const (
+	NoEB   ExportBasis = 0
+	EBFifo             = 1 << iota
+	EBLifo
+	EBHifo
+	EBAverage
+)
+
+func (v ExportBasis) String() string {
+	switch v {
+	case NoEB:
+		return "none"
+	}
+
+	var m = map[ExportBasis]string{
+		EBFifo:    "fifo",
+		EBLifo:    "lifo",
+		EBHifo:    "hifo",
+		EBAverage: "average",
+	}
+
+	var ret []string
+	for _, val := range []ExportBasis{EBFifo, EBLifo, EBHifo, EBAverage} {
+		if v&val != 0 {
+			ret = append(ret, m[val])
+		}
+	}
+
+	return strings.Join(ret, ",")
+}
+
+func enumFromExportBasis(values []string) (ExportBasis, error) {
+	if len(values) == 0 {
+		return NoEB, fmt.Errorf("no value provided for basis option")
+	}
+
+	var result ExportBasis
+	for _, val := range values {
+		switch val {
+		case "fifo":
+			result |= EBFifo
+		case "lifo":
+			result |= EBLifo
+		case "hifo":
+			result |= EBHifo
+		case "average":
+			result |= EBAverage
+		default:
+			return NoEB, fmt.Errorf("unknown basis: %s", val)
+		}
+	}
+
+	return result, nil
+}
+
 type ExportFlow int
 
 const (
@@ -11,6 +11,7 @@ package sdk
 import (
 	// EXISTING_CODE
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -33,6 +34,8 @@ type exportOptionsInternal struct {
 	Neighbors   bool        `json:"neighbors,omitempty"`
 	Accounting  bool        `json:"accounting,omitempty"`
 	Statements  bool        `json:"statements,omitempty"`
+	Lots        bool        `json:"lots,omitempty"`
+	Basis       ExportBasis `json:"basis,omitempty"`
 	Balances    bool        `json:"balances,omitempty"`
 	Withdrawals bool        `json:"withdrawals,omitempty"`
 	Articulate  bool        `json:"articulate,omitempty"`
@@ -79,6 +82,15 @@ func exportParseFunc(target any, key, value string) (bool, error) {
 		return false, fmt.Errorf("parseFunc(export): target is not of correct type")
 	}
 
+	if key == "basis" {
+		var err error
+		values := strings.Split(value, ",")
+		if opts.Basis, err = enumFromExportBasis(values); err != nil {
+			return false, err
+		} else {
+			found = true
+		}
+	}
 	if key == "flow" {
 		var err error
 		values := strings.Split(value, ",")
@@ -113,6 +125,7 @@ type exportGeneric interface {
 		types.Trace |
 		types.Message |
 		types.Statement |
+		types.Disposal |
 		types.State |
 		types.Withdrawal |
 		types.Monitor
@@ -145,6 +158,16 @@ func queryExport[T exportGeneric](opts *exportOptionsInternal) ([]T, *types.Meta
 	}
 }
 
+func streamExport[T exportGeneric](ctx context.Context, opts *exportOptionsInternal) Seq2[T] {
+	// EXISTING_CODE
+	if opts.Statements {
+		opts.Accounting = true
+	}
+	// EXISTING_CODE
+
+	return streamModels[T](ctx, opts.ExportBytes)
+}
+
 // toInternal converts the SDK options to the internal options format.
 func (opts *ExportOptions) toInternal() *exportOptionsInternal {
 	return &exportOptionsInternal{
@@ -152,6 +175,7 @@ func (opts *ExportOptions) toInternal() *exportOptionsInternal {
 		Topics:      opts.Topics,
 		Fourbytes:   opts.Fourbytes,
 		Accounting:  opts.Accounting,
+		Basis:       opts.Basis,
 		Articulate:  opts.Articulate,
 		CacheTraces: opts.CacheTraces,
 		FirstRecord: opts.FirstRecord,
@@ -10,6 +10,7 @@ package sdk
 
 import (
 	// EXISTING_CODE
+	"context"
 	"encoding/json"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
@@ -37,6 +38,13 @@ func (opts *InitOptions) InitAll() ([]types.Message, *types.MetaData, error) {
 	return queryInit[types.Message](in)
 }
 
+// InitAllStream is like InitAll, but delivers each item as it is produced.
+func (opts *InitOptions) InitAllStream(ctx context.Context) Seq2[types.Message] {
+	in := opts.toInternal()
+	in.All = true
+	return streamInit[types.Message](ctx, in)
+}
+
 // InitExample implements the chifra init --example command.
 func (opts *InitOptions) InitExample(val string) ([]types.Message, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -44,6 +52,13 @@ func (opts *InitOptions) InitExample(val string) ([]types.Message, *types.MetaDa
 	return queryInit[types.Message](in)
 }
 
+// InitExampleStream is like InitExample, but delivers each item as it is produced.
+func (opts *InitOptions) InitExampleStream(ctx context.Context, val string) Seq2[types.Message] {
+	in := opts.toInternal()
+	in.Example = val
+	return streamInit[types.Message](ctx, in)
+}
+
 // InitDryRun implements the chifra init --dryrun command.
 func (opts *InitOptions) InitDryRun() ([]types.Message, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -51,6 +66,13 @@ func (opts *InitOptions) InitDryRun() ([]types.Message, *types.MetaData, error)
 	return queryInit[types.Message](in)
 }
 
+// InitDryRunStream is like InitDryRun, but delivers each item as it is produced.
+func (opts *InitOptions) InitDryRunStream(ctx context.Context) Seq2[types.Message] {
+	in := opts.toInternal()
+	in.DryRun = true
+	return streamInit[types.Message](ctx, in)
+}
+
 // No enums
 // EXISTING_CODE
 // EXISTING_CODE
@@ -11,6 +11,7 @@ package sdk
 import (
 	// EXISTING_CODE
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -98,6 +99,13 @@ func queryInit[T initGeneric](opts *initOptionsInternal) ([]T, *types.MetaData,
 	}
 }
 
+func streamInit[T initGeneric](ctx context.Context, opts *initOptionsInternal) Seq2[T] {
+	// EXISTING_CODE
+	// EXISTING_CODE
+
+	return streamModels[T](ctx, opts.InitBytes)
+}
+
 // toInternal converts the SDK options to the internal options format.
 func (opts *InitOptions) toInternal() *initOptionsInternal {
 	return &initOptionsInternal{
@@ -10,6 +10,7 @@ package sdk
 
 import (
 	// EXISTING_CODE
+	"context"
 	"encoding/json"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
@@ -43,6 +44,12 @@ func (opts *ListOptions) List() ([]types.Appearance, *types.MetaData, error) {
 	return queryList[types.Appearance](in)
 }
 
+// ListStream is like List, but delivers each item as it is produced.
+func (opts *ListOptions) ListStream(ctx context.Context) Seq2[types.Appearance] {
+	in := opts.toInternal()
+	return streamList[types.Appearance](ctx, in)
+}
+
 // ListCount implements the chifra list --count command.
 func (opts *ListOptions) ListCount() ([]types.Monitor, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -50,6 +57,13 @@ func (opts *ListOptions) ListCount() ([]types.Monitor, *types.MetaData, error) {
 	return queryList[types.Monitor](in)
 }
 
+// ListCountStream is like ListCount, but delivers each item as it is produced.
+func (opts *ListOptions) ListCountStream(ctx context.Context) Seq2[types.Monitor] {
+	in := opts.toInternal()
+	in.Count = true
+	return streamList[types.Monitor](ctx, in)
+}
+
 // ListBounds implements the chifra list --bounds command.
 func (opts *ListOptions) ListBounds() ([]types.Bounds, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -57,6 +71,13 @@ func (opts *ListOptions) ListBounds() ([]types.Bounds, *types.MetaData, error) {
 	return queryList[types.Bounds](in)
 }
 
+// ListBoundsStream is like ListBounds, but delivers each item as it is produced.
+func (opts *ListOptions) ListBoundsStream(ctx context.Context) Seq2[types.Bounds] {
+	in := opts.toInternal()
+	in.Bounds = true
+	return streamList[types.Bounds](ctx, in)
+}
+
 // No enums
 // EXISTING_CODE
 // EXISTING_CODE
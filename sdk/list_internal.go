@@ -11,6 +11,7 @@ package sdk
 import (
 	// EXISTING_CODE
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -106,6 +107,13 @@ func queryList[T listGeneric](opts *listOptionsInternal) ([]T, *types.MetaData,
 	}
 }
 
+func streamList[T listGeneric](ctx context.Context, opts *listOptionsInternal) Seq2[T] {
+	// EXISTING_CODE
+	// EXISTING_CODE
+
+	return streamModels[T](ctx, opts.ListBytes)
+}
+
 // toInternal converts the SDK options to the internal options format.
 func (opts *ListOptions) toInternal() *listOptionsInternal {
 	return &listOptionsInternal{
@@ -10,6 +10,7 @@ package sdk
 
 import (
 	// EXISTING_CODE
+	"context"
 	"encoding/json"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
@@ -36,6 +37,12 @@ func (opts *LogsOptions) Logs() ([]types.Log, *types.MetaData, error) {
 	return queryLogs[types.Log](in)
 }
 
+// LogsStream is like Logs, but delivers each item as it is produced.
+func (opts *LogsOptions) LogsStream(ctx context.Context) Seq2[types.Log] {
+	in := opts.toInternal()
+	return streamLogs[types.Log](ctx, in)
+}
+
 // No enums
 // EXISTING_CODE
 // EXISTING_CODE
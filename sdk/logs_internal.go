@@ -11,6 +11,7 @@ package sdk
 import (
 	// EXISTING_CODE
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -97,6 +98,13 @@ func queryLogs[T logsGeneric](opts *logsOptionsInternal) ([]T, *types.MetaData,
 	}
 }
 
+func streamLogs[T logsGeneric](ctx context.Context, opts *logsOptionsInternal) Seq2[T] {
+	// EXISTING_CODE
+	// EXISTING_CODE
+
+	return streamModels[T](ctx, opts.LogsBytes)
+}
+
 // toInternal converts the SDK options to the internal options format.
 func (opts *LogsOptions) toInternal() *logsOptionsInternal {
 	return &logsOptionsInternal{
@@ -10,6 +10,7 @@ package sdk
 
 import (
 	// EXISTING_CODE
+	"context"
 	"encoding/json"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
@@ -42,6 +43,12 @@ func (opts *MonitorsOptions) Monitors() ([]types.Message, *types.MetaData, error
 	return queryMonitors[types.Message](in)
 }
 
+// MonitorsStream is like Monitors, but delivers each item as it is produced.
+func (opts *MonitorsOptions) MonitorsStream(ctx context.Context) Seq2[types.Message] {
+	in := opts.toInternal()
+	return streamMonitors[types.Message](ctx, in)
+}
+
 // MonitorsClean implements the chifra monitors --clean command.
 func (opts *MonitorsOptions) MonitorsClean() ([]types.MonitorClean, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -49,6 +56,13 @@ func (opts *MonitorsOptions) MonitorsClean() ([]types.MonitorClean, *types.MetaD
 	return queryMonitors[types.MonitorClean](in)
 }
 
+// MonitorsCleanStream is like MonitorsClean, but delivers each item as it is produced.
+func (opts *MonitorsOptions) MonitorsCleanStream(ctx context.Context) Seq2[types.MonitorClean] {
+	in := opts.toInternal()
+	in.Clean = true
+	return streamMonitors[types.MonitorClean](ctx, in)
+}
+
 // MonitorsList implements the chifra monitors --list command.
 func (opts *MonitorsOptions) MonitorsList() ([]types.Monitor, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -56,6 +70,13 @@ func (opts *MonitorsOptions) MonitorsList() ([]types.Monitor, *types.MetaData, e
 	return queryMonitors[types.Monitor](in)
 }
 
+// MonitorsListStream is like MonitorsList, but delivers each item as it is produced.
+func (opts *MonitorsOptions) MonitorsListStream(ctx context.Context) Seq2[types.Monitor] {
+	in := opts.toInternal()
+	in.List = true
+	return streamMonitors[types.Monitor](ctx, in)
+}
+
 // No enums
 // EXISTING_CODE
 // EXISTING_CODE
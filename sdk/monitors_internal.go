@@ -11,6 +11,7 @@ package sdk
 import (
 	// EXISTING_CODE
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -105,6 +106,13 @@ func queryMonitors[T monitorsGeneric](opts *monitorsOptionsInternal) ([]T, *type
 	}
 }
 
+func streamMonitors[T monitorsGeneric](ctx context.Context, opts *monitorsOptionsInternal) Seq2[T] {
+	// EXISTING_CODE
+	// EXISTING_CODE
+
+	return streamModels[T](ctx, opts.MonitorsBytes)
+}
+
 // toInternal converts the SDK options to the internal options format.
 func (opts *MonitorsOptions) toInternal() *monitorsOptionsInternal {
 	return &monitorsOptionsInternal{
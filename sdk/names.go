@@ -10,7 +10,10 @@ package sdk
 
 import (
 	// EXISTING_CODE
+	"context"
 	"encoding/json"
+	"fmt"
+	"strings"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
@@ -18,14 +21,16 @@ import (
 )
 
 type NamesOptions struct {
-	Terms     []string `json:"terms,omitempty"`
-	Expand    bool     `json:"expand,omitempty"`
-	MatchCase bool     `json:"matchCase,omitempty"`
-	All       bool     `json:"all,omitempty"`
-	Custom    bool     `json:"custom,omitempty"`
-	Prefund   bool     `json:"prefund,omitempty"`
-	Regular   bool     `json:"regular,omitempty"`
-	DryRun    bool     `json:"dryRun,omitempty"`
+	Terms     []string   `json:"terms,omitempty"`
+	Expand    bool       `json:"expand,omitempty"`
+	MatchCase bool       `json:"matchCase,omitempty"`
+	All       bool       `json:"all,omitempty"`
+	Custom    bool       `json:"custom,omitempty"`
+	Prefund   bool       `json:"prefund,omitempty"`
+	Regular   bool       `json:"regular,omitempty"`
+	DryRun    bool       `json:"dryRun,omitempty"`
+	Neighbors bool       `json:"neighbors,omitempty"`
+	Merge     NamesMerge `json:"merge,omitempty"`
 	Globals
 }
 
@@ -41,6 +46,12 @@ func (opts *NamesOptions) Names() ([]types.Name, *types.MetaData, error) {
 	return queryNames[types.Name](in)
 }
 
+// NamesStream is like Names, but delivers each item as it is produced.
+func (opts *NamesOptions) NamesStream(ctx context.Context) Seq2[types.Name] {
+	in := opts.toInternal()
+	return streamNames[types.Name](ctx, in)
+}
+
 // NamesAddr implements the chifra names --addr command.
 func (opts *NamesOptions) NamesAddr() ([]types.Name, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -48,6 +59,13 @@ func (opts *NamesOptions) NamesAddr() ([]types.Name, *types.MetaData, error) {
 	return queryNames[types.Name](in)
 }
 
+// NamesAddrStream is like NamesAddr, but delivers each item as it is produced.
+func (opts *NamesOptions) NamesAddrStream(ctx context.Context) Seq2[types.Name] {
+	in := opts.toInternal()
+	in.Addr = true
+	return streamNames[types.Name](ctx, in)
+}
+
 // NamesTags implements the chifra names --tags command.
 func (opts *NamesOptions) NamesTags() ([]types.Name, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -55,6 +73,13 @@ func (opts *NamesOptions) NamesTags() ([]types.Name, *types.MetaData, error) {
 	return queryNames[types.Name](in)
 }
 
+// NamesTagsStream is like NamesTags, but delivers each item as it is produced.
+func (opts *NamesOptions) NamesTagsStream(ctx context.Context) Seq2[types.Name] {
+	in := opts.toInternal()
+	in.Tags = true
+	return streamNames[types.Name](ctx, in)
+}
+
 // NamesClean implements the chifra names --clean command.
 func (opts *NamesOptions) NamesClean() ([]types.Message, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -62,6 +87,13 @@ func (opts *NamesOptions) NamesClean() ([]types.Message, *types.MetaData, error)
 	return queryNames[types.Message](in)
 }
 
+// NamesCleanStream is like NamesClean, but delivers each item as it is produced.
+func (opts *NamesOptions) NamesCleanStream(ctx context.Context) Seq2[types.Message] {
+	in := opts.toInternal()
+	in.Clean = true
+	return streamNames[types.Message](ctx, in)
+}
+
 // NamesAutoname implements the chifra names --autoname command.
 func (opts *NamesOptions) NamesAutoname(val base.Address) ([]types.Message, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -69,6 +101,27 @@ func (opts *NamesOptions) NamesAutoname(val base.Address) ([]types.Message, *typ
 	return queryNames[types.Message](in)
 }
 
+// NamesAutonameStream is like NamesAutoname, but delivers each item as it is produced.
+func (opts *NamesOptions) NamesAutonameStream(ctx context.Context, val base.Address) Seq2[types.Message] {
+	in := opts.toInternal()
+	in.Autoname = val
+	return streamNames[types.Message](ctx, in)
+}
+
+// NamesAutonameList implements the chifra names --autonamelist command.
+func (opts *NamesOptions) NamesAutonameList(val string) ([]types.Message, *types.MetaData, error) {
+	in := opts.toInternal()
+	in.AutonameList = val
+	return queryNames[types.Message](in)
+}
+
+// NamesAutonameListStream is like NamesAutonameList, but delivers each item as it is produced.
+func (opts *NamesOptions) NamesAutonameListStream(ctx context.Context, val string) Seq2[types.Message] {
+	in := opts.toInternal()
+	in.AutonameList = val
+	return streamNames[types.Message](ctx, in)
+}
+
 // NamesCreate implements the chifra names --create command.
 func (opts *NamesOptions) NamesCreate() ([]types.Name, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -76,6 +129,13 @@ func (opts *NamesOptions) NamesCreate() ([]types.Name, *types.MetaData, error) {
 	return queryNames[types.Name](in)
 }
 
+// NamesCreateStream is like NamesCreate, but delivers each item as it is produced.
+func (opts *NamesOptions) NamesCreateStream(ctx context.Context) Seq2[types.Name] {
+	in := opts.toInternal()
+	in.Create = true
+	return streamNames[types.Name](ctx, in)
+}
+
 // NamesUpdate implements the chifra names --update command.
 func (opts *NamesOptions) NamesUpdate() ([]types.Name, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -83,6 +143,13 @@ func (opts *NamesOptions) NamesUpdate() ([]types.Name, *types.MetaData, error) {
 	return queryNames[types.Name](in)
 }
 
+// NamesUpdateStream is like NamesUpdate, but delivers each item as it is produced.
+func (opts *NamesOptions) NamesUpdateStream(ctx context.Context) Seq2[types.Name] {
+	in := opts.toInternal()
+	in.Update = true
+	return streamNames[types.Name](ctx, in)
+}
+
 // NamesDelete implements the chifra names --delete command.
 func (opts *NamesOptions) NamesDelete() ([]types.Name, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -90,6 +157,13 @@ func (opts *NamesOptions) NamesDelete() ([]types.Name, *types.MetaData, error) {
 	return queryNames[types.Name](in)
 }
 
+// NamesDeleteStream is like NamesDelete, but delivers each item as it is produced.
+func (opts *NamesOptions) NamesDeleteStream(ctx context.Context) Seq2[types.Name] {
+	in := opts.toInternal()
+	in.Delete = true
+	return streamNames[types.Name](ctx, in)
+}
+
 // NamesUndelete implements the chifra names --undelete command.
 func (opts *NamesOptions) NamesUndelete() ([]types.Name, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -97,6 +171,13 @@ func (opts *NamesOptions) NamesUndelete() ([]types.Name, *types.MetaData, error)
 	return queryNames[types.Name](in)
 }
 
+// NamesUndeleteStream is like NamesUndelete, but delivers each item as it is produced.
+func (opts *NamesOptions) NamesUndeleteStream(ctx context.Context) Seq2[types.Name] {
+	in := opts.toInternal()
+	in.Undelete = true
+	return streamNames[types.Name](ctx, in)
+}
+
 // NamesRemove implements the chifra names --remove command.
 func (opts *NamesOptions) NamesRemove() ([]types.Name, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -104,6 +185,93 @@ func (opts *NamesOptions) NamesRemove() ([]types.Name, *types.MetaData, error) {
 	return queryNames[types.Name](in)
 }
 
-// No enums
+// NamesRemoveStream is like NamesRemove, but delivers each item as it is produced.
+func (opts *NamesOptions) NamesRemoveStream(ctx context.Context) Seq2[types.Name] {
+	in := opts.toInternal()
+	in.Remove = true
+	return streamNames[types.Name](ctx, in)
+}
+
+// NamesImport implements the chifra names --import command.
+func (opts *NamesOptions) NamesImport(val string) ([]types.Message, *types.MetaData, error) {
+	in := opts.toInternal()
+	in.Import = val
+	return queryNames[types.Message](in)
+}
+
+// NamesImportStream is like NamesImport, but delivers each item as it is produced.
+func (opts *NamesOptions) NamesImportStream(ctx context.Context, val string) Seq2[types.Message] {
+	in := opts.toInternal()
+	in.Import = val
+	return streamNames[types.Message](ctx, in)
+}
+
+// NamesExport implements the chifra names --export command.
+func (opts *NamesOptions) NamesExport(val string) ([]types.Message, *types.MetaData, error) {
+	in := opts.toInternal()
+	in.Export = val
+	return queryNames[types.Message](in)
+}
+
+// NamesExportStream is like NamesExport, but delivers each item as it is produced.
+func (opts *NamesOptions) NamesExportStream(ctx context.Context, val string) Seq2[types.Message] {
+	in := opts.toInternal()
+	in.Export = val
+	return streamNames[types.Message](ctx, in)
+}
+
+type NamesMerge int
+
+const (
+	NoNM   NamesMerge = 0
+	NMMine            = 1 << iota
+	NMTheirs
+	NMTags
+)
+
+func (v NamesMerge) String() string {
+	switch v {
+	case NoNM:
+		return "none"
+	}
+
+	var m = map[NamesMerge]string{
+		NMMine:   "mine",
+		NMTheirs: "theirs",
+		NMTags:   "tags",
+	}
+
+	var ret []string
+	for _, val := range []NamesMerge{NMMine, NMTheirs, NMTags} {
+		if v&val != 0 {
+			ret = append(ret, m[val])
+		}
+	}
+
+	return strings.Join(ret, ",")
+}
+
+func enumFromNamesMerge(values []string) (NamesMerge, error) {
+	if len(values) == 0 {
+		return NoNM, fmt.Errorf("no value provided for merge option")
+	}
+
+	var result NamesMerge
+	for _, val := range values {
+		switch val {
+		case "mine":
+			result |= NMMine
+		case "theirs":
+			result |= NMTheirs
+		case "tags":
+			result |= NMTags
+		default:
+			return NoNM, fmt.Errorf("unknown merge: %s", val)
+		}
+	}
+
+	return result, nil
+}
+
 // EXISTING_CODE
 // EXISTING_CODE
@@ -11,9 +11,11 @@ package sdk
 import (
 	// EXISTING_CODE
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
@@ -22,23 +24,28 @@ import (
 )
 
 type namesOptionsInternal struct {
-	Terms     []string     `json:"terms,omitempty"`
-	Expand    bool         `json:"expand,omitempty"`
-	MatchCase bool         `json:"matchCase,omitempty"`
-	All       bool         `json:"all,omitempty"`
-	Custom    bool         `json:"custom,omitempty"`
-	Prefund   bool         `json:"prefund,omitempty"`
-	Addr      bool         `json:"addr,omitempty"`
-	Tags      bool         `json:"tags,omitempty"`
-	Clean     bool         `json:"clean,omitempty"`
-	Regular   bool         `json:"regular,omitempty"`
-	DryRun    bool         `json:"dryRun,omitempty"`
-	Autoname  base.Address `json:"autoname,omitempty"`
-	Create    bool         `json:"create,omitempty"`
-	Update    bool         `json:"update,omitempty"`
-	Delete    bool         `json:"delete,omitempty"`
-	Undelete  bool         `json:"undelete,omitempty"`
-	Remove    bool         `json:"remove,omitempty"`
+	Terms        []string     `json:"terms,omitempty"`
+	Expand       bool         `json:"expand,omitempty"`
+	MatchCase    bool         `json:"matchCase,omitempty"`
+	All          bool         `json:"all,omitempty"`
+	Custom       bool         `json:"custom,omitempty"`
+	Prefund      bool         `json:"prefund,omitempty"`
+	Addr         bool         `json:"addr,omitempty"`
+	Tags         bool         `json:"tags,omitempty"`
+	Clean        bool         `json:"clean,omitempty"`
+	Regular      bool         `json:"regular,omitempty"`
+	DryRun       bool         `json:"dryRun,omitempty"`
+	Autoname     base.Address `json:"autoname,omitempty"`
+	AutonameList string       `json:"autonameList,omitempty"`
+	Neighbors    bool         `json:"neighbors,omitempty"`
+	Create       bool         `json:"create,omitempty"`
+	Update       bool         `json:"update,omitempty"`
+	Delete       bool         `json:"delete,omitempty"`
+	Undelete     bool         `json:"undelete,omitempty"`
+	Remove       bool         `json:"remove,omitempty"`
+	Import       string       `json:"import,omitempty"`
+	Export       string       `json:"export,omitempty"`
+	Merge        NamesMerge   `json:"merge,omitempty"`
 	Globals
 }
 
@@ -61,12 +68,21 @@ func (opts *namesOptionsInternal) NamesBytes(w io.Writer) error {
 // namesParseFunc handles special cases such as structs and enums (if any).
 func namesParseFunc(target any, key, value string) (bool, error) {
 	var found bool
-	_, ok := target.(*namesOptionsInternal)
+	opts, ok := target.(*namesOptionsInternal)
 	if !ok {
 		return false, fmt.Errorf("parseFunc(names): target is not of correct type")
 	}
 
-	// No enums
+	if key == "merge" {
+		var err error
+		values := strings.Split(value, ",")
+		if opts.Merge, err = enumFromNamesMerge(values); err != nil {
+			return false, err
+		} else {
+			found = true
+		}
+	}
+
 	// EXISTING_CODE
 	// EXISTING_CODE
 
@@ -110,6 +126,13 @@ func queryNames[T namesGeneric](opts *namesOptionsInternal) ([]T, *types.MetaDat
 	}
 }
 
+func streamNames[T namesGeneric](ctx context.Context, opts *namesOptionsInternal) Seq2[T] {
+	// EXISTING_CODE
+	// EXISTING_CODE
+
+	return streamModels[T](ctx, opts.NamesBytes)
+}
+
 // toInternal converts the SDK options to the internal options format.
 func (opts *NamesOptions) toInternal() *namesOptionsInternal {
 	return &namesOptionsInternal{
@@ -121,6 +144,8 @@ func (opts *NamesOptions) toInternal() *namesOptionsInternal {
 		Prefund:   opts.Prefund,
 		Regular:   opts.Regular,
 		DryRun:    opts.DryRun,
+		Neighbors: opts.Neighbors,
+		Merge:     opts.Merge,
 		Globals:   opts.Globals,
 	}
 }
@@ -15,6 +15,7 @@ chunksOpts = {
     "publish": {"hotkey": "-p", "type": "switch"},
     "remote": {"hotkey": "-r", "type": "switch"},
     "belongs": {"hotkey": "-b", "type": "flag"},
+    "shard": {"hotkey": "", "type": "switch"},
     "firstBlock": {"hotkey": "-F", "type": "flag"},
     "lastBlock": {"hotkey": "-L", "type": "flag"},
     "maxAddrs": {"hotkey": "-m", "type": "flag"},
@@ -17,6 +17,8 @@ exportOpts = {
     "neighbors": {"hotkey": "-n", "type": "switch"},
     "accounting": {"hotkey": "-C", "type": "switch"},
     "statements": {"hotkey": "-A", "type": "switch"},
+    "lots": {"hotkey": "", "type": "switch"},
+    "basis": {"hotkey": "", "type": "flag"},
     "balances": {"hotkey": "-b", "type": "switch"},
     "withdrawals": {"hotkey": "-i", "type": "switch"},
     "articulate": {"hotkey": "-a", "type": "switch"},
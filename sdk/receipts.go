@@ -10,6 +10,7 @@ package sdk
 
 import (
 	// EXISTING_CODE
+	"context"
 	"encoding/json"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
@@ -34,6 +35,12 @@ func (opts *ReceiptsOptions) Receipts() ([]types.Receipt, *types.MetaData, error
 	return queryReceipts[types.Receipt](in)
 }
 
+// ReceiptsStream is like Receipts, but delivers each item as it is produced.
+func (opts *ReceiptsOptions) ReceiptsStream(ctx context.Context) Seq2[types.Receipt] {
+	in := opts.toInternal()
+	return streamReceipts[types.Receipt](ctx, in)
+}
+
 // No enums
 // EXISTING_CODE
 // EXISTING_CODE
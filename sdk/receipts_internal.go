@@ -11,6 +11,7 @@ package sdk
 import (
 	// EXISTING_CODE
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -96,6 +97,13 @@ func queryReceipts[T receiptsGeneric](opts *receiptsOptionsInternal) ([]T, *type
 	}
 }
 
+func streamReceipts[T receiptsGeneric](ctx context.Context, opts *receiptsOptionsInternal) Seq2[T] {
+	// EXISTING_CODE
+	// EXISTING_CODE
+
+	return streamModels[T](ctx, opts.ReceiptsBytes)
+}
+
 // toInternal converts the SDK options to the internal options format.
 func (opts *ReceiptsOptions) toInternal() *receiptsOptionsInternal {
 	return &receiptsOptionsInternal{
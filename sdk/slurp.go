@@ -10,6 +10,7 @@ package sdk
 
 import (
 	// EXISTING_CODE
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -43,6 +44,12 @@ func (opts *SlurpOptions) Slurp() ([]types.Slurp, *types.MetaData, error) {
 	return querySlurp[types.Slurp](in)
 }
 
+// SlurpStream is like Slurp, but delivers each item as it is produced.
+func (opts *SlurpOptions) SlurpStream(ctx context.Context) Seq2[types.Slurp] {
+	in := opts.toInternal()
+	return streamSlurp[types.Slurp](ctx, in)
+}
+
 // SlurpAppearances implements the chifra slurp --appearances command.
 func (opts *SlurpOptions) SlurpAppearances() ([]types.Appearance, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -50,6 +57,13 @@ func (opts *SlurpOptions) SlurpAppearances() ([]types.Appearance, *types.MetaDat
 	return querySlurp[types.Appearance](in)
 }
 
+// SlurpAppearancesStream is like SlurpAppearances, but delivers each item as it is produced.
+func (opts *SlurpOptions) SlurpAppearancesStream(ctx context.Context) Seq2[types.Appearance] {
+	in := opts.toInternal()
+	in.Appearances = true
+	return streamSlurp[types.Appearance](ctx, in)
+}
+
 // SlurpCount implements the chifra slurp --count command.
 func (opts *SlurpOptions) SlurpCount() ([]types.Monitor, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -57,6 +71,13 @@ func (opts *SlurpOptions) SlurpCount() ([]types.Monitor, *types.MetaData, error)
 	return querySlurp[types.Monitor](in)
 }
 
+// SlurpCountStream is like SlurpCount, but delivers each item as it is produced.
+func (opts *SlurpOptions) SlurpCountStream(ctx context.Context) Seq2[types.Monitor] {
+	in := opts.toInternal()
+	in.Count = true
+	return streamSlurp[types.Monitor](ctx, in)
+}
+
 type SlurpParts int
 
 const (
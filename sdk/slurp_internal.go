@@ -11,6 +11,7 @@ package sdk
 import (
 	// EXISTING_CODE
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -125,6 +126,13 @@ func querySlurp[T slurpGeneric](opts *slurpOptionsInternal) ([]T, *types.MetaDat
 	}
 }
 
+func streamSlurp[T slurpGeneric](ctx context.Context, opts *slurpOptionsInternal) Seq2[T] {
+	// EXISTING_CODE
+	// EXISTING_CODE
+
+	return streamModels[T](ctx, opts.SlurpBytes)
+}
+
 // toInternal converts the SDK options to the internal options format.
 func (opts *SlurpOptions) toInternal() *slurpOptionsInternal {
 	return &slurpOptionsInternal{
@@ -10,6 +10,7 @@ package sdk
 
 import (
 	// EXISTING_CODE
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -42,6 +43,12 @@ func (opts *StateOptions) State() ([]types.State, *types.MetaData, error) {
 	return queryState[types.State](in)
 }
 
+// StateStream is like State, but delivers each item as it is produced.
+func (opts *StateOptions) StateStream(ctx context.Context) Seq2[types.State] {
+	in := opts.toInternal()
+	return streamState[types.State](ctx, in)
+}
+
 // StateCall implements the chifra state --call command.
 func (opts *StateOptions) StateCall(val string) ([]types.Result, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -49,6 +56,13 @@ func (opts *StateOptions) StateCall(val string) ([]types.Result, *types.MetaData
 	return queryState[types.Result](in)
 }
 
+// StateCallStream is like StateCall, but delivers each item as it is produced.
+func (opts *StateOptions) StateCallStream(ctx context.Context, val string) Seq2[types.Result] {
+	in := opts.toInternal()
+	in.Call = val
+	return streamState[types.Result](ctx, in)
+}
+
 type StateParts int
 
 const (
@@ -11,6 +11,7 @@ package sdk
 import (
 	// EXISTING_CODE
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -117,6 +118,13 @@ func queryState[T stateGeneric](opts *stateOptionsInternal) ([]T, *types.MetaDat
 	}
 }
 
+func streamState[T stateGeneric](ctx context.Context, opts *stateOptionsInternal) Seq2[T] {
+	// EXISTING_CODE
+	// EXISTING_CODE
+
+	return streamModels[T](ctx, opts.StateBytes)
+}
+
 // toInternal converts the SDK options to the internal options format.
 func (opts *StateOptions) toInternal() *stateOptionsInternal {
 	return &stateOptionsInternal{
@@ -10,6 +10,7 @@ package sdk
 
 import (
 	// EXISTING_CODE
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -38,6 +39,13 @@ func (opts *StatusOptions) StatusIndex() ([]types.Status, *types.MetaData, error
 	return queryStatus[types.Status](in)
 }
 
+// StatusIndexStream is like StatusIndex, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusIndexStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Modes = SMIndex
+	return streamStatus[types.Status](ctx, in)
+}
+
 // StatusBlooms implements the chifra status blooms command.
 func (opts *StatusOptions) StatusBlooms() ([]types.Status, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -45,6 +53,13 @@ func (opts *StatusOptions) StatusBlooms() ([]types.Status, *types.MetaData, erro
 	return queryStatus[types.Status](in)
 }
 
+// StatusBloomsStream is like StatusBlooms, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusBloomsStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Modes = SMBlooms
+	return streamStatus[types.Status](ctx, in)
+}
+
 // StatusBlocks implements the chifra status blocks command.
 func (opts *StatusOptions) StatusBlocks() ([]types.Status, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -52,6 +67,13 @@ func (opts *StatusOptions) StatusBlocks() ([]types.Status, *types.MetaData, erro
 	return queryStatus[types.Status](in)
 }
 
+// StatusBlocksStream is like StatusBlocks, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusBlocksStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Modes = SMBlocks
+	return streamStatus[types.Status](ctx, in)
+}
+
 // StatusTransactions implements the chifra status transactions command.
 func (opts *StatusOptions) StatusTransactions() ([]types.Status, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -59,6 +81,13 @@ func (opts *StatusOptions) StatusTransactions() ([]types.Status, *types.MetaData
 	return queryStatus[types.Status](in)
 }
 
+// StatusTransactionsStream is like StatusTransactions, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusTransactionsStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Modes = SMTransactions
+	return streamStatus[types.Status](ctx, in)
+}
+
 // StatusTraces implements the chifra status traces command.
 func (opts *StatusOptions) StatusTraces() ([]types.Status, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -66,6 +95,13 @@ func (opts *StatusOptions) StatusTraces() ([]types.Status, *types.MetaData, erro
 	return queryStatus[types.Status](in)
 }
 
+// StatusTracesStream is like StatusTraces, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusTracesStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Modes = SMTraces
+	return streamStatus[types.Status](ctx, in)
+}
+
 // StatusLogs implements the chifra status logs command.
 func (opts *StatusOptions) StatusLogs() ([]types.Status, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -73,6 +109,13 @@ func (opts *StatusOptions) StatusLogs() ([]types.Status, *types.MetaData, error)
 	return queryStatus[types.Status](in)
 }
 
+// StatusLogsStream is like StatusLogs, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusLogsStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Modes = SMLogs
+	return streamStatus[types.Status](ctx, in)
+}
+
 // StatusStatements implements the chifra status statements command.
 func (opts *StatusOptions) StatusStatements() ([]types.Status, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -80,6 +123,13 @@ func (opts *StatusOptions) StatusStatements() ([]types.Status, *types.MetaData,
 	return queryStatus[types.Status](in)
 }
 
+// StatusStatementsStream is like StatusStatements, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusStatementsStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Modes = SMStatements
+	return streamStatus[types.Status](ctx, in)
+}
+
 // StatusResults implements the chifra status results command.
 func (opts *StatusOptions) StatusResults() ([]types.Status, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -87,6 +137,13 @@ func (opts *StatusOptions) StatusResults() ([]types.Status, *types.MetaData, err
 	return queryStatus[types.Status](in)
 }
 
+// StatusResultsStream is like StatusResults, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusResultsStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Modes = SMResults
+	return streamStatus[types.Status](ctx, in)
+}
+
 // StatusState implements the chifra status state command.
 func (opts *StatusOptions) StatusState() ([]types.Status, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -94,6 +151,13 @@ func (opts *StatusOptions) StatusState() ([]types.Status, *types.MetaData, error
 	return queryStatus[types.Status](in)
 }
 
+// StatusStateStream is like StatusState, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusStateStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Modes = SMState
+	return streamStatus[types.Status](ctx, in)
+}
+
 // StatusTokens implements the chifra status tokens command.
 func (opts *StatusOptions) StatusTokens() ([]types.Status, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -101,6 +165,13 @@ func (opts *StatusOptions) StatusTokens() ([]types.Status, *types.MetaData, erro
 	return queryStatus[types.Status](in)
 }
 
+// StatusTokensStream is like StatusTokens, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusTokensStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Modes = SMTokens
+	return streamStatus[types.Status](ctx, in)
+}
+
 // StatusMonitors implements the chifra status monitors command.
 func (opts *StatusOptions) StatusMonitors() ([]types.Status, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -108,6 +179,13 @@ func (opts *StatusOptions) StatusMonitors() ([]types.Status, *types.MetaData, er
 	return queryStatus[types.Status](in)
 }
 
+// StatusMonitorsStream is like StatusMonitors, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusMonitorsStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Modes = SMMonitors
+	return streamStatus[types.Status](ctx, in)
+}
+
 // StatusNames implements the chifra status names command.
 func (opts *StatusOptions) StatusNames() ([]types.Status, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -115,6 +193,13 @@ func (opts *StatusOptions) StatusNames() ([]types.Status, *types.MetaData, error
 	return queryStatus[types.Status](in)
 }
 
+// StatusNamesStream is like StatusNames, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusNamesStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Modes = SMNames
+	return streamStatus[types.Status](ctx, in)
+}
+
 // StatusAbis implements the chifra status abis command.
 func (opts *StatusOptions) StatusAbis() ([]types.Status, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -122,6 +207,13 @@ func (opts *StatusOptions) StatusAbis() ([]types.Status, *types.MetaData, error)
 	return queryStatus[types.Status](in)
 }
 
+// StatusAbisStream is like StatusAbis, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusAbisStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Modes = SMAbis
+	return streamStatus[types.Status](ctx, in)
+}
+
 // StatusSlurps implements the chifra status slurps command.
 func (opts *StatusOptions) StatusSlurps() ([]types.Status, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -129,6 +221,13 @@ func (opts *StatusOptions) StatusSlurps() ([]types.Status, *types.MetaData, erro
 	return queryStatus[types.Status](in)
 }
 
+// StatusSlurpsStream is like StatusSlurps, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusSlurpsStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Modes = SMSlurps
+	return streamStatus[types.Status](ctx, in)
+}
+
 // StatusStaging implements the chifra status staging command.
 func (opts *StatusOptions) StatusStaging() ([]types.Status, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -136,6 +235,13 @@ func (opts *StatusOptions) StatusStaging() ([]types.Status, *types.MetaData, err
 	return queryStatus[types.Status](in)
 }
 
+// StatusStagingStream is like StatusStaging, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusStagingStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Modes = SMStaging
+	return streamStatus[types.Status](ctx, in)
+}
+
 // StatusUnripe implements the chifra status unripe command.
 func (opts *StatusOptions) StatusUnripe() ([]types.Status, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -143,6 +249,13 @@ func (opts *StatusOptions) StatusUnripe() ([]types.Status, *types.MetaData, erro
 	return queryStatus[types.Status](in)
 }
 
+// StatusUnripeStream is like StatusUnripe, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusUnripeStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Modes = SMUnripe
+	return streamStatus[types.Status](ctx, in)
+}
+
 // StatusMaps implements the chifra status maps command.
 func (opts *StatusOptions) StatusMaps() ([]types.Status, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -150,6 +263,27 @@ func (opts *StatusOptions) StatusMaps() ([]types.Status, *types.MetaData, error)
 	return queryStatus[types.Status](in)
 }
 
+// StatusMapsStream is like StatusMaps, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusMapsStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Modes = SMMaps
+	return streamStatus[types.Status](ctx, in)
+}
+
+// StatusPrices implements the chifra status prices command.
+func (opts *StatusOptions) StatusPrices() ([]types.Status, *types.MetaData, error) {
+	in := opts.toInternal()
+	in.Modes = SMPrices
+	return queryStatus[types.Status](in)
+}
+
+// StatusPricesStream is like StatusPrices, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusPricesStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Modes = SMPrices
+	return streamStatus[types.Status](ctx, in)
+}
+
 // StatusSome implements the chifra status some command.
 func (opts *StatusOptions) StatusSome() ([]types.Status, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -157,6 +291,13 @@ func (opts *StatusOptions) StatusSome() ([]types.Status, *types.MetaData, error)
 	return queryStatus[types.Status](in)
 }
 
+// StatusSomeStream is like StatusSome, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusSomeStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Modes = SMSome
+	return streamStatus[types.Status](ctx, in)
+}
+
 // StatusAll implements the chifra status all command.
 func (opts *StatusOptions) StatusAll() ([]types.Status, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -164,6 +305,13 @@ func (opts *StatusOptions) StatusAll() ([]types.Status, *types.MetaData, error)
 	return queryStatus[types.Status](in)
 }
 
+// StatusAllStream is like StatusAll, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusAllStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Modes = SMAll
+	return streamStatus[types.Status](ctx, in)
+}
+
 // StatusDiagnose implements the chifra status --diagnose command.
 func (opts *StatusOptions) StatusDiagnose() ([]types.Status, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -171,6 +319,13 @@ func (opts *StatusOptions) StatusDiagnose() ([]types.Status, *types.MetaData, er
 	return queryStatus[types.Status](in)
 }
 
+// StatusDiagnoseStream is like StatusDiagnose, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusDiagnoseStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Diagnose = true
+	return streamStatus[types.Status](ctx, in)
+}
+
 // StatusHealthcheck implements the chifra status --healthcheck command.
 func (opts *StatusOptions) StatusHealthcheck() ([]types.Status, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -178,6 +333,13 @@ func (opts *StatusOptions) StatusHealthcheck() ([]types.Status, *types.MetaData,
 	return queryStatus[types.Status](in)
 }
 
+// StatusHealthcheckStream is like StatusHealthcheck, but delivers each item as it is produced.
+func (opts *StatusOptions) StatusHealthcheckStream(ctx context.Context) Seq2[types.Status] {
+	in := opts.toInternal()
+	in.Healthcheck = true
+	return streamStatus[types.Status](ctx, in)
+}
+
 type StatusModes int
 
 const (
@@ -199,8 +361,9 @@ const (
 	SMStaging
 	SMUnripe
 	SMMaps
+	SMPrices
 	SMSome = SMIndex | SMBlooms | SMBlocks | SMTransactions
-	SMAll  = SMIndex | SMBlooms | SMBlocks | SMTransactions | SMTraces | SMLogs | SMStatements | SMResults | SMState | SMTokens | SMMonitors | SMNames | SMAbis | SMSlurps | SMStaging | SMUnripe | SMMaps
+	SMAll  = SMIndex | SMBlooms | SMBlocks | SMTransactions | SMTraces | SMLogs | SMStatements | SMResults | SMState | SMTokens | SMMonitors | SMNames | SMAbis | SMSlurps | SMStaging | SMUnripe | SMMaps | SMPrices
 )
 
 func (v StatusModes) String() string {
@@ -231,10 +394,11 @@ func (v StatusModes) String() string {
 		SMStaging:      "staging",
 		SMUnripe:       "unripe",
 		SMMaps:         "maps",
+		SMPrices:       "prices",
 	}
 
 	var ret []string
-	for _, val := range []StatusModes{SMIndex, SMBlooms, SMBlocks, SMTransactions, SMTraces, SMLogs, SMStatements, SMResults, SMState, SMTokens, SMMonitors, SMNames, SMAbis, SMSlurps, SMStaging, SMUnripe, SMMaps} {
+	for _, val := range []StatusModes{SMIndex, SMBlooms, SMBlocks, SMTransactions, SMTraces, SMLogs, SMStatements, SMResults, SMState, SMTokens, SMMonitors, SMNames, SMAbis, SMSlurps, SMStaging, SMUnripe, SMMaps, SMPrices} {
 		if v&val != 0 {
 			ret = append(ret, m[val])
 		}
@@ -291,6 +455,8 @@ func enumFromStatusModes(values []string) (StatusModes, error) {
 			result |= SMUnripe
 		case "maps":
 			result |= SMMaps
+		case "prices":
+			result |= SMPrices
 		default:
 			return NoSM, fmt.Errorf("unknown modes: %s", val)
 		}
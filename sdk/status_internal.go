@@ -12,6 +12,7 @@ import (
 	// EXISTING_CODE
 
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -108,6 +109,13 @@ func queryStatus[T statusGeneric](opts *statusOptionsInternal) ([]T, *types.Meta
 	}
 }
 
+func streamStatus[T statusGeneric](ctx context.Context, opts *statusOptionsInternal) Seq2[T] {
+	// EXISTING_CODE
+	// EXISTING_CODE
+
+	return streamModels[T](ctx, opts.StatusBytes)
+}
+
 // toInternal converts the SDK options to the internal options format.
 func (opts *StatusOptions) toInternal() *statusOptionsInternal {
 	return &statusOptionsInternal{
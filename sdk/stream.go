@@ -0,0 +1,72 @@
+// Copyright 2016, 2024 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// Seq2 is an iterator over the items produced by a streaming SDK call. It has the same shape as
+// iter.Seq2[T, error] so that, with Go 1.23 or later, callers may range over it directly:
+//
+//	for tx, err := range opts.ExportStream(ctx) {
+//		...
+//	}
+//
+// Each item is delivered as soon as chifra produces it. Non-fatal errors (which chifra would
+// otherwise report in the `errors` array of the JSON output) are delivered with a zero item and
+// the iteration continues. Breaking out of the loop or cancelling ctx stops the underlying command.
+type Seq2[T any] func(yield func(T, error) bool)
+
+var errStopped = errors.New("stream stopped by caller")
+
+// streamModels runs a command (through its Bytes function) with an output.ModelWriter so that
+// each model is handed to the caller directly rather than being rendered to and parsed from JSON.
+func streamModels[T any](ctx context.Context, run func(w io.Writer) error) Seq2[T] {
+	return func(yield func(T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var zero T
+		stopped := false
+		send := func(item T, err error) error {
+			if stopped {
+				return errStopped
+			}
+			if !yield(item, err) {
+				stopped = true
+				cancel()
+				return errStopped
+			}
+			return nil
+		}
+
+		w := output.NewModelWriter(ctx,
+			func(m types.Modeler) error {
+				switch v := any(m).(type) {
+				case *T:
+					return send(*v, nil)
+				case T:
+					return send(v, nil)
+				default:
+					return send(zero, fmt.Errorf("unexpected type %T in stream of %T", m, zero))
+				}
+			},
+			func(err error) error {
+				return send(zero, err)
+			},
+		)
+
+		if err := run(w); err != nil && !stopped && !errors.Is(err, errStopped) {
+			yield(zero, err)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2016, 2024 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// endlessBlocks runs like a chifra command that produces blocks until its context is cancelled. The
+// done channel is closed when its fetcher returns.
+func endlessBlocks(done chan bool) func(w io.Writer) error {
+	return func(w io.Writer) error {
+		ctx := output.ContextFor(w)
+		fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
+			defer close(done)
+			for bn := base.Blknum(0); ; bn++ {
+				select {
+				case <-ctx.Done():
+					return
+				case modelChan <- &types.LightBlock{BlockNumber: bn}:
+				}
+			}
+		}
+		return output.StreamMany(ctx, fetchData, output.OutputOptions{Writer: w, Format: "json"})
+	}
+}
+
+func TestStreamStopsFetcherOnBreak(t *testing.T) {
+	done := make(chan bool)
+	seen := []base.Blknum{}
+	streamModels[types.LightBlock](context.Background(), endlessBlocks(done))(func(block types.LightBlock, err error) bool {
+		if err != nil {
+			t.Fatal(err)
+		}
+		seen = append(seen, block.BlockNumber)
+		return len(seen) < 3 // the consumer breaks out of the loop
+	})
+
+	if len(seen) != 3 || seen[2] != 2 {
+		t.Error("expected the first three blocks, got", seen)
+	}
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the fetcher is still running after the consumer stopped")
+	}
+}
+
+func TestStreamStopsFetcherOnCancel(t *testing.T) {
+	done := make(chan bool)
+	ctx, cancel := context.WithCancel(context.Background())
+	count := 0
+	streamModels[types.LightBlock](ctx, endlessBlocks(done))(func(block types.LightBlock, err error) bool {
+		if count++; count == 3 {
+			cancel()
+		}
+		return true
+	})
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the fetcher is still running after the context was cancelled")
+	}
+}
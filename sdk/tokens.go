@@ -10,6 +10,7 @@ package sdk
 
 import (
 	// EXISTING_CODE
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -40,6 +41,12 @@ func (opts *TokensOptions) Tokens() ([]types.Token, *types.MetaData, error) {
 	return queryTokens[types.Token](in)
 }
 
+// TokensStream is like Tokens, but delivers each item as it is produced.
+func (opts *TokensOptions) TokensStream(ctx context.Context) Seq2[types.Token] {
+	in := opts.toInternal()
+	return streamTokens[types.Token](ctx, in)
+}
+
 type TokensParts int
 
 const (
@@ -11,6 +11,7 @@ package sdk
 import (
 	// EXISTING_CODE
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -107,6 +108,13 @@ func queryTokens[T tokensGeneric](opts *tokensOptionsInternal) ([]T, *types.Meta
 	}
 }
 
+func streamTokens[T tokensGeneric](ctx context.Context, opts *tokensOptionsInternal) Seq2[T] {
+	// EXISTING_CODE
+	// EXISTING_CODE
+
+	return streamModels[T](ctx, opts.TokensBytes)
+}
+
 // toInternal converts the SDK options to the internal options format.
 func (opts *TokensOptions) toInternal() *tokensOptionsInternal {
 	return &tokensOptionsInternal{
@@ -10,6 +10,7 @@ package sdk
 
 import (
 	// EXISTING_CODE
+	"context"
 	"encoding/json"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
@@ -35,6 +36,12 @@ func (opts *TracesOptions) Traces() ([]types.Trace, *types.MetaData, error) {
 	return queryTraces[types.Trace](in)
 }
 
+// TracesStream is like Traces, but delivers each item as it is produced.
+func (opts *TracesOptions) TracesStream(ctx context.Context) Seq2[types.Trace] {
+	in := opts.toInternal()
+	return streamTraces[types.Trace](ctx, in)
+}
+
 // TracesCount implements the chifra traces --count command.
 func (opts *TracesOptions) TracesCount() ([]types.TraceCount, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -42,6 +49,13 @@ func (opts *TracesOptions) TracesCount() ([]types.TraceCount, *types.MetaData, e
 	return queryTraces[types.TraceCount](in)
 }
 
+// TracesCountStream is like TracesCount, but delivers each item as it is produced.
+func (opts *TracesOptions) TracesCountStream(ctx context.Context) Seq2[types.TraceCount] {
+	in := opts.toInternal()
+	in.Count = true
+	return streamTraces[types.TraceCount](ctx, in)
+}
+
 // No enums
 // EXISTING_CODE
 // EXISTING_CODE
@@ -11,6 +11,7 @@ package sdk
 import (
 	// EXISTING_CODE
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -100,6 +101,13 @@ func queryTraces[T tracesGeneric](opts *tracesOptionsInternal) ([]T, *types.Meta
 	}
 }
 
+func streamTraces[T tracesGeneric](ctx context.Context, opts *tracesOptionsInternal) Seq2[T] {
+	// EXISTING_CODE
+	// EXISTING_CODE
+
+	return streamModels[T](ctx, opts.TracesBytes)
+}
+
 // toInternal converts the SDK options to the internal options format.
 func (opts *TracesOptions) toInternal() *tracesOptionsInternal {
 	return &tracesOptionsInternal{
@@ -10,6 +10,7 @@ package sdk
 
 import (
 	// EXISTING_CODE
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -40,6 +41,12 @@ func (opts *TransactionsOptions) Transactions() ([]types.Transaction, *types.Met
 	return queryTransactions[types.Transaction](in)
 }
 
+// TransactionsStream is like Transactions, but delivers each item as it is produced.
+func (opts *TransactionsOptions) TransactionsStream(ctx context.Context) Seq2[types.Transaction] {
+	in := opts.toInternal()
+	return streamTransactions[types.Transaction](ctx, in)
+}
+
 // TransactionsTraces implements the chifra transactions --traces command.
 func (opts *TransactionsOptions) TransactionsTraces() ([]types.Trace, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -47,6 +54,13 @@ func (opts *TransactionsOptions) TransactionsTraces() ([]types.Trace, *types.Met
 	return queryTransactions[types.Trace](in)
 }
 
+// TransactionsTracesStream is like TransactionsTraces, but delivers each item as it is produced.
+func (opts *TransactionsOptions) TransactionsTracesStream(ctx context.Context) Seq2[types.Trace] {
+	in := opts.toInternal()
+	in.Traces = true
+	return streamTransactions[types.Trace](ctx, in)
+}
+
 // TransactionsUniq implements the chifra transactions --uniq command.
 func (opts *TransactionsOptions) TransactionsUniq() ([]types.Appearance, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -54,6 +68,13 @@ func (opts *TransactionsOptions) TransactionsUniq() ([]types.Appearance, *types.
 	return queryTransactions[types.Appearance](in)
 }
 
+// TransactionsUniqStream is like TransactionsUniq, but delivers each item as it is produced.
+func (opts *TransactionsOptions) TransactionsUniqStream(ctx context.Context) Seq2[types.Appearance] {
+	in := opts.toInternal()
+	in.Uniq = true
+	return streamTransactions[types.Appearance](ctx, in)
+}
+
 // TransactionsLogs implements the chifra transactions --logs command.
 func (opts *TransactionsOptions) TransactionsLogs() ([]types.Log, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -61,6 +82,13 @@ func (opts *TransactionsOptions) TransactionsLogs() ([]types.Log, *types.MetaDat
 	return queryTransactions[types.Log](in)
 }
 
+// TransactionsLogsStream is like TransactionsLogs, but delivers each item as it is produced.
+func (opts *TransactionsOptions) TransactionsLogsStream(ctx context.Context) Seq2[types.Log] {
+	in := opts.toInternal()
+	in.Logs = true
+	return streamTransactions[types.Log](ctx, in)
+}
+
 type TransactionsFlow int
 
 const (
@@ -11,6 +11,7 @@ package sdk
 import (
 	// EXISTING_CODE
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -115,6 +116,13 @@ func queryTransactions[T transactionsGeneric](opts *transactionsOptionsInternal)
 	}
 }
 
+func streamTransactions[T transactionsGeneric](ctx context.Context, opts *transactionsOptionsInternal) Seq2[T] {
+	// EXISTING_CODE
+	// EXISTING_CODE
+
+	return streamModels[T](ctx, opts.TransactionsBytes)
+}
+
 // toInternal converts the SDK options to the internal options format.
 func (opts *TransactionsOptions) toInternal() *transactionsOptionsInternal {
 	return &transactionsOptionsInternal{
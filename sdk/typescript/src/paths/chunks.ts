@@ -19,6 +19,7 @@ export function getChunks(
     publish?: boolean,
     remote?: boolean,
     belongs?: address[],
+    shard?: boolean,
     firstBlock?: blknum,
     lastBlock?: blknum,
     maxAddrs?: uint64,
@@ -8,7 +8,7 @@
  */
 
 import * as ApiCallers from '../lib/api_callers';
-import { address, Appearance, blknum, fourbyte, Log, Message, Monitor, Receipt, State, Statement, topic, Trace, Transaction, uint64, Withdrawal } from '../types';
+import { address, Appearance, blknum, Disposal, fourbyte, Log, Message, Monitor, Receipt, State, Statement, topic, Trace, Transaction, uint64, Withdrawal } from '../types';
 
 export function getExport(
   parameters?: {
@@ -22,6 +22,8 @@ export function getExport(
     neighbors?: boolean,
     accounting?: boolean,
     statements?: boolean,
+    lots?: boolean,
+    basis?: 'fifo' | 'lifo' | 'hifo' | 'average',
     balances?: boolean,
     withdrawals?: boolean,
     articulate?: boolean,
@@ -50,7 +52,7 @@ export function getExport(
   },
   options?: RequestInit,
 ) {
-  return ApiCallers.fetch<Appearance[] | Log[] | Message[] | Monitor[] | Receipt[] | State[] | Statement[] | Trace[] | Transaction[] | Withdrawal[]>(
+  return ApiCallers.fetch<Appearance[] | Disposal[] | Log[] | Message[] | Monitor[] | Receipt[] | State[] | Statement[] | Trace[] | Transaction[] | Withdrawal[]>(
     { endpoint: '/export', method: 'get', parameters, options },
   );
 }
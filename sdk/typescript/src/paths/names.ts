@@ -24,6 +24,11 @@ export function getNames(
     regular?: boolean,
     dryRun?: boolean,
     autoname?: address,
+    autonameList?: string,
+    neighbors?: boolean,
+    import?: string,
+    export?: string,
+    merge?: 'mine' | 'theirs' | 'tags',
     create?: boolean,
     update?: boolean,
     delete?: boolean,
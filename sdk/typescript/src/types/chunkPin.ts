@@ -3,7 +3,7 @@
 /*
  * This file was generated with makeClass --sdk. Do not edit it.
  */
-import { ipfshash } from '.';
+import { address, hash, ipfshash } from '.';
 
 export type ChunkPin = {
   version: string
@@ -11,4 +11,6 @@ export type ChunkPin = {
   timestampHash: ipfshash
   manifestHash: ipfshash
   specHash: ipfshash
+  publisher: address
+  transactionHash: hash
 }
@@ -13,6 +13,7 @@ export * from './chunkPin';
 export * from './chunkRecord';
 export * from './chunkStats';
 export * from './config';
+export * from './disposal';
 export * from './function';
 export * from './ipfsPin';
 export * from './log';
@@ -47,4 +47,5 @@ export type Statement = {
   endBalDiff?: int256
   endBalCalc?: int256
   correctingReason?: string
+  tokenId?: int256
 }
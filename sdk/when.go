@@ -10,6 +10,7 @@ package sdk
 
 import (
 	// EXISTING_CODE
+	"context"
 	"encoding/json"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
@@ -39,6 +40,12 @@ func (opts *WhenOptions) When() ([]types.NamedBlock, *types.MetaData, error) {
 	return queryWhen[types.NamedBlock](in)
 }
 
+// WhenStream is like When, but delivers each item as it is produced.
+func (opts *WhenOptions) WhenStream(ctx context.Context) Seq2[types.NamedBlock] {
+	in := opts.toInternal()
+	return streamWhen[types.NamedBlock](ctx, in)
+}
+
 // WhenList implements the chifra when --list command.
 func (opts *WhenOptions) WhenList() ([]types.NamedBlock, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -46,6 +53,13 @@ func (opts *WhenOptions) WhenList() ([]types.NamedBlock, *types.MetaData, error)
 	return queryWhen[types.NamedBlock](in)
 }
 
+// WhenListStream is like WhenList, but delivers each item as it is produced.
+func (opts *WhenOptions) WhenListStream(ctx context.Context) Seq2[types.NamedBlock] {
+	in := opts.toInternal()
+	in.List = true
+	return streamWhen[types.NamedBlock](ctx, in)
+}
+
 // WhenTimestamps implements the chifra when --timestamps command.
 func (opts *WhenOptions) WhenTimestamps() ([]types.Timestamp, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -53,6 +67,13 @@ func (opts *WhenOptions) WhenTimestamps() ([]types.Timestamp, *types.MetaData, e
 	return queryWhen[types.Timestamp](in)
 }
 
+// WhenTimestampsStream is like WhenTimestamps, but delivers each item as it is produced.
+func (opts *WhenOptions) WhenTimestampsStream(ctx context.Context) Seq2[types.Timestamp] {
+	in := opts.toInternal()
+	in.Timestamps = true
+	return streamWhen[types.Timestamp](ctx, in)
+}
+
 // WhenCount implements the chifra when --count command.
 func (opts *WhenOptions) WhenCount() ([]types.TimestampCount, *types.MetaData, error) {
 	in := opts.toInternal()
@@ -60,6 +81,13 @@ func (opts *WhenOptions) WhenCount() ([]types.TimestampCount, *types.MetaData, e
 	return queryWhen[types.TimestampCount](in)
 }
 
+// WhenCountStream is like WhenCount, but delivers each item as it is produced.
+func (opts *WhenOptions) WhenCountStream(ctx context.Context) Seq2[types.TimestampCount] {
+	in := opts.toInternal()
+	in.Count = true
+	return streamWhen[types.TimestampCount](ctx, in)
+}
+
 // No enums
 // EXISTING_CODE
 // EXISTING_CODE
@@ -11,6 +11,7 @@ package sdk
 import (
 	// EXISTING_CODE
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -103,6 +104,13 @@ func queryWhen[T whenGeneric](opts *whenOptionsInternal) ([]T, *types.MetaData,
 	}
 }
 
+func streamWhen[T whenGeneric](ctx context.Context, opts *whenOptionsInternal) Seq2[T] {
+	// EXISTING_CODE
+	// EXISTING_CODE
+
+	return streamModels[T](ctx, opts.WhenBytes)
+}
+
 // toInternal converts the SDK options to the internal options format.
 func (opts *WhenOptions) toInternal() *whenOptionsInternal {
 	return &whenOptionsInternal{
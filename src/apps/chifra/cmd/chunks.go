@@ -55,9 +55,10 @@ Notes:
   - The --belongs option is only available in the index mode.
   - The --first_block and --last_block options apply only to addresses, appearances, and index --belongs mode.
   - The --pin option requires a locally running IPFS node or a pinning service API key.
-  - The --publish option requires a private key.
+  - The --publish option requires a keystore or private key in the [unchained] section of the config.
   - The --publisher option is ignored with the --publish option since the sender of the transaction is recorded as the publisher.
-  - Without --rewrite, the manifest is written to the temporary cache. With it, the manifest is rewritten to the index folder.`
+  - Without --rewrite, the manifest is written to the temporary cache. With it, the manifest is rewritten to the index folder.
+  - The --shard option only adds chunks not yet in the shards whose index files are local (see chifra init --all). Once built, chifra scrape keeps the shards up to date.`
 
 func init() {
 	var capabilities caps.Capability // capabilities for chifra chunks
@@ -72,6 +73,7 @@ func init() {
 	chunksCmd.Flags().Uint64VarP((*uint64)(&chunksPkg.GetOptions().Truncate), "truncate", "n", 0, `truncate the entire index at this block (requires a block identifier) (hidden)`)
 	chunksCmd.Flags().BoolVarP(&chunksPkg.GetOptions().Remote, "remote", "r", false, `prior to processing, retrieve the manifest from the Unchained Index smart contract`)
 	chunksCmd.Flags().StringSliceVarP(&chunksPkg.GetOptions().Belongs, "belongs", "b", nil, `in index mode only, checks the address(es) for inclusion in the given index chunk`)
+	chunksCmd.Flags().BoolVarP(&chunksPkg.GetOptions().Shard, "shard", "", false, `in index mode only, build or update the address shards used to skip chunks when freshening monitors`)
 	chunksCmd.Flags().BoolVarP(&chunksPkg.GetOptions().Diff, "diff", "f", false, `compare two index portions (see notes) (hidden)`)
 	chunksCmd.Flags().Uint64VarP((*uint64)(&chunksPkg.GetOptions().FirstBlock), "first_block", "F", 0, `first block to process (inclusive)`)
 	chunksCmd.Flags().Uint64VarP((*uint64)(&chunksPkg.GetOptions().LastBlock), "last_block", "L", 0, `last block to process (inclusive)`)
@@ -76,6 +76,9 @@ func init() {
 	exportCmd.Flags().BoolVarP(&exportPkg.GetOptions().Neighbors, "neighbors", "n", false, `export the neighbors of the given address`)
 	exportCmd.Flags().BoolVarP(&exportPkg.GetOptions().Accounting, "accounting", "C", false, `attach accounting records to the exported data (applies to transactions export only)`)
 	exportCmd.Flags().BoolVarP(&exportPkg.GetOptions().Statements, "statements", "A", false, `for the accounting options only, export only statements`)
+	exportCmd.Flags().BoolVarP(&exportPkg.GetOptions().Lots, "lots", "", false, `for the --statements option only, report the realized and unrealized gains of the address's tax lots`)
+	exportCmd.Flags().StringVarP(&exportPkg.GetOptions().Basis, "basis", "", "fifo", `for the --lots option only, the cost-basis method used to match disposals to lots
+One of [ fifo | lifo | hifo | average ]`)
 	exportCmd.Flags().BoolVarP(&exportPkg.GetOptions().Balances, "balances", "b", false, `traverse the transaction history and show each change in ETH balances`)
 	exportCmd.Flags().BoolVarP(&exportPkg.GetOptions().Withdrawals, "withdrawals", "i", false, `export withdrawals for the given address`)
 	exportCmd.Flags().BoolVarP(&exportPkg.GetOptions().Articulate, "articulate", "a", false, `articulate transactions, traces, logs, and outputs`)
@@ -47,7 +47,10 @@ const longNames = `Purpose:
 const notesNames = `
 Notes:
   - The tool will accept up to three terms, each of which must match against any field in the database.
-  - The --match_case option enables case sensitive matching.`
+  - The --match_case option enables case sensitive matching.
+  - Terms may name a field (tag:Exchange), end with * to match the start of a word, or end with ~ to match words with typos.
+  - The format of an --import or --export file is given by its extension: .csv, .tsv, .json, or .bundle (see below). Neither option is available in the API.
+  - The --autoname option recognizes tokens, proxies, Gnosis Safes, Uniswap pairs and pools, and ENS names (see below).`
 
 func init() {
 	var capabilities caps.Capability // capabilities for chifra names
@@ -64,13 +67,19 @@ func init() {
 	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Tags, "tags", "g", false, `export the list of tags and subtags only`)
 	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Clean, "clean", "C", false, `clean the data (addrs to lower case, sort by addr)`)
 	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Regular, "regular", "r", false, `only available with --clean, cleans regular names database`)
-	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().DryRun, "dry_run", "d", false, `only available with --clean or --autoname, outputs changes to stdout instead of updating databases`)
-	namesCmd.Flags().StringVarP(&namesPkg.GetOptions().Autoname, "autoname", "A", "", `an address assumed to be a token, added automatically to names database if true`)
+	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().DryRun, "dry_run", "d", false, `only available with --clean, --autoname, or --import, outputs changes to stdout instead of updating databases`)
+	namesCmd.Flags().StringVarP(&namesPkg.GetOptions().Autoname, "autoname", "A", "", `an address to name from what is found on chain (a token, proxy, multisig, pool, or ENS name)`)
+	namesCmd.Flags().StringVarP(&namesPkg.GetOptions().AutonameList, "autoname_list", "", "", `a file containing the addresses to name as with --autoname, one per line`)
+	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Neighbors, "neighbors", "", false, `for --autoname only, name the addresses found in the monitor for the address instead of the address itself`)
 	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Create, "create", "", false, `create a new name record (hidden)`)
 	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Update, "update", "", false, `edit an existing name (hidden)`)
 	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Delete, "delete", "", false, `delete a name, but do not remove it (hidden)`)
 	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Undelete, "undelete", "", false, `undelete a previously deleted name (hidden)`)
 	namesCmd.Flags().BoolVarP(&namesPkg.GetOptions().Remove, "remove", "", false, `remove a previously deleted name (hidden)`)
+	namesCmd.Flags().StringVarP(&namesPkg.GetOptions().Import, "import", "", "", `import the names in a csv, tsv, json, or signed bundle file into the custom names database`)
+	namesCmd.Flags().StringVarP(&namesPkg.GetOptions().Export, "export", "", "", `export the names that match the search to a csv, tsv, json, or signed bundle file`)
+	namesCmd.Flags().StringVarP(&namesPkg.GetOptions().Merge, "merge", "", "mine", `for --import only, resolve conflicts by keeping my values, their values, or mine with theirs added to the tags
+One of [ mine | theirs | tags ]`)
 	if os.Getenv("TEST_MODE") != "true" {
 		_ = namesCmd.Flags().MarkHidden("create")
 		_ = namesCmd.Flags().MarkHidden("update")
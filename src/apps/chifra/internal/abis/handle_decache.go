@@ -12,8 +12,8 @@ import (
 	"strings"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/abi"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/colors"
-	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/walk"
@@ -53,6 +53,7 @@ func (opts *AbisOptions) HandleDecache() error {
 									} else {
 										msg := fmt.Sprintf("%sAbi file %s removed: %d bytes.%s", colors.Green, fn, size, colors.Off)
 										logger.Warn(msg)
+										_ = os.Remove(strings.TrimSuffix(path, ".json") + ".source")
 									}
 								}
 							}
@@ -68,13 +69,10 @@ func (opts *AbisOptions) HandleDecache() error {
 		}
 	} else {
 		for _, addr := range opts.Addrs {
-			path := config.PathToCache(chain) + "abis/" + addr + ".json"
-			if file.FileExists(path) {
-				if err := os.Remove(path); err != nil {
-					logger.Warn(colors.Red+"Could not remove abi for address", addr, ":", err, "."+colors.Off)
-				} else {
-					logger.Info(colors.Green+"Abi file for address", addr, "removed."+colors.Off)
-				}
+			if removed, err := abi.RemoveAbi(chain, base.HexToAddress(addr)); err != nil {
+				logger.Warn(colors.Red+"Could not remove abi for address", addr, ":", err, "."+colors.Off)
+			} else if removed {
+				logger.Info(colors.Green+"Abi file for address", addr, "removed."+colors.Off)
 			}
 		}
 	}
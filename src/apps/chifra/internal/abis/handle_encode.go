@@ -5,7 +5,6 @@
 package abisPkg
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/abi"
@@ -16,7 +15,7 @@ import (
 )
 
 func (opts *AbisOptions) HandleEncode() error {
-	ctx := context.Background()
+	ctx := output.ContextFor(opts.Globals.Writer)
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		funcs := abi.ExtractSigs(opts.Encode)
 		if len(funcs) == 0 {
@@ -33,7 +33,7 @@ func (opts *AbisOptions) HandleFind() error {
 
 	// TODO: we might want to use utils.IterateOver Map here
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		var results []types.Function
 		var wg sync.WaitGroup
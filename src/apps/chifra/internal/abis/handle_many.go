@@ -15,7 +15,7 @@ import (
 )
 
 func (opts *AbisOptions) HandleMany() (err error) {
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		for _, addr := range opts.Addrs {
 			abiCache := articulate.NewAbiCache(opts.Conn, opts.Known)
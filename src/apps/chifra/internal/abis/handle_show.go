@@ -21,7 +21,7 @@ func (opts *AbisOptions) HandleShow() (err error) {
 
 	abiCache := articulate.NewAbiCache(opts.Conn, opts.Known)
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		// Note here, that known ABIs are not downloaded. They are only loaded from the local cache.
 		for _, addr := range opts.Addrs {
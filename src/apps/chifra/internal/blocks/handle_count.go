@@ -17,7 +17,7 @@ import (
 func (opts *BlocksOptions) HandleCount() error {
 	chain := opts.Globals.Chain
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		for _, br := range opts.BlockIds {
 			blockNums, err := br.ResolveBlocks(chain)
@@ -23,7 +23,7 @@ import (
 func (opts *ChunksOptions) HandleAddresses(blockNums []base.Blknum) error {
 	chain := opts.Globals.Chain
 	been_here := 0
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		var showAddresses func(walker *walk.CacheWalker, path string, first bool) (bool, error)
 		if opts.Globals.Verbose {
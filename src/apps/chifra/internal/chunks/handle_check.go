@@ -196,7 +196,7 @@ func (opts *ChunksOptions) check(blockNums []base.Blknum, silent bool) (error, b
 		nFailed += int(reports[i].FailedCnt)
 	}
 
-	ctx := context.Background()
+	ctx := output.ContextFor(opts.Globals.Writer)
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		for _, report := range reports {
 			if !silent {
@@ -22,7 +22,7 @@ func (opts *ChunksOptions) HandleIndex(blockNums []base.Blknum) error {
 	}
 
 	chain := opts.Globals.Chain
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		showIndex := func(walker *walk.CacheWalker, fileName string, first bool) (bool, error) {
 			if fileName != index.ToBloomPath(fileName) {
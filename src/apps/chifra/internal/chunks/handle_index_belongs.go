@@ -22,9 +22,30 @@ import (
 func (opts *ChunksOptions) HandleIndexBelongs(blockNums []base.Blknum) error {
 	chain := opts.Globals.Chain
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
+		// Chunks covered by the address shards are skipped unless they contain one of the addresses
+		var covered, containing map[base.FileRange]bool
+		if index.HasShards(chain) {
+			addrs := make([]base.Address, 0, len(opts.Belongs))
+			for _, belongs := range opts.Belongs {
+				addrs = append(addrs, base.HexToAddress(belongs))
+			}
+			var err error
+			if covered, err = index.ShardsCovered(chain); err == nil {
+				containing, err = index.ShardsContaining(chain, addrs)
+			}
+			if err != nil {
+				errorChan <- err
+				cancel()
+				return
+			}
+		}
+
 		showAddressesBelongs := func(walker *walk.CacheWalker, path string, first bool) (bool, error) {
+			if rng := base.RangeFromFilename(path); covered[rng] && !containing[rng] {
+				return true, nil
+			}
 			return opts.handleResolvedRecords(modelChan, walker, path)
 		}
 
@@ -1,7 +1,6 @@
 package chunksPkg
 
 import (
-	"context"
 	"sort"
 	"strings"
 	"time"
@@ -20,7 +19,7 @@ func (opts *ChunksOptions) HandleList(unusedBns []base.Blknum) error {
 		return nil
 	}
 
-	ctx := context.Background()
+	ctx := output.ContextFor(opts.Globals.Writer)
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		var perPage = 1000
 		if testMode {
@@ -5,8 +5,6 @@
 package chunksPkg
 
 import (
-	"context"
-
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/manifest"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
@@ -32,7 +30,7 @@ func (opts *ChunksOptions) HandleManifest(blockNums []base.Blknum) error {
 		man.Specification = "--testing-hash--"
 	}
 
-	ctx := context.Background()
+	ctx := output.ContextFor(opts.Globals.Writer)
 	if opts.Globals.Format == "txt" || opts.Globals.Format == "csv" {
 		fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 			for _, chunk := range man.Chunks {
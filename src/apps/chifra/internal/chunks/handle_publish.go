@@ -1,10 +1,110 @@
 package chunksPkg
 
 import (
+	"fmt"
+	"os"
+	"time"
+
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
-	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/validate"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/colors"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/manifest"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/pinning"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
 )
 
+// publishTimeout is how long we wait for the publishing transaction to be mined
+const publishTimeout = 5 * time.Minute
+
+// HandlePublish pins the local manifest and records its CID in the Unchained Index smart contract. The
+// transaction is sent from (and the manifest is therefore published by) the address of the key in the
+// [unchained] section of the config. If TB_CHUNKS_RAWTXPATH is set, the signed transaction is written to
+// that file instead of being sent. Otherwise, once the transaction is mined, we read the manifest's CID back
+// from the smart contract to make sure it was published.
 func (opts *ChunksOptions) HandlePublish(blockNums []base.Blknum) error {
-	return validate.Usage("Handler for {0} --publish not yet implemented.", opts.Mode)
+	chain := opts.Globals.Chain
+
+	key, err := manifest.PublisherKey()
+	if err != nil {
+		return err
+	}
+
+	local, err := manifest.ReadManifest(chain, opts.PublisherAddr, manifest.LocalCache)
+	if err != nil {
+		return err
+	}
+
+	rpcUrl := config.GetChain(manifest.UnchainedChain).RpcProvider
+	contract := base.HexToAddress(config.GetUnchained().SmartContract)
+	rawTxPath := os.Getenv("TB_CHUNKS_RAWTXPATH")
+
+	ctx := output.ContextFor(opts.Globals.Writer)
+	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
+		hash := base.BytesToHash(config.HeaderHash(config.ExpectedVersion()))
+		report := types.ChunkPin{
+			Version:  config.VersionTags[hash.Hex()],
+			Chain:    chain,
+			SpecHash: base.IpfsHash(manifest.Specification()),
+		}
+
+		manPath := config.PathToManifest(chain)
+		localHash, remoteHash, err := pinning.PinOneFile(chain, "manifest", manPath, opts.Remote)
+		if err != nil {
+			errorChan <- err
+			return
+		}
+		opts.matchReport(localHash == remoteHash, localHash, remoteHash)
+		report.ManifestHash = localHash
+
+		pub, err := manifest.NewPublication(rpcUrl, contract, key, chain, localHash.String())
+		if err != nil {
+			errorChan <- fmt.Errorf("building the publishing transaction: %w", err)
+			return
+		}
+		report.Publisher = pub.Publisher
+		report.TransactionHash = pub.Hash()
+
+		if len(rawTxPath) > 0 {
+			raw, err := pub.RawTransaction()
+			if err == nil {
+				err = os.WriteFile(rawTxPath, []byte(raw+"\n"), 0644)
+			}
+			if err != nil {
+				errorChan <- err
+				return
+			}
+			logger.Info("The signed transaction was written to", colors.BrightGreen+rawTxPath+colors.Off)
+			modelChan <- &report
+			return
+		}
+
+		if err := pub.Broadcast(rpcUrl); err != nil {
+			errorChan <- fmt.Errorf("sending the publishing transaction: %w", err)
+			return
+		}
+		logger.Info("Sent transaction", report.TransactionHash.Hex(), "from", report.Publisher.Hex())
+
+		bn, err := pub.WaitForReceipt(rpcUrl, publishTimeout)
+		if err != nil {
+			errorChan <- err
+			return
+		}
+		logger.Info("Transaction mined in block", bn)
+
+		// Read only the CID so as not to overwrite the local manifest with the published one
+		if cid, err := manifest.ReadUnchainedIndex(chain, pub.Publisher, chain); err != nil {
+			errorChan <- fmt.Errorf("reading the published manifest: %w", err)
+			return
+		} else if cid != localHash.String() {
+			errorChan <- fmt.Errorf("the smart contract records manifest %s, expected %s", cid, localHash)
+			return
+		}
+		logger.Info(colors.BrightGreen+"Published", localHash, "for", chain, "with", len(local.Chunks), "chunks", colors.Off)
+
+		modelChan <- &report
+	}
+
+	return output.StreamMany(ctx, fetchData, opts.Globals.OutputOpts())
 }
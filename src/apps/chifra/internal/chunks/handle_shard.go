@@ -0,0 +1,139 @@
+package chunksPkg
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/index"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/walk"
+)
+
+// HandleShard adds the addresses of any chunk not yet covered by the address shards to the shards.
+// Chunks already covered are skipped, so this may be run repeatedly (or interrupted and restarted).
+func (opts *ChunksOptions) HandleShard(blockNums []base.Blknum) error {
+	chain := opts.Globals.Chain
+	if opts.Globals.TestMode {
+		logger.Warn("Shard option not tested.")
+		return nil
+	}
+
+	covered, err := index.ShardsCovered(chain)
+	if err != nil {
+		return err
+	}
+
+	bar := logger.NewBar(logger.BarOptions{
+		Enabled: opts.Globals.ShowProgressNotTesting(),
+		Total:   128,
+		Type:    logger.Expanding,
+	})
+
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
+	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
+		nSharded, nSkipped, nNotLocal, nAddrs := 0, 0, 0, 0
+		shardChunk := func(walker *walk.CacheWalker, path string, first bool) (bool, error) {
+			if path != index.ToBloomPath(path) {
+				logger.Fatal("should not happen ==> we're spinning through the bloom filters")
+			}
+
+			if strings.HasSuffix(path, ".gz") {
+				return true, nil
+			}
+
+			rng, err := base.RangeFromFilenameE(path)
+			if err != nil {
+				return false, err
+			}
+
+			if covered[rng] {
+				nSkipped++
+				bar.Prefix = fmt.Sprintf("Skipping %s", rng)
+				bar.Tick()
+				return true, nil
+			}
+
+			// Unless the whole index was downloaded (chifra init --all), most index files are not local
+			indexPath := index.ToIndexPath(path)
+			if !file.FileExists(indexPath) {
+				nNotLocal++
+				bar.Prefix = fmt.Sprintf("Not local %s", rng)
+				bar.Tick()
+				return true, nil
+			}
+
+			addrs, err := readChunkAddresses(indexPath)
+			if err != nil {
+				return false, err
+			}
+			if err = index.ShardChunk(chain, rng, addrs); err != nil {
+				return false, err
+			}
+
+			nSharded++
+			nAddrs += len(addrs)
+			bar.Prefix = fmt.Sprintf("Sharded %s", rng)
+			bar.Tick()
+			return true, nil
+		}
+
+		walker := walk.NewCacheWalker(
+			chain,
+			opts.Globals.TestMode,
+			100, /* maxTests */
+			shardChunk,
+		)
+		if err := walker.WalkBloomFilters(blockNums); err != nil {
+			errorChan <- err
+			cancel()
+			return
+		}
+		bar.Finish(true /* newLine */)
+
+		msg := fmt.Sprintf("Added %d addresses from %d chunks to the shards, %d chunks were already covered.", nAddrs, nSharded, nSkipped)
+		if nNotLocal > 0 {
+			msg += fmt.Sprintf(" %d chunks were skipped as their index files are not local (see chifra init --all).", nNotLocal)
+		}
+		if opts.Globals.Format == "json" {
+			modelChan <- &types.Message{
+				Msg: msg,
+			}
+		} else {
+			logger.Info(msg)
+		}
+	}
+
+	opts.Globals.NoHeader = true
+	return output.StreamMany(ctx, fetchData, opts.Globals.OutputOpts())
+}
+
+// readChunkAddresses returns the addresses in the chunk's address table
+func readChunkAddresses(path string) ([]base.Address, error) {
+	indexChunk, err := index.OpenIndex(path, true /* check */)
+	if err != nil {
+		return nil, err
+	}
+	defer indexChunk.Close()
+
+	if _, err = indexChunk.File.Seek(int64(index.HeaderWidth), io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	records := make([]types.AddrRecord, indexChunk.Header.AddressCount)
+	if err = binary.Read(indexChunk.File, binary.LittleEndian, records); err != nil {
+		return nil, err
+	}
+
+	addrs := make([]base.Address, 0, len(records))
+	for _, record := range records {
+		addrs = append(addrs, record.Address)
+	}
+	return addrs, nil
+}
@@ -17,7 +17,7 @@ import (
 
 func (opts *ChunksOptions) HandleStats(blockNums []base.Blknum) error {
 	chain := opts.Globals.Chain
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		showFinalizedStats := func(walker *walk.CacheWalker, path string, first bool) (bool, error) {
 			if path != index.ToBloomPath(path) {
@@ -33,7 +33,7 @@ func (opts *ChunksOptions) HandleTag(blockNums []base.Blknum) error {
 	_ = file.CleanFolder(chain, config.PathToIndex(chain), []string{"ripe", "unripe", "maps", "staging"})
 
 	userHitCtrlC := false
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
 
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		nChunksTagged := 0
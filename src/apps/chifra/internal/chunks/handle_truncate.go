@@ -44,7 +44,7 @@ func (opts *ChunksOptions) HandleTruncate(blockNums []base.Blknum) error {
 		Type:    logger.Expanding,
 	})
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 
 		// First, we will remove the chunks and update the manifest. We do this separately for
@@ -95,6 +95,14 @@ func (opts *ChunksOptions) HandleTruncate(blockNums []base.Blknum) error {
 			cancel()
 
 		} else {
+			if index.HasShards(chain) {
+				if err := index.UncoverShards(chain, opts.Truncate); err != nil {
+					errorChan <- err
+					cancel()
+					return
+				}
+			}
+
 			bar.Prefix = fmt.Sprintf("Truncated to %d                    ", opts.Truncate)
 			bar.Finish(true /* newLine */)
 			bar = logger.NewBar(logger.BarOptions{
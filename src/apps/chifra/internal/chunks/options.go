@@ -46,6 +46,7 @@ type ChunksOptions struct {
 	Truncate   base.Blknum              `json:"truncate,omitempty"`   // Truncate the entire index at this block (requires a block identifier)
 	Remote     bool                     `json:"remote,omitempty"`     // Prior to processing, retrieve the manifest from the Unchained Index smart contract
 	Belongs    []string                 `json:"belongs,omitempty"`    // In index mode only, checks the address(es) for inclusion in the given index chunk
+	Shard      bool                     `json:"shard,omitempty"`      // In index mode only, build or update the address shards used to skip chunks when freshening monitors
 	Diff       bool                     `json:"diff,omitempty"`       // Compare two index portions (see notes)
 	FirstBlock base.Blknum              `json:"firstBlock,omitempty"` // First block to process (inclusive)
 	LastBlock  base.Blknum              `json:"lastBlock,omitempty"`  // Last block to process (inclusive)
@@ -82,6 +83,7 @@ func (opts *ChunksOptions) testLog() {
 	logger.TestLog(opts.Truncate != base.NOPOSN, "Truncate: ", opts.Truncate)
 	logger.TestLog(opts.Remote, "Remote: ", opts.Remote)
 	logger.TestLog(len(opts.Belongs) > 0, "Belongs: ", opts.Belongs)
+	logger.TestLog(opts.Shard, "Shard: ", opts.Shard)
 	logger.TestLog(opts.Diff, "Diff: ", opts.Diff)
 	logger.TestLog(opts.FirstBlock != 0, "FirstBlock: ", opts.FirstBlock)
 	logger.TestLog(opts.LastBlock != base.NOPOSN && opts.LastBlock != 0, "LastBlock: ", opts.LastBlock)
@@ -145,6 +147,8 @@ func ChunksFinishParseInternal(w io.Writer, values url.Values) *ChunksOptions {
 				s := strings.Split(val, " ") // may contain space separated items
 				opts.Belongs = append(opts.Belongs, s...)
 			}
+		case "shard":
+			opts.Shard = true
 		case "diff":
 			opts.Diff = true
 		case "firstBlock":
@@ -77,6 +77,8 @@ func (opts *ChunksOptions) ChunksInternal() error {
 		err = opts.HandlePublish(blockNums)
 	} else if opts.Truncate != base.NOPOSN {
 		err = opts.HandleTruncate(blockNums)
+	} else if opts.Shard {
+		err = opts.HandleShard(blockNums)
 	} else {
 		err = opts.HandleShow(blockNums)
 	}
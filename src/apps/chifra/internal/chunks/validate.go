@@ -14,6 +14,7 @@ import (
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/index"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/manifest"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/pinning"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/validate"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/version"
@@ -35,6 +36,9 @@ func (opts *ChunksOptions) validateChunks() error {
 		if opts.Truncate != base.NOPOSN {
 			return validate.Usage("The {0} option is not available{1}.", "--truncate", " in api mode")
 		}
+		if opts.Shard {
+			return validate.Usage("The {0} option is not available{1}.", "--shard", " in api mode")
+		}
 		if opts.Mode == "pins" {
 			return validate.Usage("The {0} mode is not available{1}.", "pins", " in api mode")
 		}
@@ -130,7 +134,15 @@ func (opts *ChunksOptions) validateChunks() error {
 		return validate.Usage("The {0} options require {1}.", "--remote and --deep", "--pin or --check")
 	}
 
-	if isPin {
+	if isPin && isPublish {
+		return validate.Usage("Choose either {0} or {1}, not both.", "--pin", "--publish")
+	}
+
+	if isPin || isPublish {
+		option := "--pin"
+		if isPublish {
+			option = "--publish"
+		}
 		if isRemote {
 			apiKey, secret, jwt := config.GetKey("pinata").ApiKey, config.GetKey("pinata").Secret, config.GetKey("pinata").Jwt
 			if secret == "" && jwt == "" {
@@ -140,22 +152,37 @@ func (opts *ChunksOptions) validateChunks() error {
 				return validate.Usage("If the {0} key is present, so must be the {1}.", "secret", "apiKey")
 			}
 			if config.GetPinning().RemotePinUrl == "" {
-				return validate.Usage("The {0} option requires {1}.", "--pin --remote", "a remotePinUrl")
+				return validate.Usage("The {0} option requires {1}.", option+" --remote", "a remotePinUrl")
 			}
 		} else {
 			if !config.IpfsRunning() {
-				return validate.Usage("The {0} option requires {1}.", "--pin", "a locally running IPFS daemon")
+				return validate.Usage("The {0} option requires {1}.", option, "a locally running IPFS daemon")
 			}
 			if config.GetPinning().LocalPinUrl == "" {
-				return validate.Usage("The {0} option requires {1}.", "--pin", "a localPinUrl")
+				return validate.Usage("The {0} option requires {1}.", option, "a localPinUrl")
 			}
 		}
-	} else if isRewrite {
+	}
+
+	if isRewrite && !isPin {
 		return validate.Usage("The {0} option requires {1}.", "--rewrite", "--pin")
 	}
 
-	if opts.Publish {
-		return validate.Usage("The {0} option is currenlty unavailable.", "--publish")
+	if isPublish {
+		if opts.Mode != "manifest" {
+			return validate.Usage("The {0} option is only available in {1} mode.", "--publish", "manifest")
+		}
+		if !file.FileExists(config.PathToManifest(chain)) {
+			return validate.Usage("The {0} option requires {1}.", "--publish", "a local manifest")
+		}
+		if _, err := manifest.PublisherKey(); errors.Is(err, manifest.ErrNoPublisherKey) {
+			return validate.Usage("The {0} option requires {1}.", "--publish", "a keystore or privateKey in the [unchained] section of the config")
+		} else if err != nil {
+			return err
+		}
+		if !base.IsValidAddress(config.GetUnchained().SmartContract) {
+			return validate.Usage("The {0} option requires {1}.", "--publish", "a valid smartContract in the [unchained] section of the config")
+		}
 	}
 
 	if opts.Mode != "index" {
@@ -168,8 +195,17 @@ func (opts *ChunksOptions) validateChunks() error {
 		if len(opts.Belongs) > 0 {
 			return validate.Usage("The {0} option requires {1}.", "--belongs", "the index mode")
 		}
+		if opts.Shard {
+			return validate.Usage("The {0} option is only available {1}.", "--shard", "in index mode")
+		}
 
 	} else {
+		if opts.Shard && len(opts.Belongs) > 0 {
+			return validate.Usage("Choose either {0} or {1}, not both.", "--shard", "--belongs")
+		}
+		if opts.Shard && opts.Truncate != base.NOPOSN {
+			return validate.Usage("Choose either {0} or {1}, not both.", "--shard", "--truncate")
+		}
 		if len(opts.Belongs) > 0 {
 			err := validate.ValidateAtLeastOneAddr(opts.Belongs)
 			if err != nil {
@@ -1,8 +1,6 @@
 package configPkg
 
 import (
-	"context"
-
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
@@ -12,7 +10,7 @@ import (
 func (opts *ConfigOptions) HandlePaths() error {
 	testMode := opts.Globals.TestMode
 
-	ctx := context.Background()
+	ctx := output.ContextFor(opts.Globals.Writer)
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		root, cache, index := config.PathToRootConfig(),
 			config.PathToCache(opts.Globals.Chain),
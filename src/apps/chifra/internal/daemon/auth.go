@@ -0,0 +1,276 @@
+package daemonPkg
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+)
+
+// Authentication is optional. If [settings.daemon] lists any keys, each request must carry one of them,
+// either as a bearer token (Authorization: Bearer <key>) or in the X-API-Key header. Browsers cannot set
+// headers on websockets, so /websocket also accepts the key in an apiKey query parameter. A key with the
+// read scope may use every route and option that does not change the node's state. Everything else
+// requires the admin scope.
+
+type scope int
+
+const (
+	scopeNone scope = iota
+	scopeRead
+	scopeAdmin
+)
+
+func (s scope) String() string {
+	switch s {
+	case scopeRead:
+		return "read"
+	case scopeAdmin:
+		return "admin"
+	}
+	return "none"
+}
+
+func scopeFromString(s string) scope {
+	switch s {
+	case "read":
+		return scopeRead
+	case "admin":
+		return scopeAdmin
+	}
+	return scopeNone
+}
+
+// adminRoutes require the admin scope whatever their options
+var adminRoutes = map[string]bool{
+	"RouteInit":      true,
+	"RouteScrape":    true,
+	"DeleteMonitors": true,
+	"CreateName":     true,
+	"EditName":       true,
+	"DeleteName":     true,
+}
+
+// adminOptions are the options that, on an otherwise read-only route, change the node's state. An entry
+// of the form option=value requires the admin scope only for that value. Options listed under "*"
+// apply to every route.
+var adminOptions = map[string][]string{
+	"*":             {"decache"},
+	"RouteChunks":   {"pin", "publish", "truncate", "shard", "rewrite", "unpin", "tag"},
+	"RouteConfig":   {"mode=edit"},
+	"RouteMonitors": {"delete", "undelete", "remove", "clean"},
+	"RouteNames":    {"clean", "autoname", "autonameList", "neighbors", "create", "update", "delete", "undelete", "remove", "import", "export", "merge"},
+	"RouteWhen":     {"truncate", "repair", "update"},
+}
+
+// requiredScope returns the scope needed to make the request
+func requiredScope(name string, values url.Values) scope {
+	if adminRoutes[name] {
+		return scopeAdmin
+	}
+
+	options := append(append([]string{}, adminOptions["*"]...), adminOptions[name]...)
+	for _, option := range options {
+		key, value, hasValue := strings.Cut(option, "=")
+		for _, v := range values[key] {
+			if hasValue && strings.EqualFold(v, value) {
+				return scopeAdmin
+			} else if !hasValue && !strings.EqualFold(v, "false") {
+				return scopeAdmin
+			}
+		}
+	}
+	return scopeRead
+}
+
+// authorizer holds the keys accepted by the API server and the audit log
+type authorizer struct {
+	keys     []config.DaemonKey
+	audit    io.Writer
+	auditMux sync.Mutex
+}
+
+// newAuthorizer returns an authorizer for the configured keys and audit log, reporting any errors in them
+func newAuthorizer(keys []config.DaemonKey, auditLog string) (*authorizer, error) {
+	a := &authorizer{keys: keys}
+	names := make(map[string]bool, len(keys))
+	for i, key := range keys {
+		if len(key.Name) == 0 {
+			return nil, fmt.Errorf("daemon key %d has no name", i+1)
+		} else if names[key.Name] {
+			return nil, fmt.Errorf("daemon key %s is listed more than once", key.Name)
+		} else if len(key.Key) == 0 {
+			return nil, fmt.Errorf("daemon key %s has no key", key.Name)
+		} else if scopeFromString(key.Scope) == scopeNone {
+			return nil, fmt.Errorf("daemon key %s has invalid scope '%s' (must be read or admin)", key.Name, key.Scope)
+		}
+		if hash, ok := strings.CutPrefix(key.Key, "sha256:"); ok {
+			if b, err := hex.DecodeString(hash); err != nil || len(b) != sha256.Size {
+				return nil, fmt.Errorf("daemon key %s is not a valid sha256 hash", key.Name)
+			}
+		}
+		names[key.Name] = true
+	}
+
+	if len(auditLog) > 0 {
+		fp, err := os.OpenFile(auditLog, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("opening audit log: %w", err)
+		}
+		a.audit = fp
+	}
+
+	return a, nil
+}
+
+// enabled returns true if requests require a key
+func (a *authorizer) enabled() bool {
+	return a != nil && len(a.keys) > 0
+}
+
+// keyFor returns the configured key presented with the request, if any
+func (a *authorizer) keyFor(r *http.Request, name string) *config.DaemonKey {
+	presented := r.Header.Get("X-API-Key")
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		presented = strings.TrimSpace(token)
+	}
+	if len(presented) == 0 && name == "Websockets" {
+		presented = r.URL.Query().Get("apiKey")
+	}
+	if len(presented) == 0 {
+		return nil
+	}
+
+	sum := sha256.Sum256([]byte(presented))
+	hashed := hex.EncodeToString(sum[:])
+	for i := range a.keys {
+		want, have := a.keys[i].Key, presented
+		if hash, ok := strings.CutPrefix(want, "sha256:"); ok {
+			want, have = strings.ToLower(hash), hashed
+		}
+		if subtle.ConstantTimeCompare([]byte(want), []byte(have)) == 1 {
+			return &a.keys[i]
+		}
+	}
+	return nil
+}
+
+// auditRecord is a single line in the audit log
+type auditRecord struct {
+	Time     string `json:"time"`
+	Remote   string `json:"remote"`
+	Key      string `json:"key,omitempty"`
+	Method   string `json:"method"`
+	Route    string `json:"route"`
+	Uri      string `json:"uri"`
+	Scope    string `json:"scope"`
+	Status   int    `json:"status"`
+	Duration string `json:"duration"`
+}
+
+// record appends the request to the audit log or, if there is none and keys are required, to the
+// server's log
+func (a *authorizer) record(r *http.Request, name string, key *config.DaemonKey, required scope, status int, start time.Time) {
+	if a == nil || (a.audit == nil && !a.enabled()) {
+		return
+	}
+
+	uri := *r.URL
+	if values := uri.Query(); values.Has("apiKey") {
+		values.Set("apiKey", "redacted")
+		uri.RawQuery = values.Encode()
+	}
+
+	rec := auditRecord{
+		Time:     start.UTC().Format(time.RFC3339),
+		Remote:   r.RemoteAddr,
+		Method:   r.Method,
+		Route:    name,
+		Uri:      uri.RequestURI(),
+		Scope:    required.String(),
+		Status:   status,
+		Duration: time.Since(start).String(),
+	}
+	if key != nil {
+		rec.Key = key.Name
+	}
+	line, _ := json.Marshal(rec)
+
+	if a.audit == nil {
+		logger.Info("audit:", string(line))
+		return
+	}
+	a.auditMux.Lock()
+	defer a.auditMux.Unlock()
+	if _, err := a.audit.Write(append(line, '\n')); err != nil {
+		logger.Error("writing audit log:", err)
+	}
+}
+
+// authorize checks the request's key against the scope the route and its options require, and records
+// the request in the audit log
+func authorize(a *authorizer, inner http.Handler, name string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		required := requiredScope(name, r.URL.Query())
+		var key *config.DaemonKey
+		if a.enabled() {
+			key = a.keyFor(r, name)
+			if key == nil {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="chifra"`)
+				RespondWithError(w, http.StatusUnauthorized, errors.New("a valid API key is required"))
+				a.record(r, name, key, required, http.StatusUnauthorized, start)
+				return
+			} else if scopeFromString(key.Scope) < required {
+				err := fmt.Errorf("this request requires the %s scope, the key has the %s scope", required, key.Scope)
+				RespondWithError(w, http.StatusForbidden, err)
+				a.record(r, name, key, required, http.StatusForbidden, start)
+				return
+			}
+		}
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		inner.ServeHTTP(recorder, r)
+		a.record(r, name, key, required, recorder.status, start)
+	})
+}
+
+// authReport describes the configured keys for the server's startup report
+func authReport(a *authorizer) string {
+	if !a.enabled() {
+		return "off"
+	}
+	nAdmin := 0
+	for _, key := range a.keys {
+		if scopeFromString(key.Scope) == scopeAdmin {
+			nAdmin++
+		}
+	}
+	return fmt.Sprintf("%d keys (%d admin)", len(a.keys), nAdmin)
+}
+
+// isLoopback returns true if the server only listens on the local machine
+func isLoopback(serverUrl string) bool {
+	host, _, err := net.SplitHostPort(serverUrl)
+	if err != nil {
+		host = serverUrl
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,138 @@
+package daemonPkg
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+)
+
+func TestRequiredScope(t *testing.T) {
+	tests := []struct {
+		route    string
+		query    string
+		expected scope
+	}{
+		{"RouteList", "addrs=0x1", scopeRead},
+		{"RouteList", "addrs=0x1&decache", scopeAdmin},
+		{"RouteMonitors", "addrs=0x1", scopeRead},
+		{"RouteMonitors", "addrs=0x1&delete", scopeAdmin},
+		{"RouteMonitors", "addrs=0x1&delete=false", scopeRead},
+		{"RouteChunks", "mode=manifest&pin=true", scopeAdmin},
+		{"RouteChunks", "mode=manifest&check", scopeRead},
+		{"RouteConfig", "mode=show", scopeRead},
+		{"RouteConfig", "mode=edit", scopeAdmin},
+		{"RouteNames", "terms=0x1", scopeRead},
+		{"RouteNames", "autonameList=addresses.txt", scopeAdmin},
+		{"RouteNames", "import=names.csv", scopeAdmin},
+		{"RouteNames", "export=names.csv&custom", scopeAdmin},
+		{"RouteWhen", "timestamps&update", scopeAdmin},
+		{"RouteInit", "", scopeAdmin},
+		{"DeleteMonitors", "addrs=0x1", scopeAdmin},
+		{"Websockets", "", scopeRead},
+	}
+	for _, test := range tests {
+		values, _ := url.ParseQuery(test.query)
+		if got := requiredScope(test.route, values); got != test.expected {
+			t.Errorf("%s?%s: expected %s, got %s", test.route, test.query, test.expected, got)
+		}
+	}
+}
+
+func TestNewAuthorizer(t *testing.T) {
+	bad := [][]config.DaemonKey{
+		{{Key: "k", Scope: "read"}},
+		{{Name: "a", Scope: "read"}},
+		{{Name: "a", Key: "k", Scope: "write"}},
+		{{Name: "a", Key: "sha256:1234", Scope: "read"}},
+		{{Name: "a", Key: "k", Scope: "read"}, {Name: "a", Key: "j", Scope: "admin"}},
+	}
+	for _, keys := range bad {
+		if _, err := newAuthorizer(keys, ""); err == nil {
+			t.Error("expected an error for", keys)
+		}
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	sum := sha256.Sum256([]byte("admin-secret"))
+	auditPath := filepath.Join(t.TempDir(), "audit.log")
+	auth, err := newAuthorizer([]config.DaemonKey{
+		{Name: "dashboard", Key: "read-secret", Scope: "read"},
+		{Name: "ops", Key: "sha256:" + hex.EncodeToString(sum[:]), Scope: "admin"},
+	}, auditPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler := authorize(auth, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}), "RouteMonitors")
+
+	tests := []struct {
+		query    string
+		header   string
+		value    string
+		expected int
+	}{
+		{"addrs=0x1", "", "", http.StatusUnauthorized},
+		{"addrs=0x1", "X-API-Key", "wrong", http.StatusUnauthorized},
+		{"addrs=0x1", "X-API-Key", "read-secret", http.StatusNoContent},
+		{"addrs=0x1&delete", "Authorization", "Bearer read-secret", http.StatusForbidden},
+		{"addrs=0x1&delete", "Authorization", "Bearer admin-secret", http.StatusNoContent},
+		{"addrs=0x1&apiKey=read-secret", "", "", http.StatusUnauthorized},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest("GET", "/monitors?"+test.query, nil)
+		if len(test.header) > 0 {
+			r.Header.Set(test.header, test.value)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		if w.Code != test.expected {
+			t.Errorf("%s %s: expected %d, got %d", test.query, test.value, test.expected, w.Code)
+		}
+	}
+
+	contents, err := os.ReadFile(auditPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(contents)), "\n")
+	if len(lines) != len(tests) {
+		t.Fatalf("expected %d audit records, got %d", len(tests), len(lines))
+	}
+	rec := auditRecord{}
+	if err := json.Unmarshal([]byte(lines[4]), &rec); err != nil {
+		t.Fatal(err)
+	}
+	if rec.Key != "ops" || rec.Scope != "admin" || rec.Status != http.StatusNoContent || rec.Route != "RouteMonitors" {
+		t.Error("unexpected audit record", lines[4])
+	}
+	if strings.Contains(string(contents), "read-secret") || !strings.Contains(lines[5], "redacted") {
+		t.Error("the audit log should not contain keys", string(contents))
+	}
+}
+
+func TestIsLoopback(t *testing.T) {
+	for url, expected := range map[string]bool{
+		"localhost:8080": true,
+		"127.0.0.1:8080": true,
+		"[::1]:8080":     true,
+		":8080":          false,
+		"0.0.0.0:8080":   false,
+		"10.0.0.5:8080":  false,
+	} {
+		if got := isLoopback(url); got != expected {
+			t.Errorf("%s: expected %t, got %t", url, expected, got)
+		}
+	}
+}
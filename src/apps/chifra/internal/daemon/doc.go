@@ -15,8 +15,13 @@
 // In the future, this daemon may also manage other long-running processes such as chifra scrape
 // and chifra monitors, but for now, it's only managing the API server.
 //
-// The --grpc option turns on a GRPC server that may speed up certain command such as chifra names,
-// although this option is experimental and therefore not recommended for production use.
+// The --grpc option turns on a gRPC server that may speed up certain command such as chifra names
+// and that provides streaming access to the chain data tools (see below). This option is experimental
+// and therefore not recommended for production use.
+//
+// The API server also serves Prometheus-format metrics at /metrics. These include the number,
+// latency and errors of RPC calls by method, binary cache hits and misses, the scraper's progress,
+// the number of monitors, and the latency of each API route.
 //
 // If the default port for the API server is in use, you may change it with the --port option.
 //
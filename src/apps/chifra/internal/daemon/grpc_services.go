@@ -0,0 +1,347 @@
+// Copyright 2016, 2024 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+/*
+ * Parts of this file were auto generated. Edit only those parts of
+ * the code inside of 'EXISTING_CODE' tags.
+ */
+
+package daemonPkg
+
+import (
+	"io"
+	"net/url"
+
+	// EXISTING_CODE
+	// EXISTING_CODE
+	blocksPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/blocks"
+	exportPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/export"
+	listPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/list"
+	logsPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/logs"
+	statePkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/state"
+	tokensPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/tokens"
+	tracesPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/traces"
+	transactionsPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/transactions"
+	whenPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/when"
+	outputHelpers "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output/helpers"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// registerServices registers the gRPC service of each tool that provides one
+func registerServices(rpcServer *grpc.Server) {
+	proto.RegisterListServiceServer(rpcServer, &listServer{})
+	proto.RegisterExportServiceServer(rpcServer, &exportServer{})
+	proto.RegisterBlocksServiceServer(rpcServer, &blocksServer{})
+	proto.RegisterTransactionsServiceServer(rpcServer, &transactionsServer{})
+	proto.RegisterLogsServiceServer(rpcServer, &logsServer{})
+	proto.RegisterTracesServiceServer(rpcServer, &tracesServer{})
+	proto.RegisterWhenServiceServer(rpcServer, &whenServer{})
+	proto.RegisterStateServiceServer(rpcServer, &stateServer{})
+	proto.RegisterTokensServiceServer(rpcServer, &tokensServer{})
+}
+
+type listServer struct {
+	proto.UnimplementedListServiceServer
+}
+
+// runList runs chifra list in-process (a variable so tests may replace it)
+var runList = func(w io.Writer, values url.Values) error {
+	opts := listPkg.ListFinishParseInternal(w, values)
+	outputHelpers.InitJsonWriterApi("list", w, &opts.Globals)
+	err := opts.ListInternal()
+	outputHelpers.CloseJsonWriterIfNeededApi("list", err, &opts.Globals)
+	return err
+}
+
+// List implements chifra list
+func (s *listServer) List(request *proto.ListRequest, stream proto.ListService_ListServer) error {
+	return serveStream(stream.Context(), runList, request, nil, stream.Send)
+}
+
+// Count implements chifra list --count
+func (s *listServer) Count(request *proto.ListRequest, stream proto.ListService_CountServer) error {
+	return serveStream(stream.Context(), runList, request, []string{"count"}, stream.Send)
+}
+
+// Bounds implements chifra list --bounds
+func (s *listServer) Bounds(request *proto.ListRequest, stream proto.ListService_BoundsServer) error {
+	return serveStream(stream.Context(), runList, request, []string{"bounds"}, stream.Send)
+}
+
+type exportServer struct {
+	proto.UnimplementedExportServiceServer
+}
+
+// runExport runs chifra export in-process (a variable so tests may replace it)
+var runExport = func(w io.Writer, values url.Values) error {
+	opts := exportPkg.ExportFinishParseInternal(w, values)
+	outputHelpers.InitJsonWriterApi("export", w, &opts.Globals)
+	err := opts.ExportInternal()
+	outputHelpers.CloseJsonWriterIfNeededApi("export", err, &opts.Globals)
+	return err
+}
+
+// Export implements chifra export
+func (s *exportServer) Export(request *proto.ExportRequest, stream proto.ExportService_ExportServer) error {
+	return serveStream(stream.Context(), runExport, request, nil, stream.Send)
+}
+
+// Appearances implements chifra export --appearances
+func (s *exportServer) Appearances(request *proto.ExportRequest, stream proto.ExportService_AppearancesServer) error {
+	return serveStream(stream.Context(), runExport, request, []string{"appearances"}, stream.Send)
+}
+
+// Receipts implements chifra export --receipts
+func (s *exportServer) Receipts(request *proto.ExportRequest, stream proto.ExportService_ReceiptsServer) error {
+	return serveStream(stream.Context(), runExport, request, []string{"receipts"}, stream.Send)
+}
+
+// Logs implements chifra export --logs
+func (s *exportServer) Logs(request *proto.ExportRequest, stream proto.ExportService_LogsServer) error {
+	return serveStream(stream.Context(), runExport, request, []string{"logs"}, stream.Send)
+}
+
+// Traces implements chifra export --traces
+func (s *exportServer) Traces(request *proto.ExportRequest, stream proto.ExportService_TracesServer) error {
+	return serveStream(stream.Context(), runExport, request, []string{"traces"}, stream.Send)
+}
+
+// Neighbors implements chifra export --neighbors
+func (s *exportServer) Neighbors(request *proto.ExportRequest, stream proto.ExportService_NeighborsServer) error {
+	return serveStream(stream.Context(), runExport, request, []string{"neighbors"}, stream.Send)
+}
+
+// Statements implements chifra export --statements
+func (s *exportServer) Statements(request *proto.ExportRequest, stream proto.ExportService_StatementsServer) error {
+	return serveStream(stream.Context(), runExport, request, []string{"statements", "accounting"}, stream.Send)
+}
+
+// Lots implements chifra export --lots
+func (s *exportServer) Lots(request *proto.ExportRequest, stream proto.ExportService_LotsServer) error {
+	return serveStream(stream.Context(), runExport, request, []string{"lots", "statements", "accounting"}, stream.Send)
+}
+
+// Balances implements chifra export --balances
+func (s *exportServer) Balances(request *proto.ExportRequest, stream proto.ExportService_BalancesServer) error {
+	return serveStream(stream.Context(), runExport, request, []string{"balances"}, stream.Send)
+}
+
+// Withdrawals implements chifra export --withdrawals
+func (s *exportServer) Withdrawals(request *proto.ExportRequest, stream proto.ExportService_WithdrawalsServer) error {
+	return serveStream(stream.Context(), runExport, request, []string{"withdrawals"}, stream.Send)
+}
+
+// Count implements chifra export --count
+func (s *exportServer) Count(request *proto.ExportRequest, stream proto.ExportService_CountServer) error {
+	return serveStream(stream.Context(), runExport, request, []string{"count"}, stream.Send)
+}
+
+type blocksServer struct {
+	proto.UnimplementedBlocksServiceServer
+}
+
+// runBlocks runs chifra blocks in-process (a variable so tests may replace it)
+var runBlocks = func(w io.Writer, values url.Values) error {
+	opts := blocksPkg.BlocksFinishParseInternal(w, values)
+	outputHelpers.InitJsonWriterApi("blocks", w, &opts.Globals)
+	err := opts.BlocksInternal()
+	outputHelpers.CloseJsonWriterIfNeededApi("blocks", err, &opts.Globals)
+	return err
+}
+
+// Blocks implements chifra blocks
+func (s *blocksServer) Blocks(request *proto.BlocksRequest, stream proto.BlocksService_BlocksServer) error {
+	return serveStream(stream.Context(), runBlocks, request, nil, stream.Send)
+}
+
+// Hashes implements chifra blocks --hashes
+func (s *blocksServer) Hashes(request *proto.BlocksRequest, stream proto.BlocksService_HashesServer) error {
+	return serveStream(stream.Context(), runBlocks, request, []string{"hashes"}, stream.Send)
+}
+
+// Uncles implements chifra blocks --uncles
+func (s *blocksServer) Uncles(request *proto.BlocksRequest, stream proto.BlocksService_UnclesServer) error {
+	return serveStream(stream.Context(), runBlocks, request, []string{"uncles"}, stream.Send)
+}
+
+// Traces implements chifra blocks --traces
+func (s *blocksServer) Traces(request *proto.BlocksRequest, stream proto.BlocksService_TracesServer) error {
+	return serveStream(stream.Context(), runBlocks, request, []string{"traces"}, stream.Send)
+}
+
+// Uniq implements chifra blocks --uniq
+func (s *blocksServer) Uniq(request *proto.BlocksRequest, stream proto.BlocksService_UniqServer) error {
+	return serveStream(stream.Context(), runBlocks, request, []string{"uniq"}, stream.Send)
+}
+
+// Logs implements chifra blocks --logs
+func (s *blocksServer) Logs(request *proto.BlocksRequest, stream proto.BlocksService_LogsServer) error {
+	return serveStream(stream.Context(), runBlocks, request, []string{"logs"}, stream.Send)
+}
+
+// Withdrawals implements chifra blocks --withdrawals
+func (s *blocksServer) Withdrawals(request *proto.BlocksRequest, stream proto.BlocksService_WithdrawalsServer) error {
+	return serveStream(stream.Context(), runBlocks, request, []string{"withdrawals"}, stream.Send)
+}
+
+// Count implements chifra blocks --count
+func (s *blocksServer) Count(request *proto.BlocksRequest, stream proto.BlocksService_CountServer) error {
+	return serveStream(stream.Context(), runBlocks, request, []string{"count"}, stream.Send)
+}
+
+type transactionsServer struct {
+	proto.UnimplementedTransactionsServiceServer
+}
+
+// runTransactions runs chifra transactions in-process (a variable so tests may replace it)
+var runTransactions = func(w io.Writer, values url.Values) error {
+	opts := transactionsPkg.TransactionsFinishParseInternal(w, values)
+	outputHelpers.InitJsonWriterApi("transactions", w, &opts.Globals)
+	err := opts.TransactionsInternal()
+	outputHelpers.CloseJsonWriterIfNeededApi("transactions", err, &opts.Globals)
+	return err
+}
+
+// Transactions implements chifra transactions
+func (s *transactionsServer) Transactions(request *proto.TransactionsRequest, stream proto.TransactionsService_TransactionsServer) error {
+	return serveStream(stream.Context(), runTransactions, request, nil, stream.Send)
+}
+
+// Traces implements chifra transactions --traces
+func (s *transactionsServer) Traces(request *proto.TransactionsRequest, stream proto.TransactionsService_TracesServer) error {
+	return serveStream(stream.Context(), runTransactions, request, []string{"traces"}, stream.Send)
+}
+
+// Uniq implements chifra transactions --uniq
+func (s *transactionsServer) Uniq(request *proto.TransactionsRequest, stream proto.TransactionsService_UniqServer) error {
+	return serveStream(stream.Context(), runTransactions, request, []string{"uniq"}, stream.Send)
+}
+
+// Logs implements chifra transactions --logs
+func (s *transactionsServer) Logs(request *proto.TransactionsRequest, stream proto.TransactionsService_LogsServer) error {
+	return serveStream(stream.Context(), runTransactions, request, []string{"logs"}, stream.Send)
+}
+
+type logsServer struct {
+	proto.UnimplementedLogsServiceServer
+}
+
+// runLogs runs chifra logs in-process (a variable so tests may replace it)
+var runLogs = func(w io.Writer, values url.Values) error {
+	opts := logsPkg.LogsFinishParseInternal(w, values)
+	outputHelpers.InitJsonWriterApi("logs", w, &opts.Globals)
+	err := opts.LogsInternal()
+	outputHelpers.CloseJsonWriterIfNeededApi("logs", err, &opts.Globals)
+	return err
+}
+
+// Logs implements chifra logs
+func (s *logsServer) Logs(request *proto.LogsRequest, stream proto.LogsService_LogsServer) error {
+	return serveStream(stream.Context(), runLogs, request, nil, stream.Send)
+}
+
+type tracesServer struct {
+	proto.UnimplementedTracesServiceServer
+}
+
+// runTraces runs chifra traces in-process (a variable so tests may replace it)
+var runTraces = func(w io.Writer, values url.Values) error {
+	opts := tracesPkg.TracesFinishParseInternal(w, values)
+	outputHelpers.InitJsonWriterApi("traces", w, &opts.Globals)
+	err := opts.TracesInternal()
+	outputHelpers.CloseJsonWriterIfNeededApi("traces", err, &opts.Globals)
+	return err
+}
+
+// Traces implements chifra traces
+func (s *tracesServer) Traces(request *proto.TracesRequest, stream proto.TracesService_TracesServer) error {
+	return serveStream(stream.Context(), runTraces, request, nil, stream.Send)
+}
+
+// Count implements chifra traces --count
+func (s *tracesServer) Count(request *proto.TracesRequest, stream proto.TracesService_CountServer) error {
+	return serveStream(stream.Context(), runTraces, request, []string{"count"}, stream.Send)
+}
+
+type whenServer struct {
+	proto.UnimplementedWhenServiceServer
+}
+
+// runWhen runs chifra when in-process (a variable so tests may replace it)
+var runWhen = func(w io.Writer, values url.Values) error {
+	opts := whenPkg.WhenFinishParseInternal(w, values)
+	outputHelpers.InitJsonWriterApi("when", w, &opts.Globals)
+	err := opts.WhenInternal()
+	outputHelpers.CloseJsonWriterIfNeededApi("when", err, &opts.Globals)
+	return err
+}
+
+// When implements chifra when
+func (s *whenServer) When(request *proto.WhenRequest, stream proto.WhenService_WhenServer) error {
+	return serveStream(stream.Context(), runWhen, request, nil, stream.Send)
+}
+
+// List implements chifra when --list
+func (s *whenServer) List(request *proto.WhenRequest, stream proto.WhenService_ListServer) error {
+	return serveStream(stream.Context(), runWhen, request, []string{"list"}, stream.Send)
+}
+
+// Timestamps implements chifra when --timestamps
+func (s *whenServer) Timestamps(request *proto.WhenRequest, stream proto.WhenService_TimestampsServer) error {
+	return serveStream(stream.Context(), runWhen, request, []string{"timestamps"}, stream.Send)
+}
+
+// Count implements chifra when --count
+func (s *whenServer) Count(request *proto.WhenRequest, stream proto.WhenService_CountServer) error {
+	return serveStream(stream.Context(), runWhen, request, []string{"count"}, stream.Send)
+}
+
+type stateServer struct {
+	proto.UnimplementedStateServiceServer
+}
+
+// runState runs chifra state in-process (a variable so tests may replace it)
+var runState = func(w io.Writer, values url.Values) error {
+	opts := statePkg.StateFinishParseInternal(w, values)
+	outputHelpers.InitJsonWriterApi("state", w, &opts.Globals)
+	err := opts.StateInternal()
+	outputHelpers.CloseJsonWriterIfNeededApi("state", err, &opts.Globals)
+	return err
+}
+
+// State implements chifra state
+func (s *stateServer) State(request *proto.StateRequest, stream proto.StateService_StateServer) error {
+	return serveStream(stream.Context(), runState, request, nil, stream.Send)
+}
+
+// Call implements chifra state --call
+func (s *stateServer) Call(request *proto.StateRequest, stream proto.StateService_CallServer) error {
+	if len(request.GetCall()) == 0 {
+		return status.Error(codes.InvalidArgument, "call is required")
+	}
+	return serveStream(stream.Context(), runState, request, nil, stream.Send)
+}
+
+type tokensServer struct {
+	proto.UnimplementedTokensServiceServer
+}
+
+// runTokens runs chifra tokens in-process (a variable so tests may replace it)
+var runTokens = func(w io.Writer, values url.Values) error {
+	opts := tokensPkg.TokensFinishParseInternal(w, values)
+	outputHelpers.InitJsonWriterApi("tokens", w, &opts.Globals)
+	err := opts.TokensInternal()
+	outputHelpers.CloseJsonWriterIfNeededApi("tokens", err, &opts.Globals)
+	return err
+}
+
+// Tokens implements chifra tokens
+func (s *tokensServer) Tokens(request *proto.TokensRequest, stream proto.TokensService_TokensServer) error {
+	return serveStream(stream.Context(), runTokens, request, nil, stream.Send)
+}
+
+// EXISTING_CODE
+// EXISTING_CODE
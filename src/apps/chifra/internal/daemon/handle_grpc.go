@@ -2,27 +2,39 @@ package daemonPkg
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net"
+	"net/url"
 	"os"
+	"reflect"
+	"strconv"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/names"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/utils"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/proto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 type chifraRpcServer struct {
 	proto.UnimplementedNamesServer
 }
 
-// Search looks up name by given terms
+// Search looks up name by given terms. The request's sort is one of names.SortBy.
 func (g *chifraRpcServer) Search(ctx context.Context, request *proto.SearchRequest) (*proto.SearchResponse, error) {
 	log("Handling SearchNames")
-	found, err := names.LoadNamesArray("mainnet", names.Parts(request.GetParts()), names.SortByAddress, request.GetTerms())
+	found, err := names.LoadNamesArray(chainOrDefault(request.GetChain()), names.Parts(request.GetParts()), names.SortBy(request.GetSort()), request.GetTerms())
 	if err != nil {
 		return nil, err
 	}
@@ -40,7 +52,7 @@ func (g *chifraRpcServer) Search(ctx context.Context, request *proto.SearchReque
 // SearchStream is like Search, but it streams the response
 func (g *chifraRpcServer) SearchStream(request *proto.SearchRequest, stream proto.Names_SearchStreamServer) error {
 	log("Handling SearchStream")
-	found, err := names.LoadNamesArray("mainnet", names.Parts(request.GetParts()), names.SortByAddress, request.GetTerms())
+	found, err := names.LoadNamesArray(chainOrDefault(request.GetChain()), names.Parts(request.GetParts()), names.SortBy(request.GetSort()), request.GetTerms())
 	if err != nil {
 		return err
 	}
@@ -127,6 +139,7 @@ func (opts *DaemonOptions) HandleGrpc() error {
 
 	rpcServer := grpc.NewServer()
 	proto.RegisterNamesServer(rpcServer, &chifraRpcServer{})
+	registerServices(rpcServer)
 
 	listener, err := net.Listen("unix", proto.SocketAddress())
 	if err != nil {
@@ -141,6 +154,101 @@ func (opts *DaemonOptions) HandleGrpc() error {
 	return nil
 }
 
+// serveStream runs a tool with the options in the request and sends each model it produces to the
+// client. If present, the switches select the tool's endpoint (for example, hashes for chifra blocks)
+// along with any switches that endpoint requires. Non-fatal errors, which the API reports alongside the data, are logged and the stream continues.
+func serveStream[M protoreflect.ProtoMessage](ctx context.Context, run func(io.Writer, url.Values) error, request protoreflect.ProtoMessage, switches []string, send func(M) error) error {
+	values := requestValues(request)
+	for _, key := range switches {
+		values.Set(key, "true")
+	}
+	log("Handling ", request.ProtoReflect().Descriptor().Name(), " ", values.Encode())
+
+	w := output.NewModelWriter(ctx,
+		func(model types.Modeler) error {
+			msg, err := modelToMessage[M](model)
+			if err != nil {
+				return err
+			}
+			return send(msg)
+		},
+		func(err error) error {
+			log("Error: ", err)
+			return nil
+		},
+	)
+
+	if err := run(w, values); err != nil {
+		if errors.Is(err, context.Canceled) || ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return status.Error(codes.Unknown, err.Error())
+	}
+	return nil
+}
+
+// requestValues converts a request into the values the API would receive. Each field's JSON name is
+// the name of the option in the API. Fields holding their zero value are left to the tool's defaults.
+func requestValues(request protoreflect.ProtoMessage) url.Values {
+	values := url.Values{}
+	request.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		key := fd.JSONName()
+		if fd.IsList() {
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				values.Add(key, fieldString(fd, list.Get(i)))
+			}
+		} else {
+			values.Set(key, fieldString(fd, v))
+		}
+		return true
+	})
+	return values
+}
+
+func fieldString(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return strconv.FormatBool(v.Bool())
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind:
+		return strconv.FormatUint(v.Uint(), 10)
+	case protoreflect.Int32Kind, protoreflect.Int64Kind:
+		return strconv.FormatInt(v.Int(), 10)
+	case protoreflect.DoubleKind:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	default:
+		return v.String()
+	}
+}
+
+// modelToMessage converts a model into its proto message. The messages are generated from the
+// same definitions as the models, so the model's JSON is the message's JSON.
+func modelToMessage[M protoreflect.ProtoMessage](model types.Modeler) (M, error) {
+	var msg M
+	msg = msg.ProtoReflect().Type().New().Interface().(M)
+
+	want := string(msg.ProtoReflect().Descriptor().Name())
+	if got := reflect.Indirect(reflect.ValueOf(model)).Type().Name(); got != want {
+		return msg, fmt.Errorf("unexpected %s in a stream of %s", got, want)
+	}
+
+	bytes, err := json.Marshal(model)
+	if err != nil {
+		return msg, err
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(bytes, msg); err != nil {
+		return msg, fmt.Errorf("converting %s: %w", want, err)
+	}
+	return msg, nil
+}
+
+func chainOrDefault(chain string) string {
+	if len(chain) == 0 {
+		return config.GetSettings().DefaultChain
+	}
+	return chain
+}
+
 func log(v ...any) {
 	logger.Info("gRPC: " + fmt.Sprint(v...))
 }
@@ -0,0 +1,110 @@
+package daemonPkg
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/proto"
+	"google.golang.org/grpc"
+)
+
+func TestRequestValues(t *testing.T) {
+	request := &proto.BlocksRequest{
+		Chain:      "sepolia",
+		Blocks:     []string{"100", "200-300"},
+		Articulate: true,
+		CacheTxs:   false,
+	}
+
+	values := requestValues(request)
+	if got := values.Get("chain"); got != "sepolia" {
+		t.Errorf("chain: expected sepolia, got %s", got)
+	}
+	if got := values["blocks"]; len(got) != 2 || got[0] != "100" || got[1] != "200-300" {
+		t.Errorf("blocks: expected [100 200-300], got %v", got)
+	}
+	if got := values.Get("articulate"); got != "true" {
+		t.Errorf("articulate: expected true, got %s", got)
+	}
+	if values.Has("cacheTxs") {
+		t.Error("cacheTxs: zero values should not be sent to the tool")
+	}
+}
+
+func TestModelToMessage(t *testing.T) {
+	app := &types.Appearance{
+		Address:          base.HexToAddress("0xf503017d7baf7fbc0fff7492b751025c6a78179b"),
+		BlockNumber:      1001001,
+		TransactionIndex: 2,
+		Timestamp:        1438269988,
+	}
+
+	msg, err := modelToMessage[*proto.Appearance](app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.GetAddress() != app.Address.Hex() {
+		t.Errorf("address: expected %s, got %s", app.Address.Hex(), msg.GetAddress())
+	}
+	if msg.GetBlockNumber() != app.BlockNumber || msg.GetTransactionIndex() != app.TransactionIndex {
+		t.Errorf("expected %d.%d, got %d.%d", app.BlockNumber, app.TransactionIndex, msg.GetBlockNumber(), msg.GetTransactionIndex())
+	}
+	if msg.GetTimestamp() != int64(app.Timestamp) {
+		t.Errorf("timestamp: expected %d, got %d", app.Timestamp, msg.GetTimestamp())
+	}
+	if msg.TraceIndex != nil || msg.Reason != nil {
+		t.Error("omitted fields should not be set")
+	}
+
+	if _, err := modelToMessage[*proto.Block](app); err == nil {
+		t.Error("expected an error converting an Appearance to a Block")
+	}
+}
+
+// lotsStream collects what the Lots rpc sends
+type lotsStream struct {
+	grpc.ServerStream
+	sent []*proto.Disposal
+}
+
+func (s *lotsStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *lotsStream) Send(msg *proto.Disposal) error {
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+func TestLots(t *testing.T) {
+	saved := runExport
+	defer func() { runExport = saved }()
+
+	var got url.Values
+	runExport = func(w io.Writer, values url.Values) error {
+		got = values
+		return nil
+	}
+
+	request := &proto.ExportRequest{
+		Chain: "mainnet",
+		Addrs: []string{"0xf503017d7baf7fbc0fff7492b751025c6a78179b"},
+	}
+	if err := (&exportServer{}).Lots(request, &lotsStream{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// --lots is only valid with --statements, which is only valid with --accounting
+	for _, key := range []string{"lots", "statements", "accounting"} {
+		if got.Get(key) != "true" {
+			t.Errorf("%s: expected true, got %q", key, got.Get(key))
+		}
+	}
+	if got.Get("addrs") != request.Addrs[0] {
+		t.Errorf("addrs: expected %s, got %s", request.Addrs[0], got.Get("addrs"))
+	}
+}
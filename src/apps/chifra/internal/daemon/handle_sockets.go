@@ -5,11 +5,14 @@
 package daemonPkg
 
 import (
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
+	"sync"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/notify"
 	"github.com/gorilla/websocket"
 )
 
@@ -21,8 +24,20 @@ const (
 	CommandErrorMessage MessageType = "command_error"
 	// CommandOutputMessage is currently not used, but may in the future carry the actual data
 	CommandOutputMessage MessageType = "output"
-	// ProgressMessage is a message carried on the stderr stream
+	// ProgressMessage is a message carried on the stderr stream or, for subscribers, a pass of the scraper
 	ProgressMessage MessageType = "progress"
+	// SubscribedMessage acknowledges a subscription (see subscriptions.go)
+	SubscribedMessage MessageType = "subscribed"
+	// UnsubscribedMessage acknowledges the end of a subscription
+	UnsubscribedMessage MessageType = "unsubscribed"
+	// AppearanceMessage carries an appearance of a subscribed address
+	AppearanceMessage MessageType = "appearance"
+	// BlockMessage carries a block the scraper has visited
+	BlockMessage MessageType = "block"
+	// ReorgMessage carries the appearances of unripe blocks that have been re-orged
+	ReorgMessage MessageType = "reorg"
+	// ChunkMessage carries a chunk the scraper has written to the index
+	ChunkMessage MessageType = "chunk"
 )
 
 var upgrader = websocket.Upgrader{}
@@ -32,13 +47,21 @@ type Message struct {
 	Action  MessageType `json:"action"`
 	ID      string      `json:"id"`
 	Content string      `json:"content"`
+	Data    any         `json:"data,omitempty"`
 }
 
+// sendBufferSize is the number of messages that may wait for a slow connection. A subscriber that
+// falls further behind is dropped. It may reconnect and resume from the last block it received.
+const sendBufferSize = 256
+
 // Connection is a structure representing a websocket connection
 type Connection struct {
 	connection *websocket.Conn
 	pool       *ConnectionPool
 	send       chan *Message
+	done       chan struct{}
+	dropped    bool
+	subs       map[string]*subscription // guarded by the pool's mutex
 }
 
 // write the message to the connection
@@ -48,25 +71,73 @@ func (c *Connection) write() {
 	}()
 
 	// this is a common pattern for a websocket connection
-	//nolint:staticcheck,gosimple
 	for {
 		select {
-		case message, ok := <-c.send:
-			if !ok {
-				c.Log("Connection closed")
-				_ = c.connection.WriteMessage(websocket.CloseMessage, []byte{})
-				return
-			}
-
+		case <-c.done:
+			c.Log("Connection closed")
+			_ = c.connection.WriteMessage(websocket.CloseMessage, []byte{})
+			return
+		case message := <-c.send:
 			err := c.connection.WriteJSON(message)
 			if err != nil {
 				// c.Log("Error while sending message, dropping connection: %s", err.Error())
 				c.pool.unregister <- c
+				<-c.done
+				return
 			}
 		}
 	}
 }
 
+// read the client's requests from the connection until the client goes away
+func (c *Connection) read() {
+	defer func() {
+		c.pool.unregister <- c
+	}()
+
+	for {
+		_, bytes, err := c.connection.ReadMessage()
+		if err != nil {
+			return
+		}
+		request := subscribeRequest{}
+		if err := json.Unmarshal(bytes, &request); err != nil {
+			c.deliver(errorMessage("", fmt.Errorf("invalid request: %w", err)))
+			continue
+		}
+		c.pool.handleRequest(c, request)
+	}
+}
+
+// deliver sends the message, waiting for room if needed. It returns false if the connection has closed.
+func (c *Connection) deliver(message *Message) bool {
+	select {
+	case c.send <- message:
+		return true
+	case <-c.done:
+		return false
+	}
+}
+
+// enqueue sends the message without waiting. If the connection can't keep up, it is dropped. It must
+// be called with the pool's mutex held.
+func (c *Connection) enqueue(message *Message) bool {
+	if c.dropped {
+		return false
+	}
+	select {
+	case c.send <- message:
+		return true
+	default:
+		c.Log("Connection is too slow, dropping it")
+		c.dropped = true
+		go func() {
+			c.pool.unregister <- c
+		}()
+		return false
+	}
+}
+
 // RemoteAddr is the other end of the connection
 func (c *Connection) RemoteAddr() net.Addr {
 	return c.connection.RemoteAddr()
@@ -85,12 +156,14 @@ type ConnectionPool struct {
 	broadcast   chan *Message
 	register    chan *Connection
 	unregister  chan *Connection
+	mutex       sync.Mutex
+	history     []event
 }
 
-// closeAndDelete cleans up a connection
+// closeAndDelete cleans up a connection. It must be called with the pool's mutex held.
 func closeAndDelete(pool *ConnectionPool, connection *Connection) {
 	delete(pool.connections, connection)
-	close(connection.send)
+	close(connection.done)
 }
 
 // newConnectionPool returns a new connection structure
@@ -111,18 +184,24 @@ func (pool *ConnectionPool) run() {
 		// handle a signal to register a new connection
 		case connection := <-pool.register:
 			connection.Log("Connected (Websockets)")
+			pool.mutex.Lock()
 			pool.connections[connection] = true
+			pool.mutex.Unlock()
 		// handle a signal to unregister a connection
 		case connection := <-pool.unregister:
+			pool.mutex.Lock()
 			if _, ok := pool.connections[connection]; ok {
 				connection.Log("Unregistering connection")
 				closeAndDelete(pool, connection)
 			}
+			pool.mutex.Unlock()
 		// handle a signal to broadcast a message
 		case message := <-pool.broadcast:
+			pool.mutex.Lock()
 			for connection := range pool.connections {
-				connection.send <- message
+				connection.enqueue(message)
 			}
+			pool.mutex.Unlock()
 		}
 	}
 }
@@ -139,15 +218,23 @@ func HandleWebsockets(pool *ConnectionPool, w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	connection := &Connection{connection: c, send: make(chan *Message), pool: pool}
+	connection := &Connection{
+		connection: c,
+		send:       make(chan *Message, sendBufferSize),
+		done:       make(chan struct{}),
+		subs:       make(map[string]*subscription),
+		pool:       pool,
+	}
 	pool.register <- connection
 
 	go connection.write()
+	go connection.read()
 }
 
 var connectionPool = newConnectionPool()
 
-// RunWebsocketPool runs the websocket pool
+// RunWebsocketPool runs the websocket pool. Subscriptions are fed by the scraper when the daemon runs it.
 func RunWebsocketPool() {
+	notify.Listen(connectionPool.publish)
 	go connectionPool.run()
 }
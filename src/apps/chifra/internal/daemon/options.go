@@ -30,7 +30,7 @@ type DaemonOptions struct {
 	Api     string                `json:"api,omitempty"`     // Instruct the node to start the API server
 	Scrape  string                `json:"scrape,omitempty"`  // Start the scraper, initialize it with either just blooms or entire index, generate for new blocks
 	Monitor bool                  `json:"monitor,omitempty"` // Instruct the node to start the monitors tool
-	Grpc    bool                  `json:"grpc,omitempty"`    // Run gRPC server to serve names
+	Grpc    bool                  `json:"grpc,omitempty"`    // Run gRPC server to serve names and chain data
 	Silent  bool                  `json:"silent,omitempty"`  // Disable logging (for use in SDK for example)
 	Port    string                `json:"port,omitempty"`    // Deprecated, use --url instead
 	Globals globals.GlobalOptions `json:"globals,omitempty"` // The global options
@@ -17,6 +17,8 @@ import (
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/colors"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/metrics"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/monitor"
 	outputHelpers "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output/helpers"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/tslib"
 	"github.com/spf13/cobra"
@@ -81,6 +83,23 @@ func (opts *DaemonOptions) DaemonInternal() error {
 		logger.InfoTable("Progress:          ", msg)
 	}
 
+	daemonConfig := config.GetDaemon()
+	auth, err := newAuthorizer(daemonConfig.Keys, daemonConfig.AuditLog)
+	if err != nil {
+		logger.Fatal(err)
+	} else if (len(daemonConfig.CertFile) > 0) != (len(daemonConfig.KeyFile) > 0) {
+		logger.Fatal("both certFile and keyFile are required to serve HTTPS")
+	}
+	logger.InfoTable("Authentication:    ", authReport(auth))
+	logger.InfoTable("TLS:               ", len(daemonConfig.CertFile) > 0)
+	if !auth.enabled() && !isLoopback(opts.Url) {
+		logger.Warn("the API server is not on localhost and requires no keys. Anyone who can reach it may change your node's state.")
+	}
+
+	metrics.RegisterMonitorCount(chain, func() int {
+		return monitor.CountMonitors(chain)
+	})
+
 	go func() {
 		_ = opts.HandleScraper()
 	}()
@@ -97,7 +116,11 @@ func (opts *DaemonOptions) DaemonInternal() error {
 	// Start listening to the web sockets
 	RunWebsocketPool()
 	// Start listening for requests
-	logger.Fatal(http.ListenAndServe(opts.Url, NewRouter(opts.Silent)))
+	router := NewRouter(opts.Silent, auth)
+	if len(daemonConfig.CertFile) > 0 {
+		logger.Fatal(http.ListenAndServeTLS(opts.Url, daemonConfig.CertFile, daemonConfig.KeyFile, router))
+	}
+	logger.Fatal(http.ListenAndServe(opts.Url, router))
 
 	// EXISTING_CODE
 
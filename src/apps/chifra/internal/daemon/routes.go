@@ -13,6 +13,7 @@ import (
 	"errors"
 	"net/http"
 
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/metrics"
 	// EXISTING_CODE
 
 	abisPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/abis"
@@ -154,6 +155,9 @@ var routes = []Route{
 	{"Websockets", "GET", "/websocket", func(w http.ResponseWriter, r *http.Request) {
 		HandleWebsockets(connectionPool, w, r)
 	}},
+	{"Metrics", "GET", "/metrics", func(w http.ResponseWriter, r *http.Request) {
+		metrics.Handler().ServeHTTP(w, r)
+	}},
 	{"DeleteMonitors", "DELETE", "/monitors", func(w http.ResponseWriter, r *http.Request) {
 		if err := monitorsPkg.ServeMonitors(w, r); err != nil {
 			RespondWithError(w, http.StatusInternalServerError, err)
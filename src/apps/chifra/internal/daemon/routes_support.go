@@ -5,12 +5,16 @@
 package daemonPkg
 
 import (
+	"bufio"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"time"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/metrics"
 	"github.com/gorilla/mux"
 	"golang.org/x/time/rate"
 )
@@ -26,8 +30,8 @@ func RespondWithError(w http.ResponseWriter, httpStatus int, err error) {
 	_, _ = w.Write(marshalled)
 }
 
-// NewRouter Creates a new router given the routes array
-func NewRouter(silent bool) *mux.Router {
+// NewRouter Creates a new router given the routes array. If auth requires keys, each route checks them.
+func NewRouter(silent bool, auth *authorizer) *mux.Router {
 	router := mux.NewRouter().StrictSlash(true)
 	router.Use(CorsHandler)
 	router.
@@ -38,6 +42,7 @@ func NewRouter(silent bool) *mux.Router {
 	for _, route := range routes {
 		var handler http.Handler
 		handler = route.HandlerFunc
+		handler = authorize(auth, handler, route.Name)
 		handler = Logger(silent, handler, route.Name)
 		router.
 			Methods(route.Method).
@@ -51,7 +56,7 @@ func NewRouter(silent bool) *mux.Router {
 
 func addCorsHeaders(w http.ResponseWriter) {
 	w.Header().Set("Access-Control-Allow-Origin", "*")
-	w.Header().Set("Access-Control-Allow-Headers", "Origin, X-Requested-With, Content-Type, Accept")
+	w.Header().Set("Access-Control-Allow-Headers", "Origin, X-Requested-With, Content-Type, Accept, Authorization, X-API-Key")
 	w.Header().Set("Access-Control-Allow-Methods", "PUT, POST, GET, DELETE, OPTIONS")
 }
 
@@ -74,10 +79,14 @@ func ContentTypeHandler(next http.Handler) http.Handler {
 
 		var contentType string
 		switch requestedFormat {
-		case "txt":
+		case "txt", "ledger", "beancount", "iif":
 			contentType = "text/plain"
 		case "csv":
 			contentType = "text/csv"
+		case "parquet":
+			contentType = "application/vnd.apache.parquet"
+		case "arrow":
+			contentType = "application/vnd.apache.arrow.stream"
 		default:
 			contentType = "application/json"
 		}
@@ -103,7 +112,9 @@ func Logger(silent bool, inner http.Handler, name string) http.Handler {
 			defer logger.SetLoggerWriter(w)
 			logger.SetLoggerWriter(nil)
 		}
-		inner.ServeHTTP(w, r)
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		inner.ServeHTTP(recorder, r)
+		metrics.HttpRequest(name, r.Method, recorder.status, time.Since(start))
 		if !silent {
 			t := ""
 			if isTestModeServer(r) {
@@ -116,6 +127,31 @@ func Logger(silent bool, inner http.Handler, name string) http.Handler {
 	})
 }
 
+// statusRecorder remembers the status code written to the response so that it may be
+// reported to the metrics. It passes Hijack and Flush through for websockets and streaming.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if h, ok := r.ResponseWriter.(http.Hijacker); ok {
+		return h.Hijack()
+	}
+	return nil, nil, errors.New("response writer does not support hijacking")
+}
+
 // isTestModeServer return true if we are running from the testing harness
 func isTestModeServer(r *http.Request) bool {
 	return r.Header.Get("User-Agent") == "testRunner"
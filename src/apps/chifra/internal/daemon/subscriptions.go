@@ -0,0 +1,349 @@
+package daemonPkg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	listPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/list"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/notify"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// Clients subscribe to the scraper's progress by sending requests on the websocket:
+//
+//	{ "action": "subscribe", "id": "1", "topic": "appearances", "addresses": ["0x..."], "fromBlock": 18000000 }
+//	{ "action": "unsubscribe", "id": "1" }
+//
+// The topics are:
+//
+//	appearances  the appearances of the given addresses in each ripe block the scraper visits
+//	blocks       each block the scraper visits (including unripe blocks) and any re-orgs
+//	chunks       each chunk the scraper writes to the index
+//	progress     each pass of the scraper
+//
+// Every message the subscription produces carries its id. If fromBlock is given, the subscription
+// first catches up from that block. Appearances are read from the index (as chifra list would) and
+// the other topics are replayed from the most recent events, which the pool remembers. A client that
+// disconnects may therefore resume from one block past the last block it received.
+
+const (
+	topicAppearances = "appearances"
+	topicBlocks      = "blocks"
+	topicChunks      = "chunks"
+	topicProgress    = "progress"
+)
+
+// historySize is the number of events the pool remembers for subscribers that resume
+const historySize = 1024
+
+// subscribeRequest is a request sent by the client on the websocket
+type subscribeRequest struct {
+	Action    string      `json:"action"`
+	ID        string      `json:"id"`
+	Topic     string      `json:"topic"`
+	Chain     string      `json:"chain"`
+	Addresses []string    `json:"addresses"`
+	FromBlock base.Blknum `json:"fromBlock"`
+}
+
+// event is a single thing that happened in the scraper, as a subscriber sees it
+type event struct {
+	chain  string
+	topic  string
+	block  base.Blknum
+	action MessageType
+	data   any
+}
+
+// subscription is a single subscription on a connection
+type subscription struct {
+	id        string
+	topic     string
+	chain     string
+	addresses map[base.Address]bool
+	fromBlock base.Blknum
+	replaying bool
+	pending   []event
+	sent      map[appearanceKey]bool
+}
+
+// appearanceKey identifies an appearance sent while a subscription catches up
+type appearanceKey struct {
+	address base.Address
+	bn      uint32
+	txid    uint32
+}
+
+func keyOf(app types.Appearance) appearanceKey {
+	return appearanceKey{app.Address, app.BlockNumber, app.TransactionIndex}
+}
+
+// progressData is the content of a ProgressMessage sent to subscribers
+type progressData struct {
+	notify.NotificationPayloadProgress
+	Meta *types.MetaData `json:"meta,omitempty"`
+}
+
+func newSubscription(request subscribeRequest) (*subscription, error) {
+	if len(request.ID) == 0 {
+		return nil, errors.New("a subscription requires an id")
+	}
+
+	sub := &subscription{
+		id:        request.ID,
+		topic:     request.Topic,
+		addresses: make(map[base.Address]bool, len(request.Addresses)),
+		fromBlock: request.FromBlock,
+		sent:      make(map[appearanceKey]bool),
+	}
+
+	switch sub.topic {
+	case topicAppearances:
+		if len(request.Addresses) == 0 {
+			return nil, errors.New("the appearances topic requires at least one address")
+		}
+		for _, addr := range request.Addresses {
+			if !base.IsValidAddress(addr) {
+				return nil, fmt.Errorf("invalid address '%s'", addr)
+			}
+			sub.addresses[base.HexToAddress(addr)] = true
+		}
+	case topicBlocks, topicChunks, topicProgress:
+	default:
+		return nil, fmt.Errorf("unknown topic '%s' (must be appearances, blocks, chunks, or progress)", sub.topic)
+	}
+
+	sub.chain = chainOrDefault(request.Chain)
+	return sub, nil
+}
+
+// matches returns true if the event is of interest to the subscription
+func (sub *subscription) matches(e event) bool {
+	return e.chain == sub.chain && e.topic == sub.topic && e.block >= sub.fromBlock
+}
+
+// messages returns the messages the subscription sends for the event
+func (sub *subscription) messages(e event) []*Message {
+	if !sub.matches(e) {
+		return nil
+	}
+
+	if e.topic != topicAppearances {
+		return []*Message{{Action: e.action, ID: sub.id, Data: e.data}}
+	}
+
+	ret := []*Message{}
+	for _, app := range e.data.([]types.Appearance) {
+		if sub.addresses[app.Address] && !sub.sent[keyOf(app)] {
+			ret = append(ret, &Message{Action: e.action, ID: sub.id, Data: app})
+		}
+	}
+	return ret
+}
+
+func errorMessage(id string, err error) *Message {
+	return &Message{Action: CommandErrorMessage, ID: id, Content: err.Error()}
+}
+
+// handleRequest handles a single request from the client
+func (pool *ConnectionPool) handleRequest(c *Connection, request subscribeRequest) {
+	switch request.Action {
+	case "subscribe":
+		pool.subscribe(c, request)
+	case "unsubscribe":
+		pool.mutex.Lock()
+		_, ok := c.subs[request.ID]
+		delete(c.subs, request.ID)
+		pool.mutex.Unlock()
+		if !ok {
+			c.deliver(errorMessage(request.ID, errors.New("no such subscription")))
+			return
+		}
+		c.deliver(&Message{Action: UnsubscribedMessage, ID: request.ID})
+	default:
+		c.deliver(errorMessage(request.ID, fmt.Errorf("unknown action '%s' (must be subscribe or unsubscribe)", request.Action)))
+	}
+}
+
+// subscribe adds the subscription to the connection. While the subscription catches up, new events
+// are held back and then sent once it has.
+func (pool *ConnectionPool) subscribe(c *Connection, request subscribeRequest) {
+	sub, err := newSubscription(request)
+	if err != nil {
+		c.deliver(errorMessage(request.ID, err))
+		return
+	}
+
+	pool.mutex.Lock()
+	if _, exists := c.subs[sub.id]; exists {
+		pool.mutex.Unlock()
+		c.deliver(errorMessage(sub.id, errors.New("a subscription with this id already exists")))
+		return
+	}
+	sub.replaying = true
+	c.subs[sub.id] = sub
+	history := []event{}
+	if sub.fromBlock > 0 {
+		history = append(history, pool.history...)
+	}
+	pool.mutex.Unlock()
+
+	if !c.deliver(&Message{Action: SubscribedMessage, ID: sub.id}) {
+		return
+	}
+
+	if sub.topic == topicAppearances && sub.fromBlock > 0 {
+		err := backfillAppearances(sub, func(app types.Appearance) bool {
+			sub.sent[keyOf(app)] = true
+			return c.deliver(&Message{Action: AppearanceMessage, ID: sub.id, Data: app})
+		})
+		if err != nil {
+			c.deliver(errorMessage(sub.id, err))
+		}
+	}
+
+	for _, e := range history {
+		for _, msg := range sub.messages(e) {
+			if !c.deliver(msg) {
+				return
+			}
+		}
+	}
+
+	for {
+		pool.mutex.Lock()
+		pending := sub.pending
+		sub.pending = nil
+		if len(pending) == 0 {
+			sub.replaying = false
+			sub.sent = make(map[appearanceKey]bool)
+			pool.mutex.Unlock()
+			return
+		}
+		pool.mutex.Unlock()
+
+		for _, e := range pending {
+			for _, msg := range sub.messages(e) {
+				if !c.deliver(msg) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// backfillAppearances sends the appearances of the subscription's addresses that are already in the
+// index, starting at the subscription's first block
+func backfillAppearances(sub *subscription, send func(types.Appearance) bool) error {
+	values := url.Values{}
+	values.Set("chain", sub.chain)
+	values.Set("firstBlock", fmt.Sprint(sub.fromBlock))
+	for addr := range sub.addresses {
+		values.Add("addrs", addr.Hex())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w := output.NewModelWriter(ctx,
+		func(model types.Modeler) error {
+			if app, ok := model.(*types.Appearance); ok && !send(*app) {
+				cancel()
+			}
+			return nil
+		},
+		func(err error) error {
+			logger.Warn("websockets: backfilling appearances:", err)
+			return nil
+		},
+	)
+
+	// Models go straight to the writer, so there is no need to set up a JsonWriter
+	opts := listPkg.ListFinishParseInternal(w, values)
+	if err := opts.ListInternal(); err != nil && ctx.Err() == nil {
+		return err
+	}
+	return nil
+}
+
+// publish is the pool's notify.Listener. It remembers the events the notification carries and sends
+// them to each subscriber. Subscribers that are catching up receive the events once they have.
+func (pool *ConnectionPool) publish(chain string, notification any) {
+	events := toEvents(chain, notification)
+	if len(events) == 0 {
+		return
+	}
+
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+
+	pool.history = append(pool.history, events...)
+	if len(pool.history) > historySize {
+		pool.history = append([]event{}, pool.history[len(pool.history)-historySize:]...)
+	}
+
+	for c := range pool.connections {
+		for _, sub := range c.subs {
+			for _, e := range events {
+				if sub.replaying {
+					if sub.matches(e) {
+						sub.pending = append(sub.pending, e)
+					}
+					continue
+				}
+				for _, msg := range sub.messages(e) {
+					if !c.enqueue(msg) {
+						break
+					}
+				}
+			}
+		}
+	}
+}
+
+// toEvents converts a notification published by the scraper into events
+func toEvents(chain string, notification any) []event {
+	switch n := notification.(type) {
+	case notify.Notification[notify.NotificationPayloadBlock]:
+		return []event{{chain: chain, topic: topicBlocks, block: n.Payload.BlockNumber, action: BlockMessage, data: n.Payload}}
+
+	case notify.Notification[notify.NotificationPayloadProgress]:
+		return []event{{chain: chain, topic: topicProgress, block: n.Payload.LastBlock, action: ProgressMessage, data: progressData{n.Payload, n.Meta}}}
+
+	case notify.Notification[[]notify.NotificationPayloadChunkWritten]:
+		ret := make([]event, 0, len(n.Payload))
+		for _, chunk := range n.Payload {
+			rng := base.RangeFromRangeString(chunk.Range)
+			ret = append(ret, event{chain: chain, topic: topicChunks, block: rng.Last, action: ChunkMessage, data: chunk})
+		}
+		return ret
+
+	case notify.Notification[[]notify.NotificationPayloadAppearance]:
+		apps := make([]types.Appearance, 0, len(n.Payload))
+		block := base.NOPOSN
+		for _, p := range n.Payload {
+			bn, _ := strconv.ParseUint(p.BlockNumber, 10, 32)
+			apps = append(apps, types.Appearance{
+				Address:          base.HexToAddress(p.Address),
+				BlockNumber:      uint32(bn),
+				TransactionIndex: p.TransactionIndex,
+			})
+			block = base.Min(block, base.Blknum(bn))
+		}
+		if len(apps) == 0 {
+			return nil
+		}
+		switch n.Msg {
+		case notify.MessageAppearance:
+			return []event{{chain: chain, topic: topicAppearances, block: block, action: AppearanceMessage, data: apps}}
+		case notify.MessageReorg:
+			return []event{{chain: chain, topic: topicBlocks, block: block, action: ReorgMessage, data: apps}}
+		}
+	}
+	return nil
+}
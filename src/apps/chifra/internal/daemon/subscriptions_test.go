@@ -0,0 +1,130 @@
+package daemonPkg
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/notify"
+	"github.com/gorilla/websocket"
+)
+
+func startPool(t *testing.T) *ConnectionPool {
+	pool := newConnectionPool()
+	go pool.run()
+	t.Cleanup(notify.Listen(pool.publish))
+	return pool
+}
+
+func dialPool(t *testing.T, pool *ConnectionPool) *websocket.Conn {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		HandleWebsockets(pool, w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func subscribeTo(t *testing.T, conn *websocket.Conn, request subscribeRequest) {
+	request.Action = "subscribe"
+	if err := conn.WriteJSON(request); err != nil {
+		t.Fatal(err)
+	}
+	if msg := nextMessage(t, conn); msg.Action != SubscribedMessage || msg.ID != request.ID {
+		t.Fatal("expected subscription to be acknowledged, got", msg)
+	}
+}
+
+type received struct {
+	Action  MessageType     `json:"action"`
+	ID      string          `json:"id"`
+	Content string          `json:"content"`
+	Data    json.RawMessage `json:"data"`
+}
+
+func nextMessage(t *testing.T, conn *websocket.Conn) received {
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	msg := received{}
+	if err := conn.ReadJSON(&msg); err != nil {
+		t.Fatal(err)
+	}
+	return msg
+}
+
+func publishBlock(chain string, bn base.Blknum) {
+	notify.Publish(chain, *notify.NewBlockNotification(nil, notify.NotificationPayloadBlock{BlockNumber: bn, NAppearances: 1}))
+}
+
+func TestSubscribeBlocks(t *testing.T) {
+	pool := startPool(t)
+	conn := dialPool(t, pool)
+	subscribeTo(t, conn, subscribeRequest{ID: "b", Topic: topicBlocks, Chain: "test"})
+
+	publishBlock("other", 9)
+	publishBlock("test", 10)
+
+	msg := nextMessage(t, conn)
+	block := notify.NotificationPayloadBlock{}
+	if err := json.Unmarshal(msg.Data, &block); err != nil {
+		t.Fatal(err)
+	}
+	if msg.Action != BlockMessage || msg.ID != "b" || block.BlockNumber != 10 {
+		t.Error("expected block 10, got", msg.Action, msg.ID, string(msg.Data))
+	}
+}
+
+func TestSubscribeResume(t *testing.T) {
+	pool := startPool(t)
+	for bn := base.Blknum(20); bn < 23; bn++ {
+		publishBlock("test", bn)
+	}
+
+	conn := dialPool(t, pool)
+	subscribeTo(t, conn, subscribeRequest{ID: "r", Topic: topicBlocks, Chain: "test", FromBlock: 21})
+	publishBlock("test", 23)
+
+	for _, expected := range []base.Blknum{21, 22, 23} {
+		msg := nextMessage(t, conn)
+		block := notify.NotificationPayloadBlock{}
+		if err := json.Unmarshal(msg.Data, &block); err != nil {
+			t.Fatal(err)
+		}
+		if block.BlockNumber != expected {
+			t.Errorf("expected block %d, got %d", expected, block.BlockNumber)
+		}
+	}
+}
+
+func TestSubscribeAppearances(t *testing.T) {
+	pool := startPool(t)
+	conn := dialPool(t, pool)
+
+	watched := "0xf503017d7baf7fbc0fff7492b751025c6a78179b"
+	subscribeTo(t, conn, subscribeRequest{ID: "a", Topic: topicAppearances, Chain: "test", Addresses: []string{watched}})
+
+	notify.Publish("test", *notify.NewAppearanceNotification(nil, []notify.NotificationPayloadAppearance{
+		{Address: "0x0000000000000000000000000000000000000001", BlockNumber: "30", TransactionIndex: 1},
+		{Address: watched, BlockNumber: "30", TransactionIndex: 2},
+	}))
+
+	msg := nextMessage(t, conn)
+	if msg.Action != AppearanceMessage || !strings.Contains(string(msg.Data), watched) || !strings.Contains(string(msg.Data), `"transactionIndex":2`) {
+		t.Error("expected the watched address's appearance, got", msg.Action, string(msg.Data))
+	}
+
+	if err := conn.WriteJSON(subscribeRequest{Action: "subscribe", ID: "x", Topic: topicAppearances}); err != nil {
+		t.Fatal(err)
+	}
+	if msg := nextMessage(t, conn); msg.Action != CommandErrorMessage {
+		t.Error("expected an error subscribing to appearances without addresses, got", msg.Action)
+	}
+}
@@ -33,7 +33,7 @@ func (opts *ExportOptions) HandleBalances(monitorArray []monitor.Monitor) error
 		base.RecordRange{First: opts.FirstRecord, Last: opts.GetMax()},
 	)
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		currentBn := base.Blknum(0)
 		prevBalance := base.NewWei(0)
@@ -88,7 +88,7 @@ func (opts *ExportOptions) HandleBalances(monitorArray []monitor.Monitor) error
 						}
 
 						iterErrorChan := make(chan error)
-						iterCtx, iterCancel := context.WithCancel(context.Background())
+						iterCtx, iterCancel := context.WithCancel(ctx)
 						defer iterCancel()
 						go utils.IterateOverMap(iterCtx, iterErrorChan, thisMap, iterFunc)
 						for err := range iterErrorChan {
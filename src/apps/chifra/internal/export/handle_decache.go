@@ -5,8 +5,6 @@
 package exportPkg
 
 import (
-	"context"
-
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/monitor"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
@@ -15,7 +13,7 @@ import (
 
 // HandleDecache handles the command chifra monitors --decache
 func (opts *ExportOptions) HandleDecache(monitorArray []monitor.Monitor) error {
-	ctx := context.Background()
+	ctx := output.ContextFor(opts.Globals.Writer)
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		doIt := true
 		for _, mon := range monitorArray {
@@ -39,7 +39,7 @@ func (opts *ExportOptions) HandleLogs(monitorArray []monitor.Monitor) error {
 	}
 	logFilter := rpc.NewLogFilter(opts.Emitter, opts.Topic)
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		for _, mon := range monitorArray {
 			if apps, cnt, err := mon.ReadAndFilterAppearances(filter, false /* withCount */); err != nil {
@@ -90,7 +90,7 @@ func (opts *ExportOptions) HandleLogs(monitorArray []monitor.Monitor) error {
 						}
 
 						// Set up and interate over the map calling iterFunc for each appearance
-						iterCtx, iterCancel := context.WithCancel(context.Background())
+						iterCtx, iterCancel := context.WithCancel(ctx)
 						defer iterCancel()
 						errChan := make(chan error)
 						go utils.IterateOverMap(iterCtx, errChan, thisMap, iterFunc)
@@ -29,7 +29,7 @@ func (opts *ExportOptions) HandleNeighbors(monitorArray []monitor.Monitor) error
 		base.RecordRange{First: opts.FirstRecord, Last: opts.GetMax()},
 	)
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		for _, mon := range monitorArray {
 			if apps, cnt, err := mon.ReadAndFilterAppearances(filter, false /* withCount */); err != nil {
@@ -75,7 +75,7 @@ func (opts *ExportOptions) HandleNeighbors(monitorArray []monitor.Monitor) error
 						}
 
 						iterErrorChan := make(chan error)
-						iterCtx, iterCancel := context.WithCancel(context.Background())
+						iterCtx, iterCancel := context.WithCancel(ctx)
 						defer iterCancel()
 						go utils.IterateOverMap(iterCtx, iterErrorChan, thisMap, iterFunc)
 						for err := range iterErrorChan {
@@ -32,7 +32,7 @@ func (opts *ExportOptions) HandleShow(monitorArray []monitor.Monitor) error {
 		base.RecordRange{First: opts.FirstRecord, Last: opts.GetMax()},
 	)
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		for _, mon := range monitorArray {
 			if apps, cnt, err := mon.ReadAndFilterAppearances(filter, false /* withCount */); err != nil {
@@ -83,7 +83,7 @@ func (opts *ExportOptions) HandleShow(monitorArray []monitor.Monitor) error {
 						}
 
 						// Set up and interate over the map calling iterFunc for each appearance
-						iterCtx, iterCancel := context.WithCancel(context.Background())
+						iterCtx, iterCancel := context.WithCancel(ctx)
 						defer iterCancel()
 						errChan := make(chan error)
 						go utils.IterateOverMap(iterCtx, errChan, thisMap, iterFunc)
@@ -10,6 +10,7 @@ import (
 	"sort"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/costbasis"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/filter"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/ledger"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
@@ -31,7 +32,7 @@ func (opts *ExportOptions) HandleStatements(monitorArray []monitor.Monitor) erro
 		base.RecordRange{First: opts.FirstRecord, Last: opts.GetMax()},
 	)
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		for _, mon := range monitorArray {
 			if apps, cnt, err := mon.ReadAndFilterAppearances(filter, false /* withCount */); err != nil {
@@ -55,8 +56,26 @@ func (opts *ExportOptions) HandleStatements(monitorArray []monitor.Monitor) erro
 						Total:   int64(cnt),
 					})
 
+					var tracker *costbasis.Tracker
+					if opts.Lots {
+						tracker = costbasis.NewTracker(mon.Address, costbasis.Method(opts.Basis))
+					}
+
 					// TODO: BOGUS - THIS IS NOT CONCURRENCY SAFE
 					finished := false
+					emitDisposals := func(disposals []types.Disposal) {
+						for i := range disposals {
+							if finished {
+								return
+							}
+							var passes bool
+							passes, finished = filter.ApplyCountFilter()
+							if passes {
+								modelChan <- &disposals[i]
+							}
+						}
+					}
+
 					for _, thisMap := range sliceOfMaps {
 						if finished {
 							continue
@@ -82,7 +101,7 @@ func (opts *ExportOptions) HandleStatements(monitorArray []monitor.Monitor) erro
 						}
 
 						// Set up and interate over the map calling iterFunc for each appearance
-						iterCtx, iterCancel := context.WithCancel(context.Background())
+						iterCtx, iterCancel := context.WithCancel(ctx)
 						defer iterCancel()
 						errChan := make(chan error)
 						go utils.IterateOverMap(iterCtx, errChan, thisMap, iterFunc)
@@ -148,6 +167,14 @@ func (opts *ExportOptions) HandleStatements(monitorArray []monitor.Monitor) erro
 							return items[i].BlockNumber < items[j].BlockNumber
 						})
 
+						if tracker != nil {
+							// Statements are sorted chronologically (--reversed is not allowed with --lots)
+							for i := range items {
+								emitDisposals(tracker.Add(&items[i]))
+							}
+							continue
+						}
+
 						for _, item := range items {
 							var passes bool
 							passes, finished = filter.ApplyCountFilter()
@@ -159,6 +186,10 @@ func (opts *ExportOptions) HandleStatements(monitorArray []monitor.Monitor) erro
 							}
 						}
 					}
+
+					if tracker != nil {
+						emitDisposals(tracker.Unrealized())
+					}
 					bar.Finish(true /* newLine */)
 				}
 			}
@@ -171,7 +202,8 @@ func (opts *ExportOptions) HandleStatements(monitorArray []monitor.Monitor) erro
 		"export":     true,
 	}
 
-	if opts.Globals.Verbose || opts.Globals.Format == "json" {
+	// Journal accounts are derived from the names database
+	if opts.Globals.Verbose || opts.Globals.Format == "json" || types.IsJournalFormat(opts.Globals.Format) {
 		parts := names.Custom | names.Prefund | names.Regular
 		if namesMap, err := names.LoadNamesMap(chain, parts, nil); err != nil {
 			return err
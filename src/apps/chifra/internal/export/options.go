@@ -38,6 +38,8 @@ type ExportOptions struct {
 	Neighbors   bool                  `json:"neighbors,omitempty"`   // Export the neighbors of the given address
 	Accounting  bool                  `json:"accounting,omitempty"`  // Attach accounting records to the exported data (applies to transactions export only)
 	Statements  bool                  `json:"statements,omitempty"`  // For the accounting options only, export only statements
+	Lots        bool                  `json:"lots,omitempty"`        // For the --statements option only, report the realized and unrealized gains of the address's tax lots
+	Basis       string                `json:"basis,omitempty"`       // For the --lots option only, the cost-basis method used to match disposals to lots
 	Balances    bool                  `json:"balances,omitempty"`    // Traverse the transaction history and show each change in ETH balances
 	Withdrawals bool                  `json:"withdrawals,omitempty"` // Export withdrawals for the given address
 	Articulate  bool                  `json:"articulate,omitempty"`  // Articulate transactions, traces, logs, and outputs
@@ -65,6 +67,7 @@ type ExportOptions struct {
 }
 
 var defaultExportOptions = ExportOptions{
+	Basis:      "fifo",
 	MaxRecords: 250,
 	LastBlock:  base.NOPOSN,
 }
@@ -81,6 +84,8 @@ func (opts *ExportOptions) testLog() {
 	logger.TestLog(opts.Neighbors, "Neighbors: ", opts.Neighbors)
 	logger.TestLog(opts.Accounting, "Accounting: ", opts.Accounting)
 	logger.TestLog(opts.Statements, "Statements: ", opts.Statements)
+	logger.TestLog(opts.Lots, "Lots: ", opts.Lots)
+	logger.TestLog(len(opts.Basis) > 0 && opts.Basis != "fifo", "Basis: ", opts.Basis)
 	logger.TestLog(opts.Balances, "Balances: ", opts.Balances)
 	logger.TestLog(opts.Withdrawals, "Withdrawals: ", opts.Withdrawals)
 	logger.TestLog(opts.Articulate, "Articulate: ", opts.Articulate)
@@ -123,6 +128,7 @@ func ExportFinishParseInternal(w io.Writer, values url.Values) *ExportOptions {
 	copy := defaultExportOptions
 	copy.Globals.Caps = getCaps()
 	opts := &copy
+	opts.Basis = "fifo"
 	opts.MaxRecords = 250
 	opts.LastBlock = base.NOPOSN
 	for key, value := range values {
@@ -156,6 +162,10 @@ func ExportFinishParseInternal(w io.Writer, values url.Values) *ExportOptions {
 			opts.Accounting = true
 		case "statements":
 			opts.Statements = true
+		case "lots":
+			opts.Lots = true
+		case "basis":
+			opts.Basis = value[0]
 		case "balances":
 			opts.Balances = true
 		case "withdrawals":
@@ -302,6 +312,7 @@ func ResetOptions(testMode bool) {
 	opts.Globals.TestMode = testMode
 	opts.Globals.Writer = w
 	opts.Globals.Caps = getCaps()
+	opts.Basis = "fifo"
 	opts.MaxRecords = 250
 	opts.LastBlock = base.NOPOSN
 	defaultExportOptions = opts
@@ -313,6 +324,7 @@ func (opts *ExportOptions) getCaches() (caches map[walk.CacheType]bool) {
 		// TODO: Enable neighbors cache
 		walk.Cache_Transactions: true,
 		walk.Cache_Statements:   opts.Accounting,
+		walk.Cache_Prices:       opts.Accounting,
 		walk.Cache_Traces:       opts.CacheTraces || (opts.Globals.Cache && (opts.Traces || opts.Neighbors)),
 	}
 	// EXISTING_CODE
@@ -13,6 +13,7 @@ import (
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/index"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/validate"
 )
 
@@ -184,6 +185,29 @@ func (opts *ExportOptions) validateExport() error {
 		}
 	}
 
+	if opts.Lots {
+		if !opts.Statements {
+			return validate.Usage("The {0} option is only available with the {1} option.", "--lots", "--statements")
+		}
+		if opts.Reversed {
+			return validate.Usage("The {0} option is not available{1}.", "--lots", " with --reversed")
+		}
+		if err := validate.ValidateEnum("--basis", opts.Basis, "[fifo|lifo|hifo|average]"); err != nil {
+			return err
+		}
+	} else if len(opts.Basis) > 0 && opts.Basis != "fifo" {
+		return validate.Usage("The {0} option is only available with the {1} option.", "--basis", "--lots")
+	}
+
+	if types.IsJournalFormat(opts.Globals.Format) {
+		if !opts.Statements {
+			return validate.Usage("The {0} option is only available with the {1} option.", "--fmt "+opts.Globals.Format, "--statements")
+		}
+		if opts.Lots {
+			return validate.Usage("The {0} option is not available{1}.", "--fmt "+opts.Globals.Format, " with --lots")
+		}
+	}
+
 	if len(opts.Asset) > 0 && !opts.Statements {
 		return validate.Usage("The {0} option is only available with the {1} option.", "--asset", "--statements")
 	}
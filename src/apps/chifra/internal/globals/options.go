@@ -18,6 +18,7 @@ import (
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/tslib"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/walk"
 	"github.com/spf13/cobra"
 )
@@ -84,7 +85,7 @@ func InitGlobals(whoAmI string, cmd *cobra.Command, opts *GlobalOptions, c caps.
 	}
 
 	if opts.Caps.Has(caps.Fmt) {
-		cmd.Flags().StringVarP(&opts.Format, "fmt", "x", "", "export format, one of [none|json*|txt|csv]")
+		cmd.Flags().StringVarP(&opts.Format, "fmt", "x", "", "export format, one of [none|json*|txt|csv|parquet|arrow]")
 	}
 
 	if opts.Caps.Has(caps.Verbose) {
@@ -181,7 +182,7 @@ func (opts *GlobalOptions) FinishParseApi(w io.Writer, values url.Values, caches
 			parts := strings.Split(opts.OutputFn, ".")
 			if len(parts) > 0 {
 				last := parts[len(parts)-1]
-				if last == "txt" || last == "csv" || last == "json" {
+				if last == "txt" || last == "csv" || last == "json" || last == "parquet" || last == "arrow" || types.IsJournalFormat(last) {
 					opts.Format = last
 				}
 			}
@@ -211,7 +212,7 @@ func (opts *GlobalOptions) FinishParse(args []string, caches map[walk.CacheType]
 		parts := strings.Split(opts.OutputFn, ".")
 		if len(parts) > 0 {
 			last := parts[len(parts)-1]
-			if last == "txt" || last == "csv" || last == "json" {
+			if last == "txt" || last == "csv" || last == "json" || last == "parquet" || last == "arrow" || types.IsJournalFormat(last) {
 				opts.Format = last
 			}
 		}
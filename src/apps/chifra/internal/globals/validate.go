@@ -7,6 +7,7 @@ package globals
 import (
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/validate"
 )
 
@@ -38,9 +39,12 @@ func (opts *GlobalOptions) Validate() error {
 	// 	}
 	// }
 
-	err := validate.ValidateEnum("--fmt", opts.Format, "[json|txt|csv]")
-	if err != nil {
-		return err
+	// The journal formats are validated by the commands that support them
+	if !types.IsJournalFormat(opts.Format) {
+		err := validate.ValidateEnum("--fmt", opts.Format, "[json|txt|csv|parquet|arrow]")
+		if err != nil {
+			return err
+		}
 	}
 
 	// TODO: This hack is here to make test cases pass. It can be removed at some point
@@ -5,8 +5,6 @@
 package logsPkg
 
 import (
-	"context"
-
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/decache"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
@@ -19,7 +17,7 @@ func (opts *LogsOptions) HandleDecache() error {
 		return err
 	}
 
-	ctx := context.Background()
+	ctx := output.ContextFor(opts.Globals.Writer)
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		showProgress := opts.Globals.ShowProgress()
 		if msg, err := decache.Decache(opts.Conn, itemsToRemove, showProgress, walk.Cache_Logs); err != nil {
@@ -37,14 +37,33 @@
 //
 // You may monitor as many addresses as you wish, however, if the commands you specify take longer than the --sleep amount you specify (14 seconds by default), the results are undefined. (Adjust --sleep if necessary.)
 //
-// The --commands file may contain a list of any valid chifra command that operates on addresses. (Currently export, list, state, tokens.) Each command in the --commands file is executed once for each address in the --watchlist file. The --commands file may contain any number of commands, one per line with the above proviso. For example:
+// The --commands file is a TOML file listing the commands to run for each watched address. Each command names a
+// tool (currently export, list, state, or tokens) and the tool's options (using the same names as the
+// API or the command line). The commands are run in-process each time a watched address has new appearances.
+// For example:
 //
-// [bash]
-// chifra list [{ADDRESS}]
-// chifra export --logs [{ADDRESS}]
-// etc.
+// [toml]
+// [[command]]
+// name = "export/logs"
+// tool = "export"
+// fmt = "csv"
+// output = "{chain}/{name}/{address}.{fmt}"
+// [command.options]
+// logs = true
+// articulate = true
+//
+// [[command]]
+// tool = "list"
+//
+// The output value is a template for the file to which the results are written. It may contain {chain},
+// {tool}, {name}, {address}, and {fmt} and defaults to {chain}/{name}/{address}.{fmt}. The name
+// defaults to the tool and fmt to csv. For export and list, only new records are appended to an existing
+// file. The other tools rewrite the file. Addresses are processed in groups of batch_size (default 8).
 //
-// The [{ADDRESS}] token is a stand-in for all addresses in the --watchlist. Addresses are processed in groups of batch_size (default 8).
+// Invalid commands are reported and skipped. If a command fails for a particular address, the process continues
+// with the next command or address. A warning is generated.
 //
-// Invalid commands or invalid addresses are ignored. If a command fails, the process continues with the next command. If a command fails for a particular address, the process continues with the next address. A warning is generated.
+// Rather than sleeping between runs, the watcher may be driven by the scraper's notifications (see
+// chifra scrape --notify). Add listen = "localhost:5555" (matching the [settings.notify] url) to the top of
+// the --commands file. The monitors are then refreshed whenever the scraper reports new appearances or chunks.
 package monitorsPkg
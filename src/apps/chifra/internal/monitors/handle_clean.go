@@ -5,8 +5,6 @@
 package monitorsPkg
 
 import (
-	"context"
-
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/monitor"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
@@ -18,7 +16,7 @@ func (opts *MonitorsOptions) HandleClean() error {
 	testMode := opts.Globals.TestMode
 	_, monArray := monitor.GetMonitorMap(chain)
 
-	ctx := context.Background()
+	ctx := output.ContextFor(opts.Globals.Writer)
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		for _, mon := range monArray {
 			addr := mon.Address.Hex()
@@ -5,7 +5,6 @@
 package monitorsPkg
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
@@ -38,7 +37,7 @@ func (opts *MonitorsOptions) HandleList() error {
 		}
 	}
 
-	ctx := context.Background()
+	ctx := output.ContextFor(opts.Globals.Writer)
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		for _, e := range errors {
 			errorChan <- e
@@ -5,20 +5,15 @@
 package monitorsPkg
 
 import (
-	"encoding/json"
 	"fmt"
-	"path/filepath"
-	"strings"
 	"sync"
 	"time"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/colors"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
-	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/monitor"
-	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/utils"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/validate"
 )
 
@@ -45,6 +40,24 @@ func (opts *MonitorsOptions) RunMonitorScraper(wg *sync.WaitGroup, s *Scraper) {
 
 	s.ChangeState(true, tmpPath)
 
+	watchFile, err := loadWatchFile(opts.Commands)
+	if err != nil {
+		logger.Error(err)
+		return
+	} else if len(watchFile.Commands) == 0 {
+		logger.Error(validate.Usage("The file you specified ({0}) contained no valid commands.", opts.Commands).Error())
+		return
+	}
+
+	var trigger <-chan struct{}
+	if len(watchFile.Listen) > 0 {
+		if trigger, err = listenForScraper(watchFile.Listen); err != nil {
+			logger.Error(err)
+			return
+		}
+		logger.Info("Listening for the scraper's notifications on", watchFile.Listen)
+	}
+
 	runCount := uint64(0)
 	for {
 		if !s.Running {
@@ -57,7 +70,7 @@ func (opts *MonitorsOptions) RunMonitorScraper(wg *sync.WaitGroup, s *Scraper) {
 				return
 			}
 
-			if canceled, err := opts.Refresh(monitorList); err != nil {
+			if canceled, err := opts.Refresh(monitorList, watchFile.Commands); err != nil {
 				logger.Error(err)
 				return
 			} else {
@@ -71,6 +84,12 @@ func (opts *MonitorsOptions) RunMonitorScraper(wg *sync.WaitGroup, s *Scraper) {
 				return
 			}
 
+			if trigger != nil {
+				// The scraper tells us when there's something new, so there's no need to sleep
+				<-trigger
+				continue
+			}
+
 			sleep := opts.Sleep
 			if sleep > 0 {
 				ms := time.Duration(sleep*1000) * time.Millisecond
@@ -83,47 +102,10 @@ func (opts *MonitorsOptions) RunMonitorScraper(wg *sync.WaitGroup, s *Scraper) {
 	}
 }
 
-type Command struct {
-	Fmt    string `json:"fmt"`
-	Folder string `json:"folder"`
-	Cmd    string `json:"cmd"`
-	Cache  bool   `json:"cache"`
-}
-
-func (c *Command) fileName(addr base.Address) string {
-	return filepath.Join(c.Folder, addr.Hex()+"."+c.Fmt)
-}
-
-func (c *Command) resolve(addr base.Address, before, after int64) string {
-	fn := c.fileName(addr)
-	if file.FileExists(fn) {
-		if strings.Contains(c.Cmd, "export") {
-			c.Cmd += fmt.Sprintf(" --first_record %d", uint64(before+1))
-			c.Cmd += fmt.Sprintf(" --max_records %d", uint64(after-before+1)) // extra space won't hurt
-		} else {
-			c.Cmd += fmt.Sprintf(" %d-%d", before+1, after)
-		}
-		c.Cmd += " --append --no_header"
-	}
-	c.Cmd = strings.Replace(c.Cmd, "  ", " ", -1)
-	ret := c.Cmd + " --fmt " + c.Fmt + " --output " + c.fileName(addr) + " " + addr.Hex()
-	if c.Cache {
-		ret += " --cache"
-	}
-	return ret
-}
-
-func (c *Command) String() string {
-	b, _ := json.MarshalIndent(c, "", "  ")
-	return string(b)
-}
-
-func (opts *MonitorsOptions) Refresh(monitors []monitor.Monitor) (bool, error) {
-	theCmds, err := opts.getCommands()
-	if err != nil {
-		return false, err
-	}
-
+// Refresh freshens the monitors and, for each monitor with new appearances, runs the commands
+// in-process. A command that fails is reported and the others continue.
+func (opts *MonitorsOptions) Refresh(monitors []monitor.Monitor, theCmds []WatchCommand) (bool, error) {
+	chain := opts.Globals.Chain
 	batches := batchSlice[monitor.Monitor](monitors, opts.BatchSize)
 	for i := 0; i < len(batches); i++ {
 		addrs := []base.Address{}
@@ -169,10 +151,12 @@ func (opts *MonitorsOptions) Refresh(monitors []monitor.Monitor) (bool, error) {
 			for _, cmd := range theCmds {
 				countBefore := countsBefore[j]
 				if countBefore == 0 || countAfter > countBefore {
-					utils.System(cmd.resolve(mon.Address, countBefore, countAfter))
-					// o := opts
-					// o.Globals.File = ""
-					// _ = o.Globals.PassItOn("acctExport", chain, cmd, []string{})
+					if opts.Globals.Verbose {
+						logger.Info(cmd.String(), mon.Address.Hex())
+					}
+					if err := cmd.run(chain, mon.Address, countBefore, countAfter); err != nil {
+						logger.Warn(fmt.Sprintf("%s failed for %s: %v", cmd.Name, mon.Address.Hex(), err))
+					}
 				} else if opts.Globals.Verbose {
 					fmt.Println("No new transactions for", mon.Address.Hex(), "since last run.")
 				}
@@ -194,131 +178,6 @@ func batchSlice[T any](slice []T, batchSize uint64) [][]T {
 	return batches
 }
 
-func GetExportFormat(cmd, def string) string {
-	if strings.Contains(cmd, "json") {
-		return "json"
-	} else if strings.Contains(cmd, "txt") {
-		return "txt"
-	} else if strings.Contains(cmd, "csv") {
-		return "csv"
-	}
-	if len(def) > 0 {
-		return def
-	}
-	return "csv"
-}
-
-func (opts *MonitorsOptions) cleanLine(lineIn string) (cmd Command, err error) {
-	line := strings.Replace(lineIn, "[{ADDRESS}]", "", -1)
-	if strings.Contains(line, "--fmt") {
-		line = strings.Replace(line, "--fmt", "", -1)
-		line = strings.Replace(line, "json", "", -1)
-		line = strings.Replace(line, "csv", "", -1)
-		line = strings.Replace(line, "txt", "", -1)
-	}
-	line = utils.StripComments(line)
-	if len(line) == 0 {
-		return Command{}, nil
-	}
-
-	folder, err := opts.getOutputFolder(line)
-	if err != nil {
-		return Command{}, err
-	}
-
-	_ = file.EstablishFolder(folder)
-	return Command{Cmd: line, Folder: folder, Fmt: GetExportFormat(lineIn, "csv"), Cache: opts.Globals.Cache}, nil
-}
-
-func (opts *MonitorsOptions) getCommands() (ret []Command, err error) {
-	lines := file.AsciiFileToLines(opts.Commands)
-	for _, line := range lines {
-		// orig := line
-		if cmd, err := opts.cleanLine(line); err != nil {
-			return nil, err
-		} else if len(cmd.Cmd) == 0 {
-			continue
-		} else {
-			ret = append(ret, cmd)
-		}
-	}
-	return ret, nil
-}
-
-func (opts *MonitorsOptions) getOutputFolder(orig string) (string, error) {
-	okMap := map[string]bool{
-		"export": true,
-		"list":   true,
-		"state":  true,
-		"tokens": true,
-	}
-
-	cmdLine := orig
-	parts := strings.Split(strings.Replace(cmdLine, "  ", " ", -1), " ")
-	if len(parts) < 1 || parts[0] != "chifra" {
-		s := fmt.Sprintf("Invalid command: %s. Must start with 'chifra'.", strings.Trim(orig, " \t\n\r"))
-		logger.Fatal(s)
-	}
-	if len(parts) < 2 || !okMap[parts[1]] {
-		s := fmt.Sprintf("Invalid command: %s. Must start with 'chifra export', 'chifra list', 'chifra state', or 'chifra tokens'.", orig)
-		logger.Fatal(s)
-	}
-
-	cmdLine += " "
-	folder := "unknown"
-	if parts[1] == "export" {
-		folder = "./export"
-		if strings.Contains(cmdLine, "-p ") || strings.Contains(cmdLine, "--appearances ") {
-			folder += "/appearances"
-		} else if strings.Contains(cmdLine, "-r ") || strings.Contains(cmdLine, "--receipts ") {
-			folder += "/receipts"
-		} else if strings.Contains(cmdLine, "-l ") || strings.Contains(cmdLine, "--logs ") {
-			folder += "/logs"
-		} else if strings.Contains(cmdLine, "-t ") || strings.Contains(cmdLine, "--traces ") {
-			folder += "/traces"
-		} else if strings.Contains(cmdLine, "-n ") || strings.Contains(cmdLine, "--neighbors ") {
-			folder += "/neighbors"
-		} else if strings.Contains(cmdLine, "-C ") || strings.Contains(cmdLine, "--accounting ") {
-			folder += "/accounting"
-		} else if strings.Contains(cmdLine, "-A ") || strings.Contains(cmdLine, "--statements ") {
-			folder += "/statements"
-		} else if strings.Contains(cmdLine, "-b ") || strings.Contains(cmdLine, "--balances ") {
-			folder += "/balances"
-		} else {
-			folder += "/transactions"
-		}
-
-	} else if parts[1] == "list" {
-		folder = "./list/appearances"
-
-	} else if parts[1] == "state" {
-		folder = "./state"
-		if strings.Contains(cmdLine, "-l ") || strings.Contains(cmdLine, "--call ") {
-			folder += "/calls"
-		} else {
-			folder += "/blocks"
-		}
-
-	} else if parts[1] == "tokens" {
-		folder = "./tokens"
-		if strings.Contains(cmdLine, "-b ") || strings.Contains(cmdLine, "--by_acct ") {
-			folder += "/by_acct"
-		} else {
-			folder += "/blocks"
-		}
-	}
-
-	if strings.Contains(folder, "unknown") {
-		return "", fmt.Errorf("unable to determine output folder for command: %s", cmdLine)
-	}
-
-	if abs, err := filepath.Abs(filepath.Join(opts.Globals.Chain, folder)); err != nil {
-		return "", err
-	} else {
-		return abs, nil
-	}
-}
-
 func (opts *MonitorsOptions) getMonitorList() []monitor.Monitor {
 	var monitors []monitor.Monitor
 
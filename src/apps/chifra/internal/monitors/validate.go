@@ -8,6 +8,7 @@ import (
 	"errors"
 	"path/filepath"
 
+	"github.com/BurntSushi/toml"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/index"
@@ -51,6 +52,9 @@ func (opts *MonitorsOptions) validateMonitors() error {
 				if file.FileSize(cmdFile) == 0 {
 					logger.Fatal(validate.Usage("The file you specified ({0}) was found but contained no commands.", cmdFile).Error())
 				}
+				if _, err := toml.DecodeFile(cmdFile, &WatchFile{}); err != nil {
+					return validate.Usage("The {0} file ({1}) could not be parsed: {2}", "--commands", opts.Commands, err.Error())
+				}
 			}
 
 			if len(opts.Watchlist) == 0 {
@@ -0,0 +1,283 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package monitorsPkg
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	exportPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/export"
+	listPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/list"
+	statePkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/state"
+	tokensPkg "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/internal/tokens"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	outputHelpers "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output/helpers"
+)
+
+// WatchFile is the contents of the --commands file. For example:
+//
+//	listen = "localhost:5555"
+//
+//	[[command]]
+//	name = "export/logs"
+//	tool = "export"
+//	fmt = "csv"
+//	output = "{chain}/{name}/{address}.{fmt}"
+//	[command.options]
+//	logs = true
+//	articulate = true
+type WatchFile struct {
+	// Listen, if not empty, is the address on which to receive the scraper's notifications. The
+	// monitors are refreshed when a notification arrives rather than after sleeping.
+	Listen   string         `toml:"listen"`
+	Commands []WatchCommand `toml:"command"`
+}
+
+// WatchCommand is one of the commands run for each watched address that has new appearances
+type WatchCommand struct {
+	Name    string         `toml:"name"`
+	Tool    string         `toml:"tool"`
+	Fmt     string         `toml:"fmt"`
+	Output  string         `toml:"output"`
+	Cache   bool           `toml:"cache"`
+	Options map[string]any `toml:"options"`
+	values  url.Values
+}
+
+// watchTool runs one of the chifra tools in-process writing its results to w
+type watchTool struct {
+	run func(w io.Writer, values url.Values) error
+	// incremental tools take --first_record and --max_records, so only new records need be appended
+	incremental bool
+}
+
+var watchTools = map[string]watchTool{
+	"export": {
+		run: func(w io.Writer, values url.Values) error {
+			exportPkg.ResetOptions(false)
+			opts := exportPkg.ExportFinishParseInternal(w, values)
+			outputHelpers.InitJsonWriterApi("export", w, &opts.Globals)
+			err := opts.ExportInternal()
+			outputHelpers.CloseJsonWriterIfNeededApi("export", err, &opts.Globals)
+			return err
+		},
+		incremental: true,
+	},
+	"list": {
+		run: func(w io.Writer, values url.Values) error {
+			listPkg.ResetOptions(false)
+			opts := listPkg.ListFinishParseInternal(w, values)
+			outputHelpers.InitJsonWriterApi("list", w, &opts.Globals)
+			err := opts.ListInternal()
+			outputHelpers.CloseJsonWriterIfNeededApi("list", err, &opts.Globals)
+			return err
+		},
+		incremental: true,
+	},
+	"state": {
+		run: func(w io.Writer, values url.Values) error {
+			statePkg.ResetOptions(false)
+			opts := statePkg.StateFinishParseInternal(w, values)
+			outputHelpers.InitJsonWriterApi("state", w, &opts.Globals)
+			err := opts.StateInternal()
+			outputHelpers.CloseJsonWriterIfNeededApi("state", err, &opts.Globals)
+			return err
+		},
+	},
+	"tokens": {
+		run: func(w io.Writer, values url.Values) error {
+			tokensPkg.ResetOptions(false)
+			opts := tokensPkg.TokensFinishParseInternal(w, values)
+			outputHelpers.InitJsonWriterApi("tokens", w, &opts.Globals)
+			err := opts.TokensInternal()
+			outputHelpers.CloseJsonWriterIfNeededApi("tokens", err, &opts.Globals)
+			return err
+		},
+	},
+}
+
+// reservedOptions are set by the watcher itself and may not appear in a command's options
+var reservedOptions = map[string]bool{
+	"fmt":      true,
+	"output":   true,
+	"append":   true,
+	"noHeader": true,
+	"chain":    true,
+	"file":     true,
+}
+
+const defaultWatchOutput = "{chain}/{name}/{address}.{fmt}"
+
+// loadWatchFile reads the --commands file. An error is returned only if the file cannot be read or
+// parsed. Commands that are malformed are reported and skipped, so the others may still be run.
+func loadWatchFile(path string) (*WatchFile, error) {
+	watchFile := &WatchFile{}
+	if _, err := toml.DecodeFile(path, watchFile); err != nil {
+		return nil, fmt.Errorf("could not parse the commands file %s: %w", path, err)
+	}
+
+	cmds := make([]WatchCommand, 0, len(watchFile.Commands))
+	for i, cmd := range watchFile.Commands {
+		if err := cmd.finish(); err != nil {
+			logger.Warn(fmt.Sprintf("skipping command %d (%s) in %s: %v", i+1, cmd.Name, path, err))
+			continue
+		}
+		cmds = append(cmds, cmd)
+	}
+	watchFile.Commands = cmds
+	return watchFile, nil
+}
+
+// finish checks the command, fills in its defaults, and converts its options to the values the tool expects
+func (c *WatchCommand) finish() error {
+	if _, ok := watchTools[c.Tool]; !ok {
+		return fmt.Errorf("tool must be one of export, list, state, or tokens, not '%s'", c.Tool)
+	}
+
+	if len(c.Name) == 0 {
+		c.Name = c.Tool
+	}
+
+	switch c.Fmt {
+	case "":
+		c.Fmt = "csv"
+	case "csv", "txt", "json":
+	default:
+		return fmt.Errorf("fmt must be one of csv, txt, or json, not '%s'", c.Fmt)
+	}
+
+	if len(c.Output) == 0 {
+		c.Output = defaultWatchOutput
+	}
+	if !strings.Contains(c.Output, "{address}") {
+		return fmt.Errorf("output (%s) must contain {address}", c.Output)
+	}
+
+	c.values = make(url.Values)
+	for key, value := range c.Options {
+		key = toCamelCase(key)
+		if reservedOptions[key] {
+			return fmt.Errorf("option %s is set by the watcher", key)
+		}
+		switch v := value.(type) {
+		case bool:
+			if v {
+				c.values.Set(key, "true")
+			}
+		case int64, float64, string:
+			c.values.Set(key, fmt.Sprint(v))
+		case []any:
+			for _, item := range v {
+				switch item.(type) {
+				case int64, float64, string:
+					c.values.Add(key, fmt.Sprint(item))
+				default:
+					return fmt.Errorf("option %s may only contain strings or numbers", key)
+				}
+			}
+		default:
+			return fmt.Errorf("option %s has an unsupported type (%T)", key, value)
+		}
+	}
+	return nil
+}
+
+// outputPath returns the file to which the command's results for the address are written
+func (c *WatchCommand) outputPath(chain string, addr base.Address) (string, error) {
+	path := strings.NewReplacer(
+		"{chain}", chain,
+		"{tool}", c.Tool,
+		"{name}", c.Name,
+		"{address}", addr.Hex(),
+		"{fmt}", c.Fmt,
+	).Replace(c.Output)
+	return filepath.Abs(path)
+}
+
+// run runs the command for the address whose appearance count has gone from before to after. For
+// incremental tools, if the output file exists, only the new records are appended to it. Otherwise
+// the file is rewritten.
+func (c *WatchCommand) run(chain string, addr base.Address, before, after int64) error {
+	path, err := c.outputPath(chain, addr)
+	if err != nil {
+		return err
+	}
+	if err = file.EstablishFolder(filepath.Dir(path)); err != nil {
+		return err
+	}
+
+	tool := watchTools[c.Tool]
+	values := make(url.Values, len(c.values)+4)
+	for key, value := range c.values {
+		values[key] = append([]string{}, value...)
+	}
+	values.Set("chain", chain)
+	values.Set("fmt", c.Fmt)
+	values.Add("addrs", addr.Hex())
+	if c.Cache {
+		values.Set("cache", "true")
+	}
+
+	flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if tool.incremental {
+		if before > 0 && file.FileExists(path) {
+			values.Set("firstRecord", fmt.Sprintf("%d", before+1))
+			values.Set("maxRecords", fmt.Sprintf("%d", after-before))
+			values.Set("noHeader", "true")
+			flags = os.O_APPEND | os.O_WRONLY
+		} else {
+			values.Set("maxRecords", fmt.Sprintf("%d", after))
+		}
+	}
+
+	fp, err := os.OpenFile(path, flags, 0644)
+	if err != nil {
+		return err
+	}
+	defer fp.Close()
+
+	return tool.run(fp, values)
+}
+
+// String returns the command as it would appear on the command line (for reporting)
+func (c *WatchCommand) String() string {
+	keys := make([]string, 0, len(c.values))
+	for key := range c.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	ret := "chifra " + c.Tool
+	for _, key := range keys {
+		for _, value := range c.values[key] {
+			if value == "true" {
+				ret += " --" + key
+			} else {
+				ret += " --" + key + " " + value
+			}
+		}
+	}
+	return ret + " --fmt " + c.Fmt
+}
+
+// toCamelCase converts snake_case option names (as used on the command line) to the camelCase
+// names used by the API. camelCase names are unchanged.
+func toCamelCase(key string) string {
+	parts := strings.Split(key, "_")
+	for i := 1; i < len(parts); i++ {
+		if len(parts[i]) > 0 {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
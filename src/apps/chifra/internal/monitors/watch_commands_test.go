@@ -0,0 +1,100 @@
+package monitorsPkg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+)
+
+const testCommands = `
+listen = "http://localhost:5555"
+
+[[command]]
+name = "export/logs"
+tool = "export"
+[command.options]
+logs = true
+articulate = false
+first_block = 100
+emitter = ["0x1", "0x2"]
+
+[[command]]
+tool = "state"
+fmt = "json"
+output = "state/{address}.{fmt}"
+cache = true
+
+[[command]]
+tool = "slurp"
+
+[[command]]
+tool = "export"
+[command.options]
+fmt = "json"
+
+[[command]]
+tool = "list"
+output = "list/all.csv"
+`
+
+func TestLoadWatchFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "commands.toml")
+	if err := os.WriteFile(path, []byte(testCommands), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	watchFile, err := loadWatchFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if watchFile.Listen != "http://localhost:5555" {
+		t.Error("wrong listen address", watchFile.Listen)
+	}
+
+	// The unknown tool, the reserved option, and the output without an address are skipped
+	if len(watchFile.Commands) != 2 {
+		t.Fatal("expected two valid commands, got", len(watchFile.Commands))
+	}
+
+	logs := watchFile.Commands[0]
+	if logs.Fmt != "csv" || logs.Output != defaultWatchOutput {
+		t.Error("defaults not applied", logs.Fmt, logs.Output)
+	}
+	if s := logs.String(); s != "chifra export --emitter 0x1 --emitter 0x2 --firstBlock 100 --logs --fmt csv" {
+		t.Error("wrong command", s)
+	}
+
+	addr := base.HexToAddress("0xf503017d7baf7fbc0fff7492b751025c6a78179b")
+	path, _ = logs.outputPath("mainnet", addr)
+	expected, _ := filepath.Abs("mainnet/export/logs/" + addr.Hex() + ".csv")
+	if path != expected {
+		t.Error("wrong output path", path)
+	}
+
+	state := watchFile.Commands[1]
+	if state.Name != "state" || !state.Cache {
+		t.Error("wrong state command", state.Name, state.Cache)
+	}
+	path, _ = state.outputPath("mainnet", addr)
+	expected, _ = filepath.Abs("state/" + addr.Hex() + ".json")
+	if path != expected {
+		t.Error("wrong output path", path)
+	}
+}
+
+func TestToCamelCase(t *testing.T) {
+	tests := map[string]string{
+		"logs":         "logs",
+		"first_record": "firstRecord",
+		"firstRecord":  "firstRecord",
+		"cache_traces": "cacheTraces",
+	}
+	for input, expected := range tests {
+		if got := toCamelCase(input); got != expected {
+			t.Error("toCamelCase", input, got, expected)
+		}
+	}
+}
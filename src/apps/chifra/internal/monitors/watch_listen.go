@@ -0,0 +1,62 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package monitorsPkg
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/url"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/notify"
+)
+
+// listenForScraper receives the notifications sent by chifra scrape --notify on the given address (which
+// should match the [settings.notify] url). Each chunkWritten, stageUpdated, or appearance notification
+// sends on the returned channel. The channel holds only one pending notification, so a burst of them
+// (the scraper sends one per block) results in a single refresh.
+func listenForScraper(addr string) (<-chan struct{}, error) {
+	trigger := make(chan struct{}, 1)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		notification := struct {
+			Msg notify.Message `json:"msg"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&notification); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		switch notification.Msg {
+		case notify.MessageChunkWritten, notify.MessageStageUpdated, notify.MessageAppearance:
+			select {
+			case trigger <- struct{}{}:
+			default:
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	// Allow the same form as the notify url, which may include the protocol
+	if u, err := url.Parse(addr); err == nil && len(u.Host) > 0 {
+		addr = u.Host
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		if err := http.Serve(listener, mux); err != nil {
+			logger.Error("the notification listener stopped:", err)
+		}
+	}()
+
+	return trigger, nil
+}
@@ -0,0 +1,297 @@
+package namesPkg
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// erc1155SupportsInterfaceData is the data needed to call the ERC-1155 supportsInterface function
+// 0x01ffc9a7: supportsInterface -- eips.ethereum.org/EIPS/eip-165
+// 0xd9b67a26: ERC-1155 interface ID -- eips.ethereum.org/EIPS/eip-1155
+const erc1155SupportsInterfaceData = "0x01ffc9a7d9b67a2600000000000000000000000000000000000000000000000000000000"
+
+// contractProbes are the calls made to find out what kind of contract an address is. They are made
+// together, so a contract that does not implement one of them simply fails that call.
+var contractProbes = []struct {
+	key  string
+	data string
+}{
+	{"getOwners", "0xa0e67e2b"},    // Gnosis Safe
+	{"getThreshold", "0xe75235b8"}, // Gnosis Safe
+	{"token0", "0x0dfe1681"},       // Uniswap V2 pair or V3 pool
+	{"token1", "0xd21220a7"},       // Uniswap V2 pair or V3 pool
+	{"getReserves", "0x0902f1ac"},  // Uniswap V2 pair
+	{"fee", "0xddca3f43"},          // Uniswap V3 pool
+	{"factory", "0xc45a0155"},      // Uniswap V2 pair or V3 pool
+	{"erc1155", erc1155SupportsInterfaceData},
+}
+
+const (
+	uniswapV2 = "Uniswap V2"
+	uniswapV3 = "Uniswap V3"
+)
+
+// uniswapFactoryV2 is the mainnet factory that creates Uniswap V2 pairs
+var uniswapFactoryV2 = base.HexToAddress("0x5c69bee701ef814a2b6a3edd4b1652cb9cc5aa6f")
+
+// uniswapFactories returns the factories that create Uniswap pairs and pools on the chain. Only
+// a contract created by one of them is named for Uniswap. The V3 factory comes from the chain's
+// pricing settings.
+func uniswapFactories(chain string) map[base.Address]string {
+	ret := map[base.Address]string{}
+	if chain == "mainnet" {
+		ret[uniswapFactoryV2] = uniswapV2
+	}
+	if factory := config.GetPricingSettings(chain).UniswapV3Factory; len(factory) > 0 {
+		ret[base.HexToAddress(factory)] = uniswapV3
+	}
+	return ret
+}
+
+// contractKind is what was found out about an address on chain
+type contractKind struct {
+	proxy          rpc.ProxyKind
+	implementation base.Address
+	owners         []base.Address // a Gnosis Safe's owners...
+	threshold      uint64         // ...and how many of them must sign
+	pool           string         // uniswapV2 for a pair, uniswapV3 for a pool
+	token0         base.Address
+	token1         base.Address
+	symbol0        string
+	symbol1        string
+	fee            uint64 // a Uniswap V3 pool's fee in hundredths of a basis point
+	erc1155        bool
+	ensName        string
+}
+
+// probeContract finds out what kind of contract the address is. For an address that is not a
+// contract, only its ENS name is looked up.
+func probeContract(conn *rpc.Connection, address base.Address, isContract bool) (*contractKind, error) {
+	kind := &contractKind{}
+	if isContract {
+		bn := conn.GetLatestBlockNumber()
+		calls := make([]rpc.CallRequest, 0, len(contractProbes))
+		for _, probe := range contractProbes {
+			calls = append(calls, rpc.CallRequest{To: address, Data: probe.data})
+		}
+		responses, err := conn.CallMany(calls, bn)
+		if err != nil {
+			return nil, err
+		}
+		results := make(map[string]rpc.CallResponse, len(responses))
+		for i, probe := range contractProbes {
+			results[probe.key] = responses[i]
+		}
+		kind = newContractKind(results, uniswapFactories(conn.Chain))
+
+		if kind.implementation, kind.proxy, err = conn.GetContractProxyKindAt(address, bn); err != nil {
+			return nil, err
+		}
+
+		if kind.pool != "" {
+			kind.symbol0 = tokenSymbol(conn, kind.token0)
+			kind.symbol1 = tokenSymbol(conn, kind.token1)
+		}
+	}
+
+	// Note: GetEnsName returns the address if there is no name
+	if ensName, ok := conn.GetEnsName(address.Hex()); ok {
+		kind.ensName = ensName
+	}
+	return kind, nil
+}
+
+// newContractKind decodes the results of the contract probes. A pair or pool is only recognized if
+// it was created by one of the factories.
+func newContractKind(results map[string]rpc.CallResponse, factories map[base.Address]string) *contractKind {
+	kind := &contractKind{}
+
+	// A Gnosis Safe has owners, a threshold of whom must sign a transaction
+	if owners, ok := wordsToAddresses(words(results["getOwners"])); ok && len(owners) > 0 {
+		if w := words(results["getThreshold"]); len(w) == 1 {
+			if threshold, ok := wordToUint(w[0]); ok && threshold > 0 && threshold <= uint64(len(owners)) {
+				kind.owners = owners
+				kind.threshold = threshold
+			}
+		}
+	}
+
+	// Uniswap V2 pairs and V3 pools both hold two tokens, but only a pair has reserves and only a pool has
+	// a fee. Many forks look the same, so the factory that created the contract decides which it is.
+	w0, w1, wf := words(results["token0"]), words(results["token1"]), words(results["factory"])
+	if len(w0) == 1 && len(w1) == 1 && len(wf) == 1 {
+		token0, ok0 := wordToAddress(w0[0])
+		token1, ok1 := wordToAddress(w1[0])
+		factory, okf := wordToAddress(wf[0])
+		if ok0 && ok1 && okf {
+			switch factories[factory] {
+			case uniswapV2:
+				if len(words(results["getReserves"])) == 3 {
+					kind.pool = uniswapV2
+				}
+			case uniswapV3:
+				if w := words(results["fee"]); len(w) == 1 {
+					if fee, ok := wordToUint(w[0]); ok && fee < 1000000 {
+						kind.pool = uniswapV3
+						kind.fee = fee
+					}
+				}
+			}
+			if kind.pool != "" {
+				kind.token0 = token0
+				kind.token1 = token1
+			}
+		}
+	}
+
+	if w := words(results["erc1155"]); len(w) == 1 {
+		supported, ok := wordToUint(w[0])
+		kind.erc1155 = ok && supported == 1
+	}
+
+	return kind
+}
+
+func (k *contractKind) isSafe() bool {
+	return len(k.owners) > 0
+}
+
+// apply names and tags the name by the kind of contract it is. It returns false if nothing was found
+// out about the address.
+func (k *contractKind) apply(name *types.Name) bool {
+	// If the address is a token, the token's own name is best
+	isToken := name.IsErc20 || name.IsErc721
+	hasTokenName := isToken && name.Name != "" && name.Name != name.Petname
+
+	generated := ""
+	switch {
+	case k.pool != "":
+		name.Tags = "55-Defi:" + k.pool
+		generated = fmt.Sprintf("%s: %s/%s", k.pool, k.symbol0, k.symbol1)
+		if k.pool == uniswapV3 {
+			generated += " " + strconv.FormatFloat(float64(k.fee)/10000, 'f', -1, 64) + "%"
+		}
+		// A pair's token is named for the exchange, not for the pair
+		hasTokenName = false
+	case k.isSafe():
+		name.Tags = "30-Contracts:Multisig:Gnosis Safe"
+		generated = fmt.Sprintf("Gnosis Safe (%d of %d)", k.threshold, len(k.owners))
+	case k.erc1155:
+		name.Tags = "50-Tokens:ERC1155"
+		name.IsErc20 = false
+		name.Decimals = 0
+	case k.proxy != rpc.ProxyNone && !isToken:
+		name.Tags = "30-Contracts:Proxy"
+		if k.proxy != rpc.ProxyOther {
+			name.Tags += ":" + string(k.proxy)
+		}
+	}
+
+	switch {
+	case hasTokenName:
+		// keep it
+	case k.ensName != "":
+		name.Name = k.ensName
+	case generated != "":
+		name.Name = generated
+	}
+
+	found := isToken || k.pool != "" || k.isSafe() || k.erc1155 || k.proxy != rpc.ProxyNone || k.ensName != ""
+	if found {
+		// The name's source records what the name does not, so it is kept in the database
+		name.Source = "On chain"
+		if details := k.details(); details != "" {
+			name.Source += ". " + details
+		}
+	}
+	return found
+}
+
+// details returns what was found out about the address that is not recorded in its name or tags
+func (k *contractKind) details() string {
+	ret := []string{}
+	if k.isSafe() {
+		owners := make([]string, 0, len(k.owners))
+		for _, owner := range k.owners {
+			owners = append(owners, owner.Hex())
+		}
+		ret = append(ret, fmt.Sprintf("Owners (%d required): %s", k.threshold, strings.Join(owners, ", ")))
+	}
+	if k.pool != "" {
+		ret = append(ret, fmt.Sprintf("Tokens: %s, %s", k.token0.Hex(), k.token1.Hex()))
+	}
+	if k.proxy != rpc.ProxyNone && !k.implementation.IsZero() {
+		ret = append(ret, fmt.Sprintf("Implementation: %s", k.implementation.Hex()))
+	}
+	return strings.Join(ret, ". ")
+}
+
+// tokenSymbol returns the token's symbol or, if it has none, its address
+func tokenSymbol(conn *rpc.Connection, token base.Address) string {
+	if state, err := conn.GetTokenState(token, "latest"); err == nil && state.Symbol != "" {
+		return strings.TrimSpace(state.Symbol)
+	}
+	return token.Hex()
+}
+
+// words splits the result of a call into its 32-byte words. It returns nil if the call failed.
+func words(response rpc.CallResponse) []string {
+	data := strings.TrimPrefix(response.Data, "0x")
+	if response.Err != nil || len(data) == 0 || len(data)%64 != 0 {
+		return nil
+	}
+	ret := make([]string, 0, len(data)/64)
+	for i := 0; i < len(data); i += 64 {
+		ret = append(ret, data[i:i+64])
+	}
+	return ret
+}
+
+// wordToAddress decodes a word holding a (non-zero) address
+func wordToAddress(word string) (base.Address, bool) {
+	if strings.Trim(word[:24], "0") != "" {
+		return base.Address{}, false
+	}
+	addr := base.HexToAddress("0x" + word[24:])
+	return addr, !addr.IsZero()
+}
+
+// wordToUint decodes a word holding an unsigned integer that fits in 64 bits
+func wordToUint(word string) (uint64, bool) {
+	if strings.Trim(word[:48], "0") != "" {
+		return 0, false
+	}
+	value, err := strconv.ParseUint(word[48:], 16, 64)
+	return value, err == nil
+}
+
+// wordsToAddresses decodes words holding a (dynamic) array of addresses
+func wordsToAddresses(w []string) ([]base.Address, bool) {
+	if len(w) < 2 {
+		return nil, false
+	}
+	offset, ok := wordToUint(w[0])
+	if !ok || offset%32 != 0 || offset/32 >= uint64(len(w)) {
+		return nil, false
+	}
+	start := offset / 32
+	n, ok := wordToUint(w[start])
+	if !ok || n > uint64(len(w))-start-1 {
+		return nil, false
+	}
+	ret := make([]base.Address, 0, n)
+	for _, word := range w[start+1 : start+1+n] {
+		addr, ok := wordToAddress(word)
+		if !ok {
+			return nil, false
+		}
+		ret = append(ret, addr)
+	}
+	return ret, true
+}
@@ -0,0 +1,257 @@
+package namesPkg
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	usdc  = base.HexToAddress("0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48")
+	weth  = base.HexToAddress("0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2")
+	alice = base.HexToAddress("0x000000000000000000000000000000000000a11c")
+	bob   = base.HexToAddress("0x0000000000000000000000000000000000000b0b")
+
+	v3Factory = base.HexToAddress("0x1f98431c8ad98523631ae4a59f267346ea31f984")
+	factories = map[base.Address]string{uniswapFactoryV2: uniswapV2, v3Factory: uniswapV3}
+)
+
+// returns builds the successful result of a call from its words
+func returns(words ...string) rpc.CallResponse {
+	data := "0x"
+	for _, word := range words {
+		data += strings.Repeat("0", 64-len(word)) + word
+	}
+	return rpc.CallResponse{Data: data}
+}
+
+func addrWord(addr base.Address) string {
+	return strings.TrimPrefix(addr.Hex(), "0x")
+}
+
+var failed = rpc.CallResponse{Data: "0x", Err: rpc.ErrCallFailed}
+
+func TestContractProbes(t *testing.T) {
+	signatures := map[string]string{
+		"getOwners":    "getOwners()",
+		"getThreshold": "getThreshold()",
+		"token0":       "token0()",
+		"token1":       "token1()",
+		"getReserves":  "getReserves()",
+		"fee":          "fee()",
+		"factory":      "factory()",
+		"erc1155":      "supportsInterface(bytes4)",
+	}
+	for _, probe := range contractProbes {
+		selector := "0x" + base.Bytes2Hex(crypto.Keccak256([]byte(signatures[probe.key]))[:4])
+		if !strings.HasPrefix(probe.data, selector) {
+			t.Errorf("%s: expected selector %s, got %s", probe.key, selector, probe.data[:10])
+		}
+	}
+}
+
+func TestNewContractKind(t *testing.T) {
+	tests := []struct {
+		name     string
+		results  map[string]rpc.CallResponse
+		expected contractKind
+	}{
+		{
+			name: "gnosis safe",
+			results: map[string]rpc.CallResponse{
+				"getOwners":    returns("20", "2", addrWord(alice), addrWord(bob)),
+				"getThreshold": returns("2"),
+			},
+			expected: contractKind{owners: []base.Address{alice, bob}, threshold: 2},
+		},
+		{
+			name: "threshold larger than the owners",
+			results: map[string]rpc.CallResponse{
+				"getOwners":    returns("20", "1", addrWord(alice)),
+				"getThreshold": returns("2"),
+			},
+		},
+		{
+			name: "uniswap v2 pair",
+			results: map[string]rpc.CallResponse{
+				"token0":      returns(addrWord(usdc)),
+				"token1":      returns(addrWord(weth)),
+				"getReserves": returns("1000", "2000", "65f1a2b3"),
+				"fee":         failed,
+				"factory":     returns(addrWord(uniswapFactoryV2)),
+			},
+			expected: contractKind{pool: uniswapV2, token0: usdc, token1: weth},
+		},
+		{
+			name: "a fork of a uniswap v2 pair",
+			results: map[string]rpc.CallResponse{
+				"token0":      returns(addrWord(usdc)),
+				"token1":      returns(addrWord(weth)),
+				"getReserves": returns("1000", "2000", "65f1a2b3"),
+				"fee":         failed,
+				"factory":     returns(addrWord(alice)),
+			},
+		},
+		{
+			name: "a uniswap v2 pair without a factory",
+			results: map[string]rpc.CallResponse{
+				"token0":      returns(addrWord(usdc)),
+				"token1":      returns(addrWord(weth)),
+				"getReserves": returns("1000", "2000", "65f1a2b3"),
+				"factory":     failed,
+			},
+		},
+		{
+			name: "uniswap v3 pool",
+			results: map[string]rpc.CallResponse{
+				"token0":      returns(addrWord(usdc)),
+				"token1":      returns(addrWord(weth)),
+				"getReserves": failed,
+				"fee":         returns("1f4"),
+				"factory":     returns(addrWord(v3Factory)),
+			},
+			expected: contractKind{pool: uniswapV3, token0: usdc, token1: weth, fee: 500},
+		},
+		{
+			name: "two tokens but neither reserves nor a fee",
+			results: map[string]rpc.CallResponse{
+				"token0":  returns(addrWord(usdc)),
+				"token1":  returns(addrWord(weth)),
+				"factory": returns(addrWord(uniswapFactoryV2)),
+			},
+		},
+		{
+			name: "erc1155",
+			results: map[string]rpc.CallResponse{
+				"erc1155": returns("1"),
+			},
+			expected: contractKind{erc1155: true},
+		},
+		{
+			name: "a fallback that returns garbage",
+			results: map[string]rpc.CallResponse{
+				"getOwners":    {Data: "0x1234"},
+				"getThreshold": returns("ffffffffffffffffffffffffffffffffffffffff"),
+				"token0":       returns("ff" + addrWord(usdc)),
+				"token1":       returns(addrWord(weth)),
+				"erc1155":      returns("2"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newContractKind(tt.results, factories); !reflect.DeepEqual(*got, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, *got)
+			}
+		})
+	}
+}
+
+func TestContractKindApply(t *testing.T) {
+	token := func(name, symbol string) types.Name {
+		return types.Name{Name: name, Symbol: symbol, Petname: "pet", Tags: "50-Tokens:ERC20", Source: "On chain", Decimals: 18, IsContract: true, IsErc20: true}
+	}
+	contract := types.Name{Name: "pet", Petname: "pet", Tags: "30-Contracts", Source: "TrueBlocks.io", IsContract: true}
+	person := types.Name{Name: "pet", Petname: "pet", Tags: "90-Individuals:Other", Source: "TrueBlocks.io"}
+
+	tests := []struct {
+		name     string
+		start    types.Name
+		kind     contractKind
+		found    bool
+		expected [3]string // name, tags, source
+	}{
+		{"uniswap v2 pair", token("Uniswap V2", "UNI-V2"), contractKind{pool: uniswapV2, token0: usdc, token1: weth, symbol0: "USDC", symbol1: "WETH"},
+			true, [3]string{"Uniswap V2: USDC/WETH", "55-Defi:Uniswap V2", "On chain. Tokens: " + usdc.Hex() + ", " + weth.Hex()}},
+		{"uniswap v3 pool", contract, contractKind{pool: uniswapV3, token0: usdc, token1: weth, symbol0: "USDC", symbol1: "WETH", fee: 500},
+			true, [3]string{"Uniswap V3: USDC/WETH 0.05%", "55-Defi:Uniswap V3", "On chain. Tokens: " + usdc.Hex() + ", " + weth.Hex()}},
+		{"gnosis safe", contract, contractKind{owners: []base.Address{alice, bob}, threshold: 1, proxy: rpc.ProxyOther, implementation: weth},
+			true, [3]string{"Gnosis Safe (1 of 2)", "30-Contracts:Multisig:Gnosis Safe",
+				"On chain. Owners (1 required): " + alice.Hex() + ", " + bob.Hex() + ". Implementation: " + weth.Hex()}},
+		{"gnosis safe with an ens name", contract, contractKind{owners: []base.Address{alice}, threshold: 1, ensName: "treasury.dao.eth"},
+			true, [3]string{"treasury.dao.eth", "30-Contracts:Multisig:Gnosis Safe", "On chain. Owners (1 required): " + alice.Hex()}},
+		{"erc1155", token("Things", "THG"), contractKind{erc1155: true},
+			true, [3]string{"Things", "50-Tokens:ERC1155", "On chain"}},
+		{"eip-1967 proxy", contract, contractKind{proxy: rpc.ProxyEip1967, implementation: usdc},
+			true, [3]string{"pet", "30-Contracts:Proxy:EIP-1967", "On chain. Implementation: " + usdc.Hex()}},
+		{"other proxy", contract, contractKind{proxy: rpc.ProxyOther},
+			true, [3]string{"pet", "30-Contracts:Proxy", "On chain"}},
+		{"token behind a proxy", token("USD Coin", "USDC"), contractKind{proxy: rpc.ProxyEip1967, ensName: "usdc.eth"},
+			true, [3]string{"USD Coin", "50-Tokens:ERC20", "On chain"}},
+		{"person with an ens name", person, contractKind{ensName: "alice.eth"},
+			true, [3]string{"alice.eth", "90-Individuals:Other", "On chain"}},
+		{"unrecognized contract", contract, contractKind{},
+			false, [3]string{"pet", "30-Contracts", "TrueBlocks.io"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name := tt.start
+			found := tt.kind.apply(&name)
+			got := [3]string{name.Name, name.Tags, name.Source}
+			if found != tt.found || got != tt.expected {
+				t.Errorf("expected %t %v, got %t %v", tt.found, tt.expected, found, got)
+			}
+			if tt.kind.erc1155 && (name.IsErc20 || name.Decimals != 0) {
+				t.Error("an ERC-1155 token should not be an ERC-20 token")
+			}
+		})
+	}
+}
+
+func TestWordsToAddresses(t *testing.T) {
+	tests := []struct {
+		name     string
+		words    []string
+		expected []base.Address
+		ok       bool
+	}{
+		{"two addresses", words(returns("20", "2", addrWord(alice), addrWord(bob))), []base.Address{alice, bob}, true},
+		{"empty array", words(returns("20", "0")), []base.Address{}, true},
+		{"too short", words(returns("20", "3", addrWord(alice), addrWord(bob))), nil, false},
+		{"offset out of range", words(returns("60", "1", addrWord(alice))), nil, false},
+		{"length overflows", words(returns("20", "ffffffffffffffff", addrWord(alice))), nil, false},
+		{"not an address", words(returns("20", "1", "ff"+addrWord(alice))), nil, false},
+		{"failed call", words(failed), nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := wordsToAddresses(tt.words)
+			if ok != tt.ok || !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %t %v, got %t %v", tt.ok, tt.expected, ok, got)
+			}
+		})
+	}
+}
+
+func TestReadAddressList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "addresses.txt")
+	contents := strings.Join([]string{
+		"address,name",
+		"# a comment",
+		usdc.Hex() + ",USD Coin",
+		"",
+		"  " + weth.Hex() + " Wrapped Ether",
+		weth.Hex() + "\tagain",
+		"not-an-address",
+		alice.Hex(),
+	}, "\n")
+	if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := readAddressList(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []base.Address{usdc, weth, alice}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
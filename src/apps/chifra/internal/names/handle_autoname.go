@@ -1,34 +1,43 @@
 package namesPkg
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/filter"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/monitor"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/names"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/uniq"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/utils"
 )
 
 func (opts *NamesOptions) HandleAutoname() error {
+	if opts.Neighbors {
+		addrs, err := opts.getNeighbors()
+		if err != nil {
+			return err
+		}
+		return opts.autonameMany(addrs)
+	}
+
 	name, err := opts.readContractAndClean()
 	if err != nil {
 		return err
 	}
 
-	message := "No name has been updated. Is the contract a token?"
+	message := "No name has been updated. Is the address a token, proxy, multisig, or pool?"
 	if name != nil {
-		message = fmt.Sprintf(
-			"Updated name for %s, %s. ERC-20 token: %t, ERC-721 NFT: %t, Symbol: %s, Decimals: %d",
-			name.Address,
-			name.Name,
-			name.IsErc20,
-			name.IsErc721,
-			name.Symbol,
-			name.Decimals,
-		)
+		message = autonameMessage(name, opts.DryRun)
 	}
 	if !utils.IsFuzzing() {
 		logger.Info(message)
@@ -40,29 +49,34 @@ func (opts *NamesOptions) HandleAutoname() error {
 				Msg: message,
 			}
 		}
-		_ = output.StreamMany(context.Background(), fetchData, opts.Globals.OutputOpts())
+		_ = output.StreamMany(output.ContextFor(opts.Globals.Writer), fetchData, opts.Globals.OutputOpts())
 	}
 	return nil
 }
 
+func (opts *NamesOptions) HandleAutonameList() error {
+	addrs, err := readAddressList(opts.AutonameList)
+	if err != nil {
+		return err
+	}
+	return opts.autonameMany(addrs)
+}
+
 // readContractAndClean will read contract data and call `cleanName` for the given address
 func (opts *NamesOptions) readContractAndClean() (name *types.Name, err error) {
 	chain := opts.Globals.Chain
 
-	name = &types.Name{
-		Address:  opts.AutonameAddr,
-		Name:     base.AddrToPetname(opts.AutonameAddr.Hex(), "-"),
-		Source:   "TrueBlocks.io",
-		IsCustom: true,
-	}
-	if _, err = cleanName(chain, name); err != nil {
+	if name, err = autoname(chain, opts.AutonameAddr); err != nil {
 		err = fmt.Errorf("autoname %s: %w", opts.Autoname, err)
 		return
 	}
 
-	if !name.IsErc20 && !name.IsErc721 {
-		logger.Warn("address", name.Address, "is not a token, ignoring...")
-		name = nil
+	if name == nil {
+		logger.Warn("address", opts.AutonameAddr, "is not a token, proxy, multisig, or pool, ignoring...")
+		return
+	}
+
+	if opts.DryRun {
 		return
 	}
 
@@ -77,3 +91,233 @@ func (opts *NamesOptions) readContractAndClean() (name *types.Name, err error) {
 
 	return
 }
+
+// autoname returns a name for the address from what is found on chain or nil if nothing is found
+func autoname(chain string, address base.Address) (*types.Name, error) {
+	name := &types.Name{
+		Address:  address,
+		Name:     base.AddrToPetname(address.Hex(), "-"),
+		Source:   "TrueBlocks.io",
+		IsCustom: true,
+	}
+	if _, err := cleanName(chain, name); err != nil {
+		return nil, err
+	}
+
+	kind, err := probeContract(rpc.TempConnection(chain), address, name.IsContract)
+	if err != nil {
+		return nil, err
+	}
+	if !kind.apply(name) {
+		return nil, nil
+	}
+	return name, nil
+}
+
+// autonameMany names each of the addresses that is not already named and reports what it did. An
+// address that cannot be read from the node is reported and skipped.
+func (opts *NamesOptions) autonameMany(addrs []base.Address) error {
+	chain := opts.Globals.Chain
+
+	existing, err := names.LoadNamesMap(chain, names.Regular|names.Custom, []string{})
+	if err != nil {
+		return err
+	}
+
+	todo := make(map[base.Address]bool, len(addrs))
+	for _, addr := range addrs {
+		if _, ok := existing[addr]; !ok && !addr.IsZero() {
+			todo[addr] = true
+		}
+	}
+	nNamed := len(addrs) - len(todo)
+
+	var mutex sync.Mutex
+	found := make([]types.Name, 0, len(todo))
+	failed := make([]base.Address, 0)
+	var done int
+	iterFunc := func(address base.Address, unused bool) error {
+		name, err := autoname(chain, address)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		if err != nil {
+			logger.Warn(wrapErrorWithAddr(&address, err))
+			failed = append(failed, address)
+		} else if name != nil {
+			found = append(found, *name)
+		}
+		done++
+		logger.PctProgress(int32(done), len(todo), 10)
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errorChan := make(chan error)
+	go utils.IterateOverMap(ctx, errorChan, todo, iterFunc)
+	if stepErr := <-errorChan; stepErr != nil {
+		cancel()
+		return stepErr
+	}
+	if done > 0 {
+		logger.CleanLine()
+	}
+
+	sort.Slice(found, func(i, j int) bool {
+		return found[i].Address.Hex() < found[j].Address.Hex()
+	})
+	if !opts.DryRun && len(found) > 0 {
+		if err := names.CreateNames(names.DatabaseCustom, chain, found); err != nil {
+			return err
+		}
+	}
+
+	sort.Slice(failed, func(i, j int) bool {
+		return failed[i].Hex() < failed[j].Hex()
+	})
+
+	messages := make([]string, 0, len(found)+2)
+	for i := range found {
+		messages = append(messages, autonameMessage(&found[i], opts.DryRun))
+	}
+	summary := fmt.Sprintf("Named %d of %d addresses. %d were already named and %d were not recognized.",
+		len(found), len(addrs), nNamed, len(todo)-len(found)-len(failed))
+	if opts.DryRun {
+		summary = strings.Replace(summary, "Named", "Would name", 1)
+	}
+	if len(failed) > 0 {
+		hexes := make([]string, 0, len(failed))
+		for _, addr := range failed {
+			hexes = append(hexes, addr.Hex())
+		}
+		summary += fmt.Sprintf(" %d could not be read from the node and should be tried again: %s",
+			len(failed), strings.Join(hexes, ", "))
+	}
+	messages = append(messages, summary)
+
+	if !utils.IsFuzzing() {
+		for _, message := range messages {
+			logger.Info(message)
+		}
+	}
+
+	if opts.Globals.IsApiMode() {
+		fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
+			for _, message := range messages {
+				modelChan <- &types.Message{
+					Msg: message,
+				}
+			}
+		}
+		_ = output.StreamMany(output.ContextFor(opts.Globals.Writer), fetchData, opts.Globals.OutputOpts())
+	}
+	return nil
+}
+
+// autonameMessage reports what was found out about a named address
+func autonameMessage(name *types.Name, dryRun bool) string {
+	verb := "Updated"
+	if dryRun {
+		verb = "Would update"
+	}
+	message := fmt.Sprintf("%s name for %s, %s. Tags: %s", verb, name.Address, name.Name, name.Tags)
+	if name.IsErc20 || name.IsErc721 {
+		message += fmt.Sprintf(". ERC-20 token: %t, ERC-721 NFT: %t, Symbol: %s, Decimals: %d",
+			name.IsErc20,
+			name.IsErc721,
+			name.Symbol,
+			name.Decimals,
+		)
+	}
+	return message + ". Source: " + name.Source
+}
+
+// readAddressList returns the addresses in the file, which holds one address per line. Anything after
+// the address on a line, and any line not starting with an address (for example, a header or a comment)
+// is ignored.
+func readAddressList(path string) ([]base.Address, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	ret := []base.Address{}
+	seen := map[base.Address]bool{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.FieldsFunc(scanner.Text(), func(r rune) bool {
+			return r == ' ' || r == '\t' || r == ','
+		})
+		if len(fields) == 0 || !base.IsValidAddress(fields[0]) {
+			continue
+		}
+		addr := base.HexToAddress(fields[0])
+		if !seen[addr] {
+			seen[addr] = true
+			ret = append(ret, addr)
+		}
+	}
+	return ret, scanner.Err()
+}
+
+// getNeighbors returns the addresses, other than the address itself, that appear in the transactions
+// in the address's monitor. A transaction that cannot be read from the node is reported and skipped.
+func (opts *NamesOptions) getNeighbors() ([]base.Address, error) {
+	chain := opts.Globals.Chain
+
+	mon, _ := monitor.NewMonitor(chain, opts.AutonameAddr, false /* create */)
+	apps, cnt, err := mon.ReadAndFilterAppearances(filter.NewEmptyFilter(), false /* withCount */)
+	if err != nil {
+		return nil, err
+	} else if cnt == 0 {
+		return nil, fmt.Errorf("no appearances found for %s. Is it monitored?", opts.AutonameAddr.Hex())
+	}
+
+	// Traces find more neighbors, but not every node has them
+	_, tracing := opts.Conn.IsNodeTracing()
+	if !tracing {
+		logger.Warn("The node does not provide traces, so only transactions and logs are searched for neighbors")
+	}
+
+	found := map[base.Address]bool{}
+	procFunc := func(app *types.Appearance) error {
+		if app.Address != opts.AutonameAddr && !app.Address.IsZero() {
+			found[app.Address] = true
+		}
+		return nil
+	}
+
+	bar := logger.NewBar(logger.BarOptions{
+		Prefix:  opts.AutonameAddr.Hex(),
+		Enabled: opts.Globals.ShowProgress(),
+		Total:   int64(cnt),
+	})
+	addrMap := make(uniq.AddressBooleanMap)
+	nSkipped := 0
+	for _, app := range apps {
+		bar.Tick()
+		trans, err := opts.Conn.GetTransactionByAppearance(&app, tracing)
+		if err == nil {
+			err = uniq.GetUniqAddressesInTransaction(chain, procFunc, "", trans, trans.Timestamp, addrMap, opts.Conn)
+		}
+		if err != nil {
+			logger.Warn(fmt.Sprintf("%d.%d: %v", app.BlockNumber, app.TransactionIndex, err))
+			nSkipped++
+		}
+	}
+	bar.Finish(true /* newLine */)
+	if nSkipped > 0 {
+		logger.Warn(nSkipped, "of", cnt, "transactions could not be read from the node, so their neighbors were not searched")
+	}
+
+	ret := make([]base.Address, 0, len(found))
+	for addr := range found {
+		ret = append(ret, addr)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Hex() < ret[j].Hex()
+	})
+	return ret, nil
+}
@@ -58,7 +58,7 @@ func (opts *NamesOptions) HandleClean() error {
 				Msg: message,
 			}
 		}
-		_ = output.StreamMany(context.Background(), fetchData, opts.Globals.OutputOpts())
+		_ = output.StreamMany(output.ContextFor(opts.Globals.Writer), fetchData, opts.Globals.OutputOpts())
 	}
 	return err
 }
@@ -141,7 +141,7 @@ func (opts *NamesOptions) cleanNames() (int, error) {
 		return nil
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
 	defer cancel()
 	errorChan := make(chan error)
 	go utils.IterateOverMap(ctx, errorChan, allNames, iterFunc)
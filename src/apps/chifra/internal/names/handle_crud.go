@@ -1,7 +1,6 @@
 package namesPkg
 
 import (
-	"context"
 	"strconv"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
@@ -44,7 +43,7 @@ func (opts *NamesOptions) HandleCrud() (err error) {
 		return
 	}
 
-	ctx := context.Background()
+	ctx := output.ContextFor(opts.Globals.Writer)
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		modelChan <- name
 	}
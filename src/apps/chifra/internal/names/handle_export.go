@@ -0,0 +1,67 @@
+package namesPkg
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/manifest"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/names"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// HandleExport writes the names that match the search to a file. Bundles are signed with the key in
+// the [settings.names] section of the config.
+func (opts *NamesOptions) HandleExport() error {
+	chain := opts.Globals.Chain
+
+	format, err := names.ExchangeFormatFromPath(opts.Export)
+	if err != nil {
+		return err
+	}
+
+	var key *ecdsa.PrivateKey
+	if format == names.ExchangeBundle {
+		settings := config.GetNames()
+		if key, err = manifest.ReadKey(settings.Keystore, settings.Password, settings.PrivateKey); err != nil {
+			return fmt.Errorf("signing the bundle: %w", err)
+		} else if key == nil {
+			return errors.New("signing the bundle: no keystore or private key in the [settings.names] section of the config")
+		}
+	}
+
+	namesArray, err := names.LoadNamesArray(chain, opts.getType(), names.SortByAddress, opts.Terms)
+	if err != nil {
+		return err
+	}
+	exported := make([]types.Name, 0, len(namesArray))
+	for _, name := range namesArray {
+		if !name.Deleted {
+			exported = append(exported, name)
+		}
+	}
+
+	out, err := os.Create(opts.Export)
+	if err != nil {
+		return err
+	}
+	if err = names.WriteExchange(out, format, chain, exported, key); err != nil {
+		out.Close()
+		return err
+	}
+	if err = out.Close(); err != nil {
+		return err
+	}
+
+	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
+		modelChan <- &types.Message{
+			Msg: fmt.Sprintf("%d names exported to %s", len(exported), opts.Export),
+			Num: int64(len(exported)),
+		}
+	}
+
+	return output.StreamMany(output.ContextFor(opts.Globals.Writer), fetchData, opts.Globals.OutputOpts())
+}
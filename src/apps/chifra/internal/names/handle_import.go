@@ -0,0 +1,73 @@
+package namesPkg
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/names"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// HandleImport merges the names in a file into the custom names database and reports the conflicts. A
+// bundle is only imported if its signer is one of the trustedSigners in the [settings.names] section of
+// the config.
+func (opts *NamesOptions) HandleImport() error {
+	chain := opts.Globals.Chain
+
+	format, err := names.ExchangeFormatFromPath(opts.Import)
+	if err != nil {
+		return err
+	}
+	input, err := os.Open(opts.Import)
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	trusted := []base.Address{}
+	for _, signer := range config.GetNames().TrustedSigners {
+		trusted = append(trusted, base.HexToAddress(signer))
+	}
+
+	exchange, err := names.ReadExchange(input, format, trusted)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", opts.Import, err)
+	}
+	if exchange.Chain != "" && exchange.Chain != chain {
+		return fmt.Errorf("the names in %s are for chain %s, not %s", opts.Import, exchange.Chain, chain)
+	}
+
+	provenance := "import:" + filepath.Base(opts.Import)
+	if !exchange.Signer.IsZero() {
+		provenance += " signed by " + exchange.Signer.Hex()
+	}
+
+	result, err := names.MergeNames(chain, exchange.Names, provenance, names.MergePolicy(opts.Merge), opts.DryRun)
+	if err != nil {
+		return err
+	}
+
+	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
+		for _, conflict := range result.Conflicts {
+			modelChan <- &types.Message{
+				Msg: "conflict: " + conflict.String(),
+			}
+		}
+
+		verb := "imported"
+		if opts.DryRun {
+			verb = "would be imported (dry run)"
+		}
+		modelChan <- &types.Message{
+			Msg: fmt.Sprintf("%d names %s from %s: %d added, %d updated, %d unchanged, %d conflicts",
+				len(exchange.Names), verb, opts.Import, result.Added, result.Updated, result.Unchanged, len(result.Conflicts)),
+			Num: int64(result.Added + result.Updated),
+		}
+	}
+
+	return output.StreamMany(output.ContextFor(opts.Globals.Writer), fetchData, opts.Globals.OutputOpts())
+}
@@ -52,7 +52,7 @@ func (opts *NamesOptions) HandleShow() error {
 			logger.Warn("falling back to file-based search")
 		}
 
-		namesArray, err := names.LoadNamesArray(chain, opts.getType(), names.SortByAddress, opts.Terms)
+		namesArray, err := names.LoadNamesArray(chain, opts.getType(), opts.sortBy(), opts.Terms)
 		if err != nil {
 			return err
 		}
@@ -81,7 +81,7 @@ func (opts *NamesOptions) HandleShow() error {
 		extraOpts["single"] = "address"
 		opts.Globals.NoHeader = true
 	}
-	ctx := context.Background()
+	ctx := output.ContextFor(opts.Globals.Writer)
 	return output.StreamMany(ctx, fetchData, opts.Globals.OutputOptsWithExtra(extraOpts))
 }
 
@@ -89,7 +89,7 @@ func (opts *NamesOptions) fetchFromGrpc(client proto.NamesClient, modelChan chan
 	stream, err := client.SearchStream(context.Background(), &proto.SearchRequest{
 		Parts: int64(opts.getType()),
 		Terms: opts.Terms,
-		Sort:  int64(names.SortByAddress),
+		Sort:  int64(opts.sortBy()),
 	})
 	if err != nil {
 		errorChan <- err
@@ -110,3 +110,12 @@ func (opts *NamesOptions) fetchFromGrpc(client proto.NamesClient, modelChan chan
 		errorChan <- fmt.Errorf("no known names found for %v", opts.Terms)
 	}
 }
+
+// sortBy returns the order of the results. Names are sorted by address unless the search uses the
+// query syntax (field:term, term*, or term~), in which case the best matches come first.
+func (opts *NamesOptions) sortBy() names.SortBy {
+	if names.UsesQuerySyntax(opts.Terms) {
+		return names.SortByRank
+	}
+	return names.SortByAddress
+}
@@ -1,7 +1,6 @@
 package namesPkg
 
 import (
-	"context"
 	"os"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
@@ -22,7 +21,7 @@ func (opts *NamesOptions) HandleTags() error {
 	}
 
 	tagsMap := make(map[string]bool, len(namesArray)/10)
-	ctx := context.Background()
+	ctx := output.ContextFor(opts.Globals.Writer)
 
 	// Note: Make sure to add an entry to enabledForCmd in src/apps/chifra/pkg/output/helpers.go
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
@@ -28,33 +28,40 @@ import (
 
 // NamesOptions provides all command options for the chifra names command.
 type NamesOptions struct {
-	Terms     []string              `json:"terms,omitempty"`     // A space separated list of one or more search terms
-	Expand    bool                  `json:"expand,omitempty"`    // Expand search to include all fields (search name, address, and symbol otherwise)
-	MatchCase bool                  `json:"matchCase,omitempty"` // Do case-sensitive search
-	All       bool                  `json:"all,omitempty"`       // Include all (including custom) names in the search
-	Custom    bool                  `json:"custom,omitempty"`    // Include only custom named accounts in the search
-	Prefund   bool                  `json:"prefund,omitempty"`   // Include prefund accounts in the search
-	Addr      bool                  `json:"addr,omitempty"`      // Display only addresses in the results (useful for scripting, assumes --no_header)
-	Tags      bool                  `json:"tags,omitempty"`      // Export the list of tags and subtags only
-	Clean     bool                  `json:"clean,omitempty"`     // Clean the data (addrs to lower case, sort by addr)
-	Regular   bool                  `json:"regular,omitempty"`   // Only available with --clean, cleans regular names database
-	DryRun    bool                  `json:"dryRun,omitempty"`    // Only available with --clean or --autoname, outputs changes to stdout instead of updating databases
-	Autoname  string                `json:"autoname,omitempty"`  // An address assumed to be a token, added automatically to names database if true
-	Create    bool                  `json:"create,omitempty"`    // Create a new name record
-	Update    bool                  `json:"update,omitempty"`    // Edit an existing name
-	Delete    bool                  `json:"delete,omitempty"`    // Delete a name, but do not remove it
-	Undelete  bool                  `json:"undelete,omitempty"`  // Undelete a previously deleted name
-	Remove    bool                  `json:"remove,omitempty"`    // Remove a previously deleted name
-	Globals   globals.GlobalOptions `json:"globals,omitempty"`   // The global options
-	Conn      *rpc.Connection       `json:"conn,omitempty"`      // The connection to the RPC server
-	BadFlag   error                 `json:"badFlag,omitempty"`   // An error flag if needed
+	Terms        []string              `json:"terms,omitempty"`        // A space separated list of one or more search terms
+	Expand       bool                  `json:"expand,omitempty"`       // Expand search to include all fields (search name, address, and symbol otherwise)
+	MatchCase    bool                  `json:"matchCase,omitempty"`    // Do case-sensitive search
+	All          bool                  `json:"all,omitempty"`          // Include all (including custom) names in the search
+	Custom       bool                  `json:"custom,omitempty"`       // Include only custom named accounts in the search
+	Prefund      bool                  `json:"prefund,omitempty"`      // Include prefund accounts in the search
+	Addr         bool                  `json:"addr,omitempty"`         // Display only addresses in the results (useful for scripting, assumes --no_header)
+	Tags         bool                  `json:"tags,omitempty"`         // Export the list of tags and subtags only
+	Clean        bool                  `json:"clean,omitempty"`        // Clean the data (addrs to lower case, sort by addr)
+	Regular      bool                  `json:"regular,omitempty"`      // Only available with --clean, cleans regular names database
+	DryRun       bool                  `json:"dryRun,omitempty"`       // Only available with --clean, --autoname, or --import, outputs changes to stdout instead of updating databases
+	Autoname     string                `json:"autoname,omitempty"`     // An address to name from what is found on chain (a token, proxy, multisig, pool, or ENS name)
+	AutonameList string                `json:"autonameList,omitempty"` // A file containing the addresses to name as with --autoname, one per line
+	Neighbors    bool                  `json:"neighbors,omitempty"`    // For --autoname only, name the addresses found in the monitor for the address instead of the address itself
+	Create       bool                  `json:"create,omitempty"`       // Create a new name record
+	Update       bool                  `json:"update,omitempty"`       // Edit an existing name
+	Delete       bool                  `json:"delete,omitempty"`       // Delete a name, but do not remove it
+	Undelete     bool                  `json:"undelete,omitempty"`     // Undelete a previously deleted name
+	Remove       bool                  `json:"remove,omitempty"`       // Remove a previously deleted name
+	Import       string                `json:"import,omitempty"`       // Import the names in a csv, tsv, json, or signed bundle file into the custom names database
+	Export       string                `json:"export,omitempty"`       // Export the names that match the search to a csv, tsv, json, or signed bundle file
+	Merge        string                `json:"merge,omitempty"`        // For --import only, resolve conflicts by keeping my values, their values, or mine with theirs added to the tags
+	Globals      globals.GlobalOptions `json:"globals,omitempty"`      // The global options
+	Conn         *rpc.Connection       `json:"conn,omitempty"`         // The connection to the RPC server
+	BadFlag      error                 `json:"badFlag,omitempty"`      // An error flag if needed
 	// EXISTING_CODE
 	crudData     *CrudData
 	AutonameAddr base.Address `json:"-"`
 	// EXISTING_CODE
 }
 
-var defaultNamesOptions = NamesOptions{}
+var defaultNamesOptions = NamesOptions{
+	Merge: "mine",
+}
 
 // testLog is used only during testing to export the options for this test case.
 func (opts *NamesOptions) testLog() {
@@ -70,11 +77,16 @@ func (opts *NamesOptions) testLog() {
 	logger.TestLog(opts.Regular, "Regular: ", opts.Regular)
 	logger.TestLog(opts.DryRun, "DryRun: ", opts.DryRun)
 	logger.TestLog(len(opts.Autoname) > 0, "Autoname: ", opts.Autoname)
+	logger.TestLog(len(opts.AutonameList) > 0, "AutonameList: ", opts.AutonameList)
+	logger.TestLog(opts.Neighbors, "Neighbors: ", opts.Neighbors)
 	logger.TestLog(opts.Create, "Create: ", opts.Create)
 	logger.TestLog(opts.Update, "Update: ", opts.Update)
 	logger.TestLog(opts.Delete, "Delete: ", opts.Delete)
 	logger.TestLog(opts.Undelete, "Undelete: ", opts.Undelete)
 	logger.TestLog(opts.Remove, "Remove: ", opts.Remove)
+	logger.TestLog(len(opts.Import) > 0, "Import: ", opts.Import)
+	logger.TestLog(len(opts.Export) > 0, "Export: ", opts.Export)
+	logger.TestLog(len(opts.Merge) > 0 && opts.Merge != "mine", "Merge: ", opts.Merge)
 	opts.Conn.TestLog(opts.getCaches())
 	opts.Globals.TestLog()
 }
@@ -98,6 +110,7 @@ func NamesFinishParseInternal(w io.Writer, values url.Values) *NamesOptions {
 	copy := defaultNamesOptions
 	copy.Globals.Caps = getCaps()
 	opts := &copy
+	opts.Merge = "mine"
 	for key, value := range values {
 		switch key {
 		case "terms":
@@ -127,6 +140,10 @@ func NamesFinishParseInternal(w io.Writer, values url.Values) *NamesOptions {
 			opts.DryRun = true
 		case "autoname":
 			opts.Autoname = value[0]
+		case "autonameList":
+			opts.AutonameList = value[0]
+		case "neighbors":
+			opts.Neighbors = true
 		case "create":
 			opts.Create = true
 		case "update":
@@ -137,6 +154,12 @@ func NamesFinishParseInternal(w io.Writer, values url.Values) *NamesOptions {
 			opts.Undelete = true
 		case "remove":
 			opts.Remove = true
+		case "import":
+			opts.Import = value[0]
+		case "export":
+			opts.Export = value[0]
+		case "merge":
+			opts.Merge = value[0]
 		default:
 			if !copy.Globals.Caps.HasKey(key) {
 				err := validate.Usage("Invalid key ({0}) in {1} route.", key, "names")
@@ -211,6 +234,7 @@ func ResetOptions(testMode bool) {
 	opts.Globals.TestMode = testMode
 	opts.Globals.Writer = w
 	opts.Globals.Caps = getCaps()
+	opts.Merge = "mine"
 	defaultNamesOptions = opts
 }
 
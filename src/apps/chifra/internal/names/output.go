@@ -61,8 +61,14 @@ func (opts *NamesOptions) NamesInternal() error {
 	// EXISTING_CODE
 	if len(opts.Autoname) > 0 {
 		err = opts.HandleAutoname()
+	} else if len(opts.AutonameList) > 0 {
+		err = opts.HandleAutonameList()
 	} else if opts.Clean {
 		err = opts.HandleClean()
+	} else if len(opts.Import) > 0 {
+		err = opts.HandleImport()
+	} else if len(opts.Export) > 0 {
+		err = opts.HandleExport()
 	} else if opts.Tags {
 		err = opts.HandleTags()
 	} else {
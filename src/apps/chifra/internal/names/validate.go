@@ -7,6 +7,9 @@ package namesPkg
 import (
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/monitor"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/names"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/validate"
 )
@@ -24,9 +27,39 @@ func (opts *NamesOptions) validateNames() error {
 		return validate.Usage("chain {0} is not properly configured.", chain)
 	}
 
-	isDryRunnable := opts.Clean || len(opts.Autoname) > 0
+	isDryRunnable := opts.Clean || len(opts.Autoname) > 0 || len(opts.AutonameList) > 0 || len(opts.Import) > 0
 	if opts.DryRun && !isDryRunnable {
-		return validate.Usage("The {0} option is only available with the {1} options.", "--dry_run", "--clean or --autoname")
+		return validate.Usage("The {0} option is only available with the {1} options.", "--dry_run", "--clean, --autoname, or --import")
+	}
+
+	if len(opts.Import) > 0 || len(opts.Export) > 0 {
+		option, path := "--import", opts.Import
+		if len(opts.Export) > 0 {
+			option, path = "--export", opts.Export
+		}
+		if len(opts.Import) > 0 && len(opts.Export) > 0 {
+			return validate.Usage("Please choose only one of {0}.", "--import or --export")
+		}
+		if opts.Globals.IsApiMode() {
+			return validate.Usage("The {0} option is not available{1}.", option, " in api mode")
+		}
+		if opts.Clean || len(opts.Autoname) > 0 || len(opts.AutonameList) > 0 || opts.Tags || opts.Addr || opts.anyCrud() {
+			return validate.Usage("The {0} option is not available{1}.", option, " with any other editing or display option")
+		}
+		if _, err := names.ExchangeFormatFromPath(path); err != nil {
+			return validate.Usage("The file given to the {0} option must end in {1}.", option, ".csv, .tsv, .json, or .bundle")
+		}
+		if len(opts.Import) > 0 && !file.FileExists(opts.Import) {
+			return validate.Usage("The file given to the {0} option ({1}) was not found.", "--import", opts.Import)
+		}
+	}
+
+	if len(opts.Import) > 0 {
+		if err := validate.ValidateEnum("--merge", opts.Merge, "[mine|theirs|tags]"); err != nil {
+			return err
+		}
+	} else if len(opts.Merge) > 0 && opts.Merge != "mine" {
+		return validate.Usage("The {0} option is only available with the {1} option.", "--merge", "--import")
 	}
 
 	if opts.Tags {
@@ -58,11 +91,30 @@ func (opts *NamesOptions) validateNames() error {
 	}
 
 	if opts.Prefund {
-		if opts.Clean || len(opts.Autoname) > 0 || opts.anyCrud() {
+		if opts.Clean || len(opts.Autoname) > 0 || len(opts.AutonameList) > 0 || len(opts.Import) > 0 || opts.anyCrud() {
 			return validate.Usage("You may not use the {0} option when editing names.", "--prefund")
 		}
 	}
 
+	if opts.Neighbors && len(opts.Autoname) == 0 {
+		return validate.Usage("The {0} option is only available with the {1} option.", "--neighbors", "--autoname")
+	}
+
+	if len(opts.AutonameList) > 0 {
+		if len(opts.Autoname) > 0 {
+			return validate.Usage("Please choose only one of {0}.", "--autoname or --autoname_list")
+		}
+		if opts.Regular {
+			return validate.Usage("The {0} option is not available{1}.", "--regular", " with the --autoname_list option")
+		}
+		if opts.Globals.IsApiMode() {
+			return validate.Usage("The {0} option is not available{1}.", "--autoname_list", " in api mode")
+		}
+		if !file.FileExists(opts.AutonameList) {
+			return validate.Usage("The file given to the {0} option ({1}) was not found.", "--autoname_list", opts.AutonameList)
+		}
+	}
+
 	if len(opts.Autoname) > 0 {
 		if opts.Regular {
 			return validate.Usage("The {0} option is not available{1}.", "--regular", " with the --autoname option")
@@ -71,9 +123,14 @@ func (opts *NamesOptions) validateNames() error {
 		if !base.IsValidAddress(opts.Autoname) || opts.AutonameAddr.IsZero() {
 			return validate.Usage("You must provide an address to the {0} option.", "--autoname")
 		}
-		if err := opts.Conn.IsContractAtLatest(opts.AutonameAddr); err != nil {
+		if opts.Neighbors {
+			mon := monitor.Monitor{Address: opts.AutonameAddr, Chain: chain}
+			if !file.FileExists(mon.Path()) {
+				return validate.Usage("The {0} option requires a monitor for {1}. Create one with {2}.", "--neighbors", opts.AutonameAddr.Hex(), "chifra list")
+			}
+		} else if err := opts.Conn.IsContractAtLatest(opts.AutonameAddr); err != nil {
 			if err == rpc.ErrNotAContract {
-				return validate.Usage("The address provided to the {0} option is not a token contract.", "--autoname")
+				return validate.Usage("The address provided to the {0} option is not a contract.", "--autoname")
 			}
 			// ignore this error... we'll catch it later
 		}
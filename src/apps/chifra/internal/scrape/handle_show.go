@@ -15,6 +15,7 @@ import (
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/notify"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/tslib"
 )
 
@@ -96,6 +97,7 @@ func (opts *ScrapeOptions) HandleScrape() error {
 			nUnripe:      0,
 			timestamps:   make(map[base.Blknum]tslib.TimestampRecord, opts.BlockCnt),
 			processedMap: make(map[base.Blknum]bool, opts.BlockCnt),
+			unripeHashes: make(map[base.Blknum]base.Hash),
 			meta:         bm.meta,
 			nChannels:    int(opts.Settings.ChannelCount),
 		}
@@ -148,6 +150,12 @@ func (opts *ScrapeOptions) HandleScrape() error {
 			goto PAUSE
 		}
 
+		// Some of the unripe blocks may have been re-orged while we scraped. Before anyone reads them,
+		// we roll those back and tell anyone listening that their appearances are no longer valid.
+		if _, err = opts.RollbackReorgs(bm.meta); err != nil {
+			logger.Error(colors.BrightRed+"error checking for re-orgs:", err, colors.Off)
+		}
+
 		if bm.nRipe == 0 {
 			logger.Info(colors.Green+"no ripe files to consolidate", spaces, colors.Off)
 			goto PAUSE
@@ -166,6 +174,12 @@ func (opts *ScrapeOptions) HandleScrape() error {
 		}
 
 	PAUSE:
+		bm.recordMetrics()
+		bm.publishProgress()
+		if opts.Notify {
+			// Deliver anything left in the spool by sinks that were unavailable
+			notify.Flush(chain)
+		}
 		runCount++
 		if opts.RunCount != 0 && runCount >= opts.RunCount {
 			// No reason to clean up here. Next round will do so and user can use these files in the meantime.
@@ -188,6 +202,12 @@ func (opts *ScrapeOptions) HandleScrape() error {
 			logger.Error(colors.BrightRed+err.Error(), colors.Off)
 		}
 
+		// The unripe blocks may also have been re-orged while they were readable during the pause. The
+		// next pass records new hashes before it checks, so this is our last chance to tell anyone.
+		if _, err = opts.RollbackReorgs(bm.meta); err != nil {
+			logger.Error(colors.BrightRed+"error checking for re-orgs:", err, colors.Off)
+		}
+
 		// We want to clean up the unripe files. The chain may have (it frequently does)
 		// re-orged. We want to re-qeury these next round. This is why we have an unripePath.
 		if err = os.RemoveAll(bm.UnripeFolder()); err != nil {
@@ -1,67 +1,65 @@
 package scrapePkg
 
 import (
-	"bytes"
-	"encoding/json"
-	"errors"
-	"fmt"
-	"io"
-	"net/http"
-	"strings"
-	"syscall"
+	"sort"
 
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/notify"
 )
 
-var ErrConfiguredButNotRunning = fmt.Errorf("listener is configured but not running")
-
-// GetNotifyEndpoint returns the notification endpoint
-func GetNotifyEndpoint() string {
-	endpoint := config.GetSettings().Notify.Url
-	// If protocol is not specified, use http by default
-	if endpoint != "" && !strings.HasPrefix(endpoint, "http") {
-		endpoint = "http://" + endpoint
-	}
-	return endpoint
-}
-
 // NotifyConfigured returns true if notification feature is configured
 func NotifyConfigured() bool {
-	return GetNotifyEndpoint() != ""
+	return len(config.GetNotifySinks()) > 0
 }
 
-// Notify may be used to tell other processes about progress.
-func Notify[T notify.NotificationPayload](notification notify.Notification[T]) error {
-	endpoint := GetNotifyEndpoint()
-	if endpoint == "" {
+// Notify may be used to tell other processes about progress. The notification is spooled and then
+// delivered to each of the configured sinks (see notify.Send).
+func Notify[T notify.NotificationPayload](chain string, notification notify.Notification[T]) error {
+	if !NotifyConfigured() {
 		return nil
 	}
-	return notifyEndpoint(endpoint, notification)
+	return notify.Send(chain, notification)
 }
 
-func notifyEndpoint(endpoint string, notification any) error {
-	encoded, err := json.Marshal(notification)
-	if err != nil {
-		return fmt.Errorf("marshalling message: %w", err)
+// publishVisited tells in-process listeners (see notify.Listen) about the blocks written during this
+// pass. Blocks are written concurrently, so they are sorted first. Listeners receive each block and the
+// appearances of each ripe block. Unripe appearances are not published as they may be re-orged.
+func (bm *BlazeManager) publishVisited() {
+	visited := bm.visited
+	bm.visited = nil
+	if len(visited) == 0 {
+		return
 	}
 
-	resp, err := http.Post(
-		endpoint,
-		"application/json",
-		bytes.NewReader(encoded),
-	)
-
-	if err != nil {
-		if errors.Is(err, syscall.ECONNREFUSED) {
-			return ErrConfiguredButNotRunning
+	sort.Slice(visited, func(i, j int) bool {
+		return visited[i].block.BlockNumber < visited[j].block.BlockNumber
+	})
+	for _, v := range visited {
+		v.block.Timestamp = base.Timestamp(bm.timestamps[v.block.BlockNumber].Ts)
+		notify.Publish(bm.chain, *notify.NewBlockNotification(bm.meta, v.block))
+		if !v.block.Unripe && len(v.appearances) > 0 {
+			sort.Slice(v.appearances, func(i, j int) bool {
+				a, b := v.appearances[i], v.appearances[j]
+				if a.TransactionIndex != b.TransactionIndex {
+					return a.TransactionIndex < b.TransactionIndex
+				}
+				return a.Address < b.Address
+			})
+			notify.Publish(bm.chain, *notify.NewAppearanceNotification(bm.meta, v.appearances))
 		}
-		return fmt.Errorf("sending notification: %w", err)
 	}
-	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		respBody, _ := io.ReadAll(resp.Body)
-		resp.Body.Close()
-		return fmt.Errorf("listener responded with %d: %s", resp.StatusCode, respBody)
+}
+
+// publishProgress tells in-process listeners about the pass of the scraper that just completed
+func (bm *BlazeManager) publishProgress() {
+	if !notify.Listening() || bm.nProcessed() == 0 {
+		return
 	}
-	return nil
+	notify.Publish(bm.chain, *notify.NewProgressNotification(bm.meta, notify.NotificationPayloadProgress{
+		FirstBlock: bm.StartBlock(),
+		LastBlock:  bm.EndBlock() - 1,
+		NRipe:      bm.nRipe,
+		NUnripe:    bm.nUnripe,
+	}))
 }
@@ -58,5 +58,9 @@ func (bm *BlazeManager) ScrapeBatch(blocks []base.Blknum) (error, bool) {
 		), true
 	}
 
+	if err := bm.WriteUnripeHashes(); err != nil {
+		return err, true
+	}
+
 	return bm.WriteTimestamps(blocks), true
 }
@@ -71,6 +71,9 @@ func (bm *BlazeManager) HandleBlaze(blocks []base.Blknum) (err error, ok bool) {
 	close(tsChannel)
 	tsWg.Wait()
 
+	// ...and tell any in-process listeners, in block order, what we found.
+	bm.publishVisited()
+
 	return nil, true
 }
 
@@ -95,6 +98,8 @@ func (bm *BlazeManager) ProcessBlocks(blockChannel chan base.Blknum, blockWg *sy
 			bm.errors = append(bm.errors, scrapeError{block: bn, err: err})
 		} else if sd.withdrawals, sd.miner, err = bm.opts.Conn.GetMinerAndWithdrawals(bn); err != nil {
 			bm.errors = append(bm.errors, scrapeError{block: bn, err: err})
+		} else if sd.hash, err = bm.unripeHash(bn); err != nil {
+			bm.errors = append(bm.errors, scrapeError{block: bn, err: err})
 		} else {
 			appearanceChannel <- sd
 		}
@@ -123,6 +128,10 @@ func (bm *BlazeManager) ProcessAppearances(appearanceChannel chan scrapedData, a
 			_ = uniq.AddMiner(bm.chain, sData.miner, sData.bn, addrMap)
 			if err = bm.WriteAppearances(sData.bn, addrMap); err != nil {
 				bm.errors = append(bm.errors, scrapeError{block: sData.bn, err: err})
+			} else if sData.bn > bm.ripeBlock && len(addrMap) > 0 {
+				writeMutex.Lock()
+				bm.unripeHashes[sData.bn] = sData.hash
+				writeMutex.Unlock()
 			}
 		}
 		tsChannel <- sData.ts
@@ -193,7 +202,7 @@ func (bm *BlazeManager) WriteAppearances(bn base.Blknum, addrMap uniq.AddressBoo
 	}
 
 	if bm.opts.Notify && bn <= bm.ripeBlock {
-		err = Notify(notify.Notification[[]notify.NotificationPayloadAppearance]{
+		err = Notify(bm.chain, notify.Notification[[]notify.NotificationPayloadAppearance]{
 			Msg:     notify.MessageAppearance,
 			Meta:    bm.meta,
 			Payload: notificationPayload,
@@ -213,6 +222,16 @@ func (bm *BlazeManager) WriteAppearances(bn base.Blknum, addrMap uniq.AddressBoo
 	} else {
 		bm.nRipe++
 	}
+	if notify.Listening() {
+		bm.visited = append(bm.visited, visitedBlock{
+			block: notify.NotificationPayloadBlock{
+				BlockNumber:  bn,
+				NAppearances: len(addrMap),
+				Unripe:       bn > bm.ripeBlock,
+			},
+			appearances: notificationPayload,
+		})
+	}
 	writeMutex.Unlock()
 
 	return
@@ -251,6 +270,7 @@ func (bm *BlazeManager) syncedReporting(bn base.Blknum, force bool) {
 type scrapedData struct {
 	bn          base.Blknum
 	ts          tslib.TimestampRecord
+	hash        base.Hash
 	traces      []types.Trace
 	receipts    []types.Receipt
 	withdrawals []types.Withdrawal
@@ -8,13 +8,13 @@ import (
 )
 
 func (opts *ScrapeOptions) NotifyChunkWritten(chunk index.Chunk, chunkPath string) (err error) {
-	if !opts.Notify {
+	if !opts.Notify && !notify.Listening() {
 		return nil
 	}
 
 	// If --notify is on, it's properly configured and IPFS is running
 	var cidString string
-	if config.IpfsRunning() { // probablyh redundant
+	if opts.Notify && config.IpfsRunning() { // probablyh redundant
 		if cidString, err = index.ChunkCid(chunkPath); err != nil {
 			return err
 		}
@@ -22,7 +22,7 @@ func (opts *ScrapeOptions) NotifyChunkWritten(chunk index.Chunk, chunkPath strin
 
 	// Generate range from path, as chunks sometimes don't have Range set
 	chunkRange := base.RangeFromFilename(index.ToIndexPath(chunkPath))
-	return Notify(notify.Notification[[]notify.NotificationPayloadChunkWritten]{
+	notification := notify.Notification[[]notify.NotificationPayloadChunkWritten]{
 		Msg:  notify.MessageChunkWritten,
 		Meta: nil,
 		Payload: []notify.NotificationPayloadChunkWritten{
@@ -32,5 +32,11 @@ func (opts *ScrapeOptions) NotifyChunkWritten(chunk index.Chunk, chunkPath strin
 				Author: config.GetRootConfig().Settings.Notify.Author,
 			},
 		},
-	})
+	}
+	notify.Publish(opts.Globals.Chain, notification)
+
+	if !opts.Notify {
+		return nil
+	}
+	return Notify(opts.Globals.Chain, notification)
 }
@@ -160,7 +160,7 @@ func (bm *BlazeManager) Consolidate(blocks []base.Blknum) (error, bool) {
 	bm.report(len(blocks), int(bm.PerChunk()), nChunks, nAppsNow, nAppsFound, nAddrsFound)
 
 	if bm.opts.Notify {
-		if err := Notify(notify.Notification[string]{
+		if err := Notify(bm.chain, notify.Notification[string]{
 			Msg:     notify.MessageStageUpdated,
 			Meta:    bm.meta,
 			Payload: newRange.String(),
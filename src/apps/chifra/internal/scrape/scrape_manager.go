@@ -5,6 +5,7 @@ import (
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/notify"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/tslib"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
 )
@@ -16,6 +17,8 @@ type BlazeManager struct {
 	chain        string
 	timestamps   map[base.Blknum]tslib.TimestampRecord
 	processedMap map[base.Blknum]bool
+	unripeHashes map[base.Blknum]base.Hash
+	visited      []visitedBlock
 	opts         *ScrapeOptions
 	meta         *types.MetaData
 	startBlock   base.Blknum
@@ -28,6 +31,13 @@ type BlazeManager struct {
 	errors       []scrapeError
 }
 
+// visitedBlock records a block written during the current pass so it may be published to
+// in-process listeners once the pass is complete (see publishVisited)
+type visitedBlock struct {
+	block       notify.NotificationPayloadBlock
+	appearances []notify.NotificationPayloadAppearance
+}
+
 type scrapeError struct {
 	block base.Blknum
 	err   error
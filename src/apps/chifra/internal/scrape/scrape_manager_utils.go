@@ -8,6 +8,7 @@ import (
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/colors"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/metrics"
 )
 
 // Report prints out a report of the progress of the scraper.
@@ -31,6 +32,26 @@ func (bm *BlazeManager) report(nBlocks, perChunk, nChunks, nAppsNow, nAppsFound,
 	logger.Info(colors.Colored(msg))
 }
 
+// recordMetrics reports the progress of the most recent pass of the scraper to the metrics package.
+func (bm *BlazeManager) recordMetrics() {
+	if bm.meta == nil {
+		return
+	}
+	staged := base.Blknum(0)
+	if bm.meta.Staging > bm.meta.Finalized {
+		staged = bm.meta.Staging - bm.meta.Finalized
+	}
+	metrics.SetScraperProgress(bm.chain, metrics.ScraperProgress{
+		Ripe:      uint64(bm.nRipe),
+		Unripe:    uint64(bm.nUnripe),
+		Staged:    uint64(staged),
+		Latest:    uint64(bm.meta.Latest),
+		Finalized: uint64(bm.meta.Finalized),
+		Staging:   uint64(bm.meta.Staging),
+		UnripeAt:  uint64(bm.meta.Unripe),
+	})
+}
+
 // Pause goes to sleep for a period of time based on the settings.
 func (opts *ScrapeOptions) pause(dist base.Blknum) {
 	// we always pause at least a quarter of a second to allow the node to 'rest'
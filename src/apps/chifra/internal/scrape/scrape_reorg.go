@@ -0,0 +1,145 @@
+package scrapePkg
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/notify"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/utils"
+)
+
+// unripeHashesPath returns the path of the file recording the hashes of the blocks in the unripe folder.
+// It is kept outside of the unripe folder so as not to be mistaken for an unripe block.
+func unripeHashesPath(chain string) string {
+	return filepath.Join(config.PathToIndex(chain), "unripe.hashes")
+}
+
+// unripeHash returns the hash of the block if it is unripe. Ripe blocks are not checked for re-orgs,
+// so we don't bother asking for their hashes.
+func (bm *BlazeManager) unripeHash(bn base.Blknum) (base.Hash, error) {
+	if bn <= bm.ripeBlock {
+		return base.Hash{}, nil
+	}
+	return bm.opts.Conn.GetBlockHashByNumber(bn)
+}
+
+// WriteUnripeHashes records the hash of each block written to the unripe folder during this pass so
+// the next pass may tell if any of them have been re-orged.
+func (bm *BlazeManager) WriteUnripeHashes() error {
+	blocks := make([]base.Blknum, 0, len(bm.unripeHashes))
+	for bn := range bm.unripeHashes {
+		blocks = append(blocks, bn)
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i] < blocks[j]
+	})
+
+	var sb strings.Builder
+	for _, bn := range blocks {
+		hash := bm.unripeHashes[bn]
+		sb.WriteString(fmt.Sprintf("%d\t%s\n", bn, hash.Hex()))
+	}
+	return os.WriteFile(unripeHashesPath(bm.chain), []byte(sb.String()), 0644)
+}
+
+// readUnripeHashes returns the hashes recorded by the previous pass (if any)
+func readUnripeHashes(chain string) (map[base.Blknum]base.Hash, error) {
+	ret := make(map[base.Blknum]base.Hash)
+	fp, err := os.Open(unripeHashesPath(chain))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ret, nil
+		}
+		return nil, err
+	}
+	defer fp.Close()
+
+	scanner := bufio.NewScanner(fp)
+	for scanner.Scan() {
+		parts := strings.Split(scanner.Text(), "\t")
+		if len(parts) != 2 {
+			continue
+		}
+		ret[base.MustParseBlknum(parts[0])] = base.HexToHash(parts[1])
+	}
+	return ret, scanner.Err()
+}
+
+// RollbackReorgs compares the hashes of the unripe blocks written during this pass with the node's.
+// Where they differ, the block has been re-orged. Its unripe file is removed and listeners (and, if
+// --notify is on, the sinks) are told which appearances are no longer valid. It returns the re-orged
+// blocks.
+func (opts *ScrapeOptions) RollbackReorgs(meta *types.MetaData) ([]base.Blknum, error) {
+	chain := opts.Globals.Chain
+	reorged, invalid, err := rollbackReorgs(chain, opts.Conn.GetBlockHashByNumber)
+	if err != nil || len(reorged) == 0 {
+		return reorged, err
+	}
+
+	logger.Warn(fmt.Sprintf("re-org detected: rolled back %d unripe blocks (%d appearances) from block %d", len(reorged), len(invalid), reorged[0]))
+
+	notify.Publish(chain, *notify.NewReorgNotification(meta, invalid))
+	if opts.Notify {
+		if err := Notify(chain, *notify.NewReorgNotification(meta, invalid)); err != nil {
+			return reorged, err
+		}
+	}
+
+	return reorged, nil
+}
+
+// rollbackReorgs removes the unripe files of the blocks whose hashes (as given by hashOf) are not the
+// recorded ones. It returns the removed blocks, sorted, and the appearances they held.
+func rollbackReorgs(chain string, hashOf func(base.Blknum) (base.Hash, error)) ([]base.Blknum, []notify.NotificationPayloadAppearance, error) {
+	hashes, err := readUnripeHashes(chain)
+	if err != nil || len(hashes) == 0 {
+		return nil, nil, err
+	}
+
+	unripePath := filepath.Join(config.PathToIndex(chain), "unripe")
+	reorged := make([]base.Blknum, 0)
+	invalid := make([]notify.NotificationPayloadAppearance, 0)
+	for bn, hash := range hashes {
+		fileName := filepath.Join(unripePath, utils.PadNum(int(bn), 9)+".txt")
+		if _, err := os.Stat(fileName); os.IsNotExist(err) {
+			continue
+		}
+
+		// A block the node no longer has returns an empty hash, which is also a re-org
+		if current, err := hashOf(bn); err != nil {
+			return reorged, invalid, err
+		} else if current == hash {
+			continue
+		}
+
+		lines := file.AsciiFileToLines(fileName)
+		for _, line := range lines {
+			if len(line) == 0 {
+				continue
+			}
+			app := notify.NotificationPayloadAppearance{}
+			if err := app.FromString(line); err != nil {
+				return reorged, invalid, fmt.Errorf("implementation error - unexpected record format: %s", err)
+			}
+			invalid = append(invalid, app)
+		}
+		if err := os.Remove(fileName); err != nil {
+			return reorged, invalid, err
+		}
+		reorged = append(reorged, bn)
+	}
+
+	sort.Slice(reorged, func(i, j int) bool {
+		return reorged[i] < reorged[j]
+	})
+	return reorged, invalid, nil
+}
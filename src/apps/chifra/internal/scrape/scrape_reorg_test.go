@@ -0,0 +1,109 @@
+package scrapePkg
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/notify"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/uniq"
+)
+
+func TestUnripeHashes(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	bm := BlazeManager{
+		chain: "mainnet",
+		unripeHashes: map[base.Blknum]base.Hash{
+			18509161: base.HexToHash("0x01"),
+			18509160: base.HexToHash("0x02"),
+		},
+	}
+	if err := bm.WriteUnripeHashes(); err != nil {
+		t.Fatal(err)
+	}
+
+	hashes, err := readUnripeHashes(bm.chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hashes) != 2 || hashes[18509161] != base.HexToHash("0x01") || hashes[18509160] != base.HexToHash("0x02") {
+		t.Fatal("wrong hashes", hashes)
+	}
+}
+
+func TestReorgNotification(t *testing.T) {
+	n := notify.NewReorgNotification(nil, []notify.NotificationPayloadAppearance{
+		{
+			Address:          "0xfffd8963efd1fc6a506488495d951d5263988d25",
+			BlockNumber:      "18509161",
+			TransactionIndex: 132,
+		},
+	})
+
+	encoded, err := json.Marshal(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"msg":"reorg","meta":null,"payload":[{"address":"0xfffd8963efd1fc6a506488495d951d5263988d25","blockNumber":"18509161","txid":132}]}`
+	if string(encoded) != expected {
+		t.Fatal("wrong notification", string(encoded))
+	}
+}
+
+func TestRollbackReorgs(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	bm := BlazeManager{
+		chain: "mainnet",
+		unripeHashes: map[base.Blknum]base.Hash{
+			18509160: base.HexToHash("0x01"),
+			18509161: base.HexToHash("0x02"),
+		},
+	}
+	if err := bm.WriteUnripeHashes(); err != nil {
+		t.Fatal(err)
+	}
+
+	unripePath := filepath.Join(config.PathToIndex(bm.chain), "unripe")
+	if err := os.MkdirAll(unripePath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	addr := "0xfffd8963efd1fc6a506488495d951d5263988d25"
+	for bn := range bm.unripeHashes {
+		line := fmt.Sprintf(uniq.AppearanceFmt, addr, bn, 132) + "\n"
+		if err := os.WriteFile(filepath.Join(unripePath, fmt.Sprintf("%09d.txt", bn)), []byte(line), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The node now has a different block at 18509161
+	hashOf := func(bn base.Blknum) (base.Hash, error) {
+		if bn == 18509161 {
+			return base.HexToHash("0x03"), nil
+		}
+		return bm.unripeHashes[bn], nil
+	}
+	reorged, invalid, err := rollbackReorgs(bm.chain, hashOf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(reorged) != 1 || reorged[0] != 18509161 {
+		t.Fatal("wrong re-orged blocks", reorged)
+	}
+	if len(invalid) != 1 || invalid[0].Address != addr || invalid[0].BlockNumber != "18509161" || invalid[0].TransactionIndex != 132 {
+		t.Fatal("wrong invalid appearances", invalid)
+	}
+	if file.FileExists(filepath.Join(unripePath, "018509161.txt")) {
+		t.Error("the re-orged block's appearances should have been removed")
+	}
+	if !file.FileExists(filepath.Join(unripePath, "018509160.txt")) {
+		t.Error("the unchanged block's appearances should remain")
+	}
+}
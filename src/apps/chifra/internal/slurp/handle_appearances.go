@@ -1,8 +1,6 @@
 package slurpPkg
 
 import (
-	"context"
-
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
 )
@@ -14,7 +12,7 @@ func (opts *SlurpOptions) HandleAppearances() error {
 	}
 	provider.SetPrintProgress(opts.Globals.ShowProgress())
 
-	ctx := context.Background()
+	ctx := output.ContextFor(opts.Globals.Writer)
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		appearancesChan := provider.Appearances(ctx, opts.Query(), errorChan)
 		for appearance := range appearancesChan {
@@ -1,8 +1,6 @@
 package slurpPkg
 
 import (
-	"context"
-
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
 )
@@ -14,7 +12,7 @@ func (opts *SlurpOptions) HandleCount() error {
 	}
 	provider.SetPrintProgress(opts.Globals.ShowProgressNotTesting())
 
-	ctx := context.Background()
+	ctx := output.ContextFor(opts.Globals.Writer)
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		monitorChan := provider.Count(ctx, opts.Query(), errorChan)
 		for monitor := range monitorChan {
@@ -1,8 +1,6 @@
 package slurpPkg
 
 import (
-	"context"
-
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/articulate"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
@@ -17,7 +15,7 @@ func (opts *SlurpOptions) HandleShow() error {
 	}
 	provider.SetPrintProgress(opts.Globals.ShowProgress())
 
-	ctx := context.Background()
+	ctx := output.ContextFor(opts.Globals.Writer)
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		txChan := provider.TransactionsByAddress(ctx, opts.Query(), errorChan)
 		for tx := range txChan {
@@ -29,7 +29,7 @@ func (opts *StateOptions) HandleCall() error {
 	}
 
 	callAddress := opts.GetCallAddress()
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		apps, _, err := identifiers.IdsToApps(chain, opts.BlockIds)
 		if err != nil {
@@ -59,28 +59,32 @@ func (opts *StateOptions) HandleCall() error {
 
 				iterFunc := func(app types.Appearance, value *[]types.Result) error {
 					bn := base.Blknum(app.BlockNumber)
+					contractCalls := make([]*call.ContractCall, 0, len(opts.Calls))
 					for _, c := range opts.Calls {
 						if contractCall, _, err := call.NewContractCall(opts.Conn, callAddress, c); err != nil {
 							delete(thisMap, app)
 							return fmt.Errorf("the --call value provided (%s) was not found: %s", c, err)
-
 						} else {
 							contractCall.BlockNumber = bn
-							results, err := contractCall.Call(artFunc)
-							if err != nil {
-								delete(thisMap, app)
-								return err
-							} else {
-								bar.Tick()
-								*value = append(*value, *results)
-							}
+							contractCalls = append(contractCalls, contractCall)
 						}
 					}
+
+					// All of the calls at this block are made together
+					results, err := call.CallMany(contractCalls, artFunc)
+					if err != nil {
+						delete(thisMap, app)
+						return err
+					}
+					for _, result := range results {
+						bar.Tick()
+						*value = append(*value, *result)
+					}
 					return nil
 				}
 
 				iterErrorChan := make(chan error)
-				iterCtx, iterCancel := context.WithCancel(context.Background())
+				iterCtx, iterCancel := context.WithCancel(ctx)
 				defer iterCancel()
 				go utils.IterateOverMap(iterCtx, iterErrorChan, thisMap, iterFunc)
 				for err := range iterErrorChan {
@@ -5,7 +5,6 @@
 package statePkg
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
@@ -23,7 +22,7 @@ func (opts *StateOptions) HandleDecache() error {
 		return err
 	}
 
-	ctx := context.Background()
+	ctx := output.ContextFor(opts.Globals.Writer)
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		showProgress := opts.Globals.ShowProgress()
 		monitorCacheTypes := []walk.CacheType{
@@ -41,7 +41,7 @@ func (opts *StateOptions) HandleShow() error {
 	stateFields, outputFields, none := types.SliceToStateParts(opts.Parts)
 
 	cnt := 0
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		for _, addressStr := range opts.Addrs {
 			address := base.HexToAddress(addressStr)
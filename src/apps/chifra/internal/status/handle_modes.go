@@ -22,7 +22,7 @@ func (opts *StatusOptions) HandleModes() error {
 	chain := opts.Globals.Chain
 	testMode := opts.Globals.TestMode
 
-	ctx := context.Background()
+	ctx := output.ContextFor(opts.Globals.Writer)
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		now := time.Now()
 
@@ -1,7 +1,6 @@
 package statusPkg
 
 import (
-	"context"
 	"fmt"
 	"io"
 	"strings"
@@ -25,7 +24,7 @@ func (opts *StatusOptions) HandleShow() error {
 
 	testMode := opts.Globals.TestMode
 
-	ctx := context.Background()
+	ctx := output.ContextFor(opts.Globals.Writer)
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		s, err := opts.GetStatus(opts.Diagnose)
 		if err != nil {
@@ -21,4 +21,9 @@
 //
 // You may optionally specify one or more blocks at which to report. If no block is specified, the
 // latest block is assumed. You may also optionally specify which parts of the token data to extract.
+//
+// Balances for all of the addresses at a given block are queried together. Where the chain has the
+// [Multicall3](https://github.com/mds1/multicall) contract deployed, these calls are aggregated into a
+// small number of eth_calls. Where it does not (or at blocks before it was deployed), each balance is
+// queried separately. chifra state --call does the same for multiple --call values.
 package tokensPkg
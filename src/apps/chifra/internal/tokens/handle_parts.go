@@ -17,7 +17,7 @@ func (opts *TokensOptions) HandleParts() error {
 	chain := opts.Globals.Chain
 	testMode := opts.Globals.TestMode
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		for _, address := range opts.Addrs {
 			addr := base.HexToAddress(address)
@@ -3,7 +3,6 @@ package tokensPkg
 import (
 	"context"
 	"errors"
-	"fmt"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/names"
@@ -13,48 +12,69 @@ import (
 	"github.com/ethereum/go-ethereum"
 )
 
+// blocksPerBatch is the number of blocks whose balances are queried before they are reported
+const blocksPerBatch = 100
+
 func (opts *TokensOptions) HandleShow() error {
 	chain := opts.Globals.Chain
 	testMode := opts.Globals.TestMode
 	tokenAddr := base.HexToAddress(opts.Addrs[0])
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
+		holders := make([]base.Address, 0, len(opts.Addrs)-1)
 		for _, address := range opts.Addrs[1:] {
-			addr := base.HexToAddress(address)
-			currentBn := base.Blknum(0)
-			currentTs := base.Timestamp(0)
-			for _, br := range opts.BlockIds {
-				blockNums, err := br.ResolveBlocks(chain)
-				if err != nil {
+			holders = append(holders, base.HexToAddress(address))
+		}
+
+		blockNums := make([]base.Blknum, 0)
+		for _, br := range opts.BlockIds {
+			bns, err := br.ResolveBlocks(chain)
+			if err != nil {
+				errorChan <- err
+				if errors.Is(err, ethereum.NotFound) {
+					continue
+				}
+				cancel()
+				return
+			}
+			blockNums = append(blockNums, bns...)
+		}
+
+		// The balances of every holder at a given block are queried together (through Multicall3 where
+		// it's available). Blocks are processed in batches, each of which is reported holder by holder.
+		for start := 0; start < len(blockNums); start += blocksPerBatch {
+			if ctx.Err() != nil {
+				return
+			}
+			batch := blockNums[start:min(start+blocksPerBatch, len(blockNums))]
+
+			balances := make([][]*base.Wei, len(batch))
+			timestamps := make([]base.Timestamp, len(batch))
+			for i, bn := range batch {
+				var err error
+				if balances[i], err = opts.Conn.GetBalancesAtToken(tokenAddr, holders, bn); err != nil {
 					errorChan <- err
-					if errors.Is(err, ethereum.NotFound) {
-						continue
-					}
-					cancel()
-					return
 				}
+				if opts.Globals.Verbose {
+					timestamps[i], _ = tslib.FromBnToTs(chain, bn)
+				}
+			}
 
-				for _, bn := range blockNums {
-					if balance, err := opts.Conn.GetBalanceAtToken(tokenAddr, addr, fmt.Sprintf("0x%x", bn)); balance == nil {
-						errorChan <- err
-					} else {
-						if opts.Globals.Verbose {
-							if bn == 0 || bn != currentBn {
-								currentTs, _ = tslib.FromBnToTs(chain, bn)
-							}
-							currentBn = bn
-						}
-						s := &types.Token{
-							Holder:      addr,
-							Address:     tokenAddr,
-							Balance:     *balance,
-							BlockNumber: bn,
-							Timestamp:   currentTs,
-							TokenType:   types.TokenErc20,
-						}
-						modelChan <- s
+			for h, holder := range holders {
+				for i, bn := range batch {
+					if balances[i] == nil {
+						continue
+					}
+					s := &types.Token{
+						Holder:      holder,
+						Address:     tokenAddr,
+						Balance:     *balances[i][h],
+						BlockNumber: bn,
+						Timestamp:   timestamps[i],
+						TokenType:   types.TokenErc20,
 					}
+					modelChan <- s
 				}
 			}
 		}
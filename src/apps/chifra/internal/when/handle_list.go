@@ -13,7 +13,7 @@ import (
 func (opts *WhenOptions) HandleList() error {
 	chain := opts.Globals.Chain
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(output.ContextFor(opts.Globals.Writer))
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		results, err := tslib.GetSpecials(chain)
 		if err != nil {
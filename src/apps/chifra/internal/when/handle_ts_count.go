@@ -5,8 +5,6 @@
 package whenPkg
 
 import (
-	"context"
-
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/tslib"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
@@ -16,7 +14,7 @@ func (opts *WhenOptions) HandleTimestampsCount() error {
 	chain := opts.Globals.Chain
 	testMode := opts.Globals.TestMode
 
-	ctx := context.Background()
+	ctx := output.ContextFor(opts.Globals.Writer)
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		if count, err := tslib.NTimestamps(chain); err != nil {
 			errorChan <- err
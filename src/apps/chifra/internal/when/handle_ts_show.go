@@ -5,8 +5,6 @@
 package whenPkg
 
 import (
-	"context"
-
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/identifiers"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/output"
@@ -28,7 +26,7 @@ func (opts *WhenOptions) HandleTimestampsShow() error {
 		return err
 	}
 
-	ctx := context.Background()
+	ctx := output.ContextFor(opts.Globals.Writer)
 	prev := base.Timestamp(0)
 	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
 		for bn := base.Blknum(0); bn < cnt; bn++ {
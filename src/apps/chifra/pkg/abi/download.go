@@ -1,19 +1,22 @@
 package abi
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
+	"path"
 	"strings"
+	"time"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
-	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/debug"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/utils"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/walk"
 )
 
 var perfTiming bool
@@ -24,10 +27,19 @@ func init() {
 
 var AbiNotFound = `[{"name":"AbiNotFound","type":"function"}]`
 
-// downloadAbi downloads the ABI for the given address and saves it to the cache.
-// TODO: This function should be easy to replace with "ABI providers" (different services like
-// Sourcify or custom ones configured by the user)
-func (abiMap *SelectorSyncMap) downloadAbi(chain string, address base.Address) error {
+// Provenance records where a cached ABI came from. It is stored next to the ABI in the cache.
+type Provenance struct {
+	Provider string   `json:"provider"`
+	Source   string   `json:"source,omitempty"`
+	Match    string   `json:"match,omitempty"`
+	Tried    []string `json:"tried,omitempty"`
+	Date     string   `json:"date"`
+}
+
+// downloadAbi tries each of the chain's ABI sources (see config.GetAbiSettings) in turn, caching the first
+// ABI found along with its provenance. If every source said the ABI was not found, an empty ABI is cached so
+// we don't keep asking. If any source could not answer, nothing is cached and the errors are returned.
+func (abiMap *SelectorSyncMap) downloadAbi(conn *rpc.Connection, address base.Address) error {
 	if address.IsZero() {
 		return errors.New("address is 0x0 in downloadAbi")
 	}
@@ -35,66 +47,92 @@ func (abiMap *SelectorSyncMap) downloadAbi(chain string, address base.Address) e
 	// C++ code used do check if the address is contract in 2 places: here and in handle_addresses. We
 	// check only in handle_addresses.
 
-	key := config.GetKey("etherscan").ApiKey
-	if key == "" {
-		return errors.New("cannot read Etherscan API key")
-	}
-	url := fmt.Sprintf(
-		"https://api.etherscan.io/api?module=contract&action=getabi&address=%s&apikey=%s",
-		address.Hex(),
-		key,
-	)
-
-	debug.DebugCurlStr(url)
-	resp, err := http.Get(url)
-	if err != nil {
-		return err
+	chain := conn.Chain
+	settings := config.GetAbiSettings(chain)
+	src := abiSource{
+		chainId:  config.GetChain(chain).ChainId,
+		apiKey:   config.GetKey(settings.EtherscanKey).ApiKey,
+		settings: settings,
+		getCode: func() []byte {
+			code, _ := conn.GetContractCodeAt(address, base.NOPOSN)
+			return code
+		},
 	}
-	defer resp.Body.Close()
 
-	// Check server response
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("etherscan API error: %s", resp.Status)
-	}
+	tried := make([]string, 0, len(settings.Sources))
+	errs := make([]error, 0, len(settings.Sources))
+	for _, name := range settings.Sources {
+		provider, ok := abiProviders[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("unknown ABI source %s", name))
+			continue
+		}
 
-	data := map[string]string{}
-	decoder := json.NewDecoder(resp.Body)
-	if err = decoder.Decode(&data); err != nil {
-		return err
-	}
-	resp.Body.Close()
-
-	if data["message"] == "NOTOK" {
-		// Etherscan sends 200 OK responses even if there's an error. We want to cache the error
-		// response so we don't keep asking Etherscan for the same address. The user may later
-		// remove empty ABIs with chifra abis --clean.
-		if !perfTiming && os.Getenv("TEST_MODE") != "true" && !utils.IsFuzzing() {
-			logger.Warn("provider responded with:", address.Hex(), data["message"], ss)
+		abiJson, provenance, err := provider(&src, address)
+		if errors.Is(err, errSourceNotConfigured) {
+			continue
+		} else if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+		tried = append(tried, name)
+		if abiJson == nil {
+			continue
 		}
 
-		reader := strings.NewReader(AbiNotFound)
-		_ = fromJson(reader, abiMap)
-		if _, err = reader.Seek(0, io.SeekStart); err != nil {
-			return err
+		if err = fromJson(bytes.NewReader(abiJson), abiMap); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
 		}
-		if err = insertAbi(chain, address, reader); err != nil {
+		if err = insertAbi(chain, address, bytes.NewReader(abiJson)); err != nil {
 			return err
 		}
-		return nil
+		provenance.Provider = name
+		return writeProvenance(chain, address, provenance)
 	}
 
-	reader := strings.NewReader(data["result"])
-	_ = fromJson(reader, abiMap)
-	if _, err = reader.Seek(0, io.SeekStart); err != nil {
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	} else if len(tried) == 0 {
+		return fmt.Errorf("no ABI source is configured for chain %s", chain)
+	}
+
+	// No source has the ABI. We cache an empty ABI so we don't keep asking for the same address. The
+	// user may later remove empty ABIs with chifra abis --clean.
+	if !perfTiming && os.Getenv("TEST_MODE") != "true" && !utils.IsFuzzing() {
+		logger.Warn("no ABI found for:", address.Hex(), "tried:", strings.Join(tried, ", "), ss)
+	}
+
+	_ = fromJson(strings.NewReader(AbiNotFound), abiMap)
+	if err := insertAbi(chain, address, strings.NewReader(AbiNotFound)); err != nil {
 		return err
 	}
+	return writeProvenance(chain, address, &Provenance{Provider: "none", Tried: tried})
+}
 
-	// Write the body to file
-	if err = insertAbi(chain, address, reader); err != nil {
+// provenancePath returns the path of the file recording where the address's cached ABI came from
+func provenancePath(chain string, address base.Address) string {
+	return path.Join(walk.GetRootPathFromCacheType(chain, walk.Cache_Abis), address.Hex()+".source")
+}
+
+func writeProvenance(chain string, address base.Address, provenance *Provenance) error {
+	provenance.Date = time.Now().UTC().Format(time.RFC3339)
+	bytes, err := json.MarshalIndent(provenance, "", "  ")
+	if err != nil {
 		return err
 	}
+	return os.WriteFile(provenancePath(chain, address), bytes, 0666)
+}
 
-	return nil
+// RemoveAbi removes the address's cached ABI and the file recording where it came from. It returns
+// false if no ABI was cached.
+func RemoveAbi(chain string, address base.Address) (bool, error) {
+	_ = os.Remove(provenancePath(chain, address))
+	abiPath := path.Join(walk.GetRootPathFromCacheType(chain, walk.Cache_Abis), address.Hex()+".json")
+	if !file.FileExists(abiPath) {
+		return false, nil
+	}
+	return true, os.Remove(abiPath)
 }
 
 var ss = strings.Repeat(" ", 40)
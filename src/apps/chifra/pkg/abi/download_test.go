@@ -0,0 +1,36 @@
+package abi
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/walk"
+)
+
+func TestRemoveAbi(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	chain := "mainnet"
+	address := base.HexToAddress("0x1f9090aae28b8a3dceadf281b0f12828e676c326")
+	if err := os.MkdirAll(walk.GetRootPathFromCacheType(chain, walk.Cache_Abis), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := insertAbi(chain, address, strings.NewReader(AbiNotFound)); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeProvenance(chain, address, &Provenance{Provider: "none"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if removed, err := RemoveAbi(chain, address); !removed || err != nil {
+		t.Fatal("expected the abi to be removed", removed, err)
+	}
+	if file.FileExists(provenancePath(chain, address)) {
+		t.Error("expected the abi's source file to be removed")
+	}
+	if removed, err := RemoveAbi(chain, address); removed || err != nil {
+		t.Error("expected nothing to remove", removed, err)
+	}
+}
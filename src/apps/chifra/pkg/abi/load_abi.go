@@ -41,7 +41,7 @@ func LoadAbi(conn *rpc.Connection, address base.Address, abiMap *SelectorSyncMap
 			return fmt.Errorf("while reading %s ABI file: %w", address, err)
 		}
 
-		return abiMap.downloadAbi(conn.Chain, address)
+		return abiMap.downloadAbi(conn, address)
 	}
 
 	return nil
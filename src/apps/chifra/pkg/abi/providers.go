@@ -0,0 +1,269 @@
+package abi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/debug"
+)
+
+// abiSource carries what the abiProviders need to know about the chain
+type abiSource struct {
+	chainId  string
+	apiKey   string
+	settings config.AbiSettings
+	getCode  func() []byte
+	code     []byte
+}
+
+// abiProvider returns the ABI for the address as JSON along with where it came from. If the provider
+// does not have the ABI, it returns nil for both. An error means the provider could not be asked.
+type abiProvider func(src *abiSource, address base.Address) ([]byte, *Provenance, error)
+
+// abiProviders are the sources that may be listed in a chain's [abis] sources
+var abiProviders = map[string]abiProvider{
+	"etherscan":  fromEtherscan,
+	"sourcify":   fromSourcify,
+	"blockscout": fromBlockscout,
+	"local":      fromLocal,
+}
+
+// errSourceNotConfigured is returned by providers that cannot be used on the chain. They are skipped.
+var errSourceNotConfigured = errors.New("the ABI source is not configured for this chain")
+
+var abiClient = &http.Client{
+	Timeout: 30 * time.Second,
+}
+
+// fromEtherscan queries the chain's Etherscan-family explorer (Etherscan, Gnosisscan, Polygonscan, ...)
+func fromEtherscan(src *abiSource, address base.Address) ([]byte, *Provenance, error) {
+	if len(src.settings.EtherscanUrl) == 0 {
+		return nil, nil, errSourceNotConfigured
+	}
+	if len(src.apiKey) == 0 {
+		return nil, nil, errSourceNotConfigured
+	}
+	endpoint := src.settings.EtherscanUrl + "?module=contract&action=getabi&address=" + address.Hex()
+	return fromExplorer(endpoint+"&apikey="+src.apiKey, endpoint)
+}
+
+// fromBlockscout queries the chain's Blockscout instance through its Etherscan compatible API
+func fromBlockscout(src *abiSource, address base.Address) ([]byte, *Provenance, error) {
+	if len(src.settings.BlockscoutUrl) == 0 {
+		return nil, nil, errSourceNotConfigured
+	}
+	endpoint := src.settings.BlockscoutUrl + "/api?module=contract&action=getabi&address=" + address.Hex()
+	return fromExplorer(endpoint, endpoint)
+}
+
+// fromExplorer reads an Etherscan style getabi response. These are sent with 200 OK even if there's an
+// error, so only a response saying the contract is not verified is taken to mean the ABI does not exist.
+// The source recorded in the provenance does not include the API key.
+func fromExplorer(url, source string) ([]byte, *Provenance, error) {
+	debug.DebugCurlStr(url)
+	resp, err := abiClient.Get(url)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("API error: %s", resp.Status)
+	}
+
+	data := struct {
+		Status  string          `json:"status"`
+		Message string          `json:"message"`
+		Result  json.RawMessage `json:"result"`
+	}{}
+	if err = json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, nil, err
+	}
+
+	var result string
+	if err = json.Unmarshal(data.Result, &result); err != nil && len(data.Result) > 0 && string(data.Result) != "null" {
+		return nil, nil, err
+	}
+
+	if data.Status != "1" {
+		if strings.Contains(strings.ToLower(result+data.Message), "not verified") {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("provider responded with: %s %s", data.Message, result)
+	}
+
+	return []byte(result), &Provenance{Source: source}, nil
+}
+
+// fromSourcify reads the contract's metadata from the Sourcify repository, preferring a full match
+// (where the metadata hash in the bytecode matches) to a partial one.
+func fromSourcify(src *abiSource, address base.Address) ([]byte, *Provenance, error) {
+	if len(src.chainId) == 0 || len(src.settings.SourcifyUrl) == 0 {
+		return nil, nil, errSourceNotConfigured
+	}
+
+	for _, match := range []string{"full", "partial"} {
+		url := fmt.Sprintf("%s/contracts/%s_match/%s/%s/metadata.json", src.settings.SourcifyUrl, match, src.chainId, address.Common().Hex())
+		debug.DebugCurlStr(url)
+		resp, err := abiClient.Get(url)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		metadata := struct {
+			Output struct {
+				Abi json.RawMessage `json:"abi"`
+			} `json:"output"`
+		}{}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			err = json.NewDecoder(resp.Body).Decode(&metadata)
+			resp.Body.Close()
+			if err != nil {
+				return nil, nil, err
+			}
+			if len(metadata.Output.Abi) == 0 {
+				return nil, nil, fmt.Errorf("the metadata at %s has no ABI", url)
+			}
+			return metadata.Output.Abi, &Provenance{Source: url, Match: match}, nil
+		case http.StatusNotFound:
+			resp.Body.Close()
+		default:
+			resp.Body.Close()
+			return nil, nil, fmt.Errorf("API error: %s", resp.Status)
+		}
+	}
+
+	return nil, nil, nil
+}
+
+// fromLocal searches the chain's local paths for a JSON file giving the address's ABI. These may be
+// plain ABI files or Foundry or Hardhat artifacts (anything with an `abi` field). A file is used if it is
+// named for the address, if it has an `address` field holding the address (as hardhat-deploy writes), or
+// if its deployed bytecode is the code at the address (ignoring the trailing metadata). The first two
+// are preferred to the last. Contracts with immutable values will not match on bytecode.
+func fromLocal(src *abiSource, address base.Address) ([]byte, *Provenance, error) {
+	if len(src.settings.LocalPaths) == 0 {
+		return nil, nil, errSourceNotConfigured
+	}
+
+	var byCode []byte
+	var byCodeProvenance *Provenance
+
+	for _, localPath := range src.settings.LocalPaths {
+		var found []byte
+		var provenance *Provenance
+		err := filepath.WalkDir(localPath, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				switch d.Name() {
+				case "build-info", "node_modules", "cache":
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !strings.HasSuffix(path, ".json") {
+				return nil
+			}
+
+			contents, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			abiJson, match := matchArtifact(contents, strings.TrimSuffix(d.Name(), ".json"), address, src)
+			switch match {
+			case "name", "address":
+				found, provenance = abiJson, &Provenance{Source: path, Match: match}
+				return filepath.SkipAll
+			case "bytecode":
+				if byCode == nil {
+					byCode, byCodeProvenance = abiJson, &Provenance{Source: path, Match: match}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		if found != nil {
+			return found, provenance, nil
+		}
+	}
+
+	return byCode, byCodeProvenance, nil
+}
+
+// matchArtifact returns the file's ABI and how it matched the address (or an empty string if it did not)
+func matchArtifact(contents []byte, name string, address base.Address, src *abiSource) ([]byte, string) {
+	contents = bytes.TrimSpace(contents)
+	isNamed := strings.EqualFold(name, address.Hex())
+	if len(contents) > 0 && contents[0] == '[' {
+		if isNamed {
+			return contents, "name"
+		}
+		return nil, ""
+	}
+
+	artifact := struct {
+		Abi              json.RawMessage `json:"abi"`
+		Address          string          `json:"address"`
+		DeployedBytecode json.RawMessage `json:"deployedBytecode"`
+	}{}
+	if err := json.Unmarshal(contents, &artifact); err != nil || len(artifact.Abi) == 0 {
+		return nil, ""
+	}
+
+	switch {
+	case isNamed:
+		return artifact.Abi, "name"
+	case len(artifact.Address) > 0 && base.HexToAddress(artifact.Address) == address:
+		return artifact.Abi, "address"
+	}
+
+	// Hardhat writes the bytecode as a string, Foundry as an object
+	var deployed string
+	if err := json.Unmarshal(artifact.DeployedBytecode, &deployed); err != nil {
+		object := struct {
+			Object string `json:"object"`
+		}{}
+		_ = json.Unmarshal(artifact.DeployedBytecode, &object)
+		deployed = object.Object
+	}
+	if len(deployed) <= 2 {
+		return nil, ""
+	}
+
+	if src.code == nil && src.getCode != nil {
+		src.code = src.getCode()
+		src.getCode = nil
+	}
+	if len(src.code) > 0 && bytes.Equal(stripMetadata(src.code), stripMetadata(base.Hex2Bytes(strings.TrimPrefix(deployed, "0x")))) {
+		return artifact.Abi, "bytecode"
+	}
+	return nil, ""
+}
+
+// stripMetadata removes the CBOR encoded metadata the Solidity compiler appends to deployed bytecode.
+// Its length is given by the last two bytes.
+func stripMetadata(code []byte) []byte {
+	if len(code) < 2 {
+		return code
+	}
+	n := int(code[len(code)-2])<<8 | int(code[len(code)-1])
+	if n+2 > len(code) {
+		return code
+	}
+	return code[:len(code)-n-2]
+}
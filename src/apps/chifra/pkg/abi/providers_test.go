@@ -0,0 +1,124 @@
+package abi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+)
+
+const testAbi = `[{"name":"isBar","type":"function","inputs":[],"outputs":[{"name":"","type":"bool"}]}]`
+
+var testAddress = base.HexToAddress("0x6b175474e89094c44da98b954eedeac495271d0f")
+
+func TestFromExplorer(t *testing.T) {
+	responses := map[string]string{
+		"found":   `{"status":"1","message":"OK","result":` + jsonString(testAbi) + `}`,
+		"missing": `{"status":"0","message":"NOTOK","result":"Contract source code not verified"}`,
+		"nullish": `{"status":"0","message":"Contract source code not verified","result":null}`,
+		"limited": `{"status":"0","message":"NOTOK","result":"Max rate limit reached"}`,
+		"garbled": `not json`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(responses[r.URL.Query().Get("address")]))
+	}))
+	defer server.Close()
+
+	src := &abiSource{chainId: "1", apiKey: "key", settings: config.AbiSettings{EtherscanUrl: server.URL}}
+	for name := range responses {
+		abiJson, provenance, err := fromExplorer(server.URL+"?address="+name, server.URL)
+		switch name {
+		case "found":
+			if err != nil || string(abiJson) != testAbi || provenance.Source != server.URL {
+				t.Error("expected the ABI", err, string(abiJson))
+			}
+		case "missing", "nullish":
+			if err != nil || abiJson != nil {
+				t.Error("expected not found", name, err)
+			}
+		default:
+			if err == nil {
+				t.Error("expected an error", name)
+			}
+		}
+	}
+
+	src.apiKey = ""
+	if _, _, err := fromEtherscan(src, testAddress); err != errSourceNotConfigured {
+		t.Error("expected Etherscan to be skipped without a key", err)
+	}
+	if _, _, err := fromBlockscout(src, testAddress); err != errSourceNotConfigured {
+		t.Error("expected Blockscout to be skipped without a url", err)
+	}
+}
+
+func TestFromSourcify(t *testing.T) {
+	checksummed := testAddress.Common().Hex()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/contracts/partial_match/100/"+checksummed+"/metadata.json" {
+			_, _ = w.Write([]byte(`{"output":{"abi":` + testAbi + `}}`))
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	src := &abiSource{chainId: "100", settings: config.AbiSettings{SourcifyUrl: server.URL}}
+	abiJson, provenance, err := fromSourcify(src, testAddress)
+	if err != nil || string(abiJson) != testAbi {
+		t.Fatal("expected the ABI", err, string(abiJson))
+	}
+	if provenance.Match != "partial" || !strings.Contains(provenance.Source, "partial_match") {
+		t.Error("wrong provenance", provenance)
+	}
+
+	src.chainId = "1"
+	if abiJson, _, err := fromSourcify(src, testAddress); err != nil || abiJson != nil {
+		t.Error("expected not found", err)
+	}
+}
+
+func TestFromLocal(t *testing.T) {
+	code := "6080604052600080fd"
+	dir := t.TempDir()
+	write := func(name, contents string) {
+		path := filepath.Join(dir, name)
+		_ = os.MkdirAll(filepath.Dir(path), 0755)
+		if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A Foundry artifact whose bytecode (less its metadata) matches the code
+	write("out/Bar.sol/Bar.json", `{"abi":`+testAbi+`,"deployedBytecode":{"object":"0x`+code+`a1650002"}}`)
+	// A Hardhat artifact that does not match
+	write("artifacts/Baz.json", `{"abi":[],"deployedBytecode":"0x6080"}`)
+
+	src := &abiSource{
+		settings: config.AbiSettings{LocalPaths: []string{dir}},
+		getCode:  func() []byte { return base.Hex2Bytes(code + "a2640002") },
+	}
+	abiJson, provenance, err := fromLocal(src, testAddress)
+	if err != nil || string(abiJson) != testAbi || provenance.Match != "bytecode" {
+		t.Fatal("expected a bytecode match", err, provenance)
+	}
+
+	// hardhat-deploy records the address, which is preferred to bytecode
+	write("deployments/mainnet/Dai.json", `{"address":"`+testAddress.Common().Hex()+`","abi":`+testAbi+`}`)
+	if _, provenance, _ = fromLocal(src, testAddress); provenance == nil || provenance.Match != "address" {
+		t.Error("expected an address match", provenance)
+	}
+
+	src.settings.LocalPaths = nil
+	if _, _, err := fromLocal(src, testAddress); err != errSourceNotConfigured {
+		t.Error("expected local to be skipped without paths", err)
+	}
+}
+
+func jsonString(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
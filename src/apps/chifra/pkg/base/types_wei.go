@@ -129,6 +129,24 @@ func (w *Wei) ToEtherStr(decimals int) string {
 	return ToEther(w).Text('f', -1*decimals)
 }
 
+// ToUnitsStr returns the exact decimal value of w in whole units of an asset with the given number
+// of decimals (for example, ether for decimals of 18) with trailing zeros removed
+func (w *Wei) ToUnitsStr(decimals int) string {
+	i := (*big.Int)(w)
+	s := new(big.Int).Abs(i).Text(10)
+	if decimals > 0 {
+		if len(s) <= decimals {
+			s = strings.Repeat("0", decimals-len(s)+1) + s
+		}
+		s = s[:len(s)-decimals] + "." + s[len(s)-decimals:]
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if i.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
 func ToEther(wei *Wei) *Ether {
 	f := NewEther(0)
 	e := NewEther(1e18)
@@ -115,3 +115,24 @@ func TestToEther(t *testing.T) {
 		}
 	}
 }
+
+func TestToUnitsStr(t *testing.T) {
+	tests := []struct {
+		input    *Wei
+		decimals int
+		want     string
+	}{
+		{NewWei(1500000), 6, "1.5"},
+		{NewWei(-1500000), 6, "-1.5"},
+		{NewWei(15), 6, "0.000015"},
+		{NewWei(0), 18, "0"},
+		{NewWei(3000), 0, "3000"},
+		{NewWei(1000000000000000000), 18, "1"},
+	}
+
+	for _, test := range tests {
+		if got := test.input.ToUnitsStr(test.decimals); got != test.want {
+			t.Errorf("ToUnitsStr(%s, %d) = %s, want %s", test.input.String(), test.decimals, got, test.want)
+		}
+	}
+}
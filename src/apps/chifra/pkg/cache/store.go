@@ -9,6 +9,7 @@ import (
 	"sync"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/cache/locations"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/metrics"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/sigintTrap"
 	"github.com/ethereum/go-ethereum/log"
 )
@@ -87,6 +88,10 @@ func (s *Store) Write(value Locator, options *WriteOptions) (err error) {
 		return
 	}
 
+	defer func() {
+		recordWrite(value, err)
+	}()
+
 	itemPath, err := s.resolvePath(value)
 	if err != nil {
 		printErr("write resolving path", err)
@@ -125,6 +130,10 @@ type ReadOptions interface{}
 // then FileSystem is used. The value has to implement Locator interface, which
 // provides information about in-cache path
 func (s *Store) Read(value Locator, options *ReadOptions) (err error) {
+	defer func() {
+		recordRead(value, err)
+	}()
+
 	itemPath, err := s.resolvePath(value)
 	if err != nil {
 		printErr("read resolving path", err)
@@ -203,6 +212,25 @@ func (s *Store) ReadOnly() bool {
 	return s.readOnly
 }
 
+func recordRead(value Locator, err error) {
+	switch {
+	case err == nil:
+		metrics.CacheRead(value.CacheName(), metrics.CacheHit)
+	case errors.Is(err, locations.ErrNotFound):
+		metrics.CacheRead(value.CacheName(), metrics.CacheMiss)
+	default:
+		metrics.CacheRead(value.CacheName(), metrics.CacheError)
+	}
+}
+
+func recordWrite(value Locator, err error) {
+	if err == nil {
+		metrics.CacheWrite(value.CacheName(), metrics.CacheOk)
+	} else {
+		metrics.CacheWrite(value.CacheName(), metrics.CacheError)
+	}
+}
+
 func printErr(desc string, err error) {
 	if !verboseMode {
 		return
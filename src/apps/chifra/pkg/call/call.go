@@ -9,7 +9,6 @@ import (
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/parser"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
-	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc/query"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/walk"
 )
@@ -187,65 +186,107 @@ func (call *ContractCall) forceEncoding(encoding string) {
 }
 
 func (call *ContractCall) Call(artFunc func(string, *types.Function) error) (results *types.Result, err error) {
-	blockTs := base.Timestamp(0)
-	if call.Conn.StoreReadable() {
-		// walk.Cache_Results
-		results = &types.Result{
-			BlockNumber: call.BlockNumber,
-			Address:     call.Address,
-			Encoding:    call.Method.Encoding,
-		}
-		if err := call.Conn.Store.Read(results, nil); err == nil {
-			return results, nil
-		}
-		blockTs = call.Conn.GetBlockTimestamp(call.BlockNumber)
+	if all, err := CallMany([]*ContractCall{call}, artFunc); err != nil {
+		return nil, err
+	} else {
+		return all[0], nil
 	}
+}
 
+// CallMany makes each of the calls (which must share a connection) and returns their results in the
+// same order. Results are read from the cache where possible. The remaining calls at each block are
+// made together, aggregated through Multicall3 where it is deployed (see rpc.CallMany).
+func CallMany(calls []*ContractCall, artFunc func(string, *types.Function) error) ([]*types.Result, error) {
 	if artFunc == nil {
 		logger.Fatal("should not happen ==> implementation error: artFunc is nil")
 	}
 
-	var packed []byte
-	blockNumberHex := fmt.Sprintf("0x%x", call.BlockNumber)
-	if call.encoded != "" {
-		packed = base.Hex2Bytes(call.encoded[2:])
-	} else {
-		packed, err = call.Method.Pack(call.Arguments)
+	results := make([]*types.Result, len(calls))
+	if len(calls) == 0 {
+		return results, nil
+	}
+	conn := calls[0].Conn
+
+	timestamps := make([]base.Timestamp, len(calls))
+	blocks := make([]base.Blknum, 0)
+	pending := make(map[base.Blknum][]int)
+	for i, call := range calls {
+		if conn.StoreReadable() {
+			// walk.Cache_Results
+			cached := &types.Result{
+				BlockNumber: call.BlockNumber,
+				Address:     call.Address,
+				Encoding:    call.Method.Encoding,
+			}
+			if err := conn.Store.Read(cached, nil); err == nil {
+				results[i] = cached
+				continue
+			}
+			timestamps[i] = conn.GetBlockTimestamp(call.BlockNumber)
+		}
+		if _, ok := pending[call.BlockNumber]; !ok {
+			blocks = append(blocks, call.BlockNumber)
+		}
+		pending[call.BlockNumber] = append(pending[call.BlockNumber], i)
+	}
+
+	for _, bn := range blocks {
+		requests := make([]rpc.CallRequest, 0, len(pending[bn]))
+		for _, i := range pending[bn] {
+			packedHex, err := calls[i].pack()
+			if err != nil {
+				return nil, err
+			}
+			requests = append(requests, rpc.CallRequest{
+				To:   calls[i].Address,
+				Data: packedHex,
+			})
+		}
+
+		responses, err := conn.CallMany(requests, bn)
 		if err != nil {
 			return nil, err
 		}
-	}
 
-	packedHex := "0x" + base.Bytes2Hex(packed)
-	encodedArguments := ""
-	if len(packedHex) > 10 {
-		encodedArguments = packedHex[10:]
+		for j, i := range pending[bn] {
+			if responses[j].Err != nil {
+				return nil, responses[j].Err
+			}
+			if results[i], err = calls[i].toResult(requests[j].Data, responses[j].Data, timestamps[i], artFunc); err != nil {
+				return nil, err
+			}
+		}
 	}
 
-	method := "eth_call"
-	params := query.Params{
-		map[string]any{
-			"to":   call.Address.Hex(),
-			"data": packedHex,
-		},
-		blockNumberHex,
-	}
+	return results, nil
+}
 
-	theBytes, err := query.Query[string](call.Conn.Chain, method, params)
+// pack returns the hex encoded calldata for the call
+func (call *ContractCall) pack() (string, error) {
+	if call.encoded != "" {
+		return "0x" + base.Bytes2Hex(base.Hex2Bytes(call.encoded[2:])), nil
+	}
+	packed, err := call.Method.Pack(call.Arguments)
 	if err != nil {
-		return nil, err
+		return "", err
+	}
+	return "0x" + base.Bytes2Hex(packed), nil
+}
+
+// toResult articulates the bytes returned by the call and caches the result if it is final
+func (call *ContractCall) toResult(packedHex, theBytes string, blockTs base.Timestamp, artFunc func(string, *types.Function) error) (*types.Result, error) {
+	encodedArguments := ""
+	if len(packedHex) > 10 {
+		encodedArguments = packedHex[10:]
 	}
 
 	function := call.Method.Clone()
 	// articulate it if possible
-	if theBytes != nil {
-		str := *theBytes
-		if err = artFunc(str, function); err != nil {
-			return nil, err
-		}
+	if err := artFunc(theBytes, function); err != nil {
+		return nil, err
 	}
 
-	results = &types.Result{
+	results := &types.Result{
 		BlockNumber:      call.BlockNumber,
 		Timestamp:        blockTs,
 		Address:          call.Address,
@@ -253,7 +294,7 @@ func (call *ContractCall) Call(artFunc func(string, *types.Function) error) (res
 		Encoding:         call.Method.Encoding,
 		Signature:        call.Method.Signature,
 		EncodedArguments: encodedArguments,
-		ReturnedBytes:    *theBytes,
+		ReturnedBytes:    theBytes,
 		ArticulatedOut:   function,
 	}
 	results.Values = make(map[string]string)
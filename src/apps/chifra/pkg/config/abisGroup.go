@@ -0,0 +1,90 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package config
+
+import (
+	"strings"
+)
+
+// AbiSettings carries config information for the sources from which ABIs are downloaded
+type AbiSettings struct {
+	Sources       []string `toml:"sources,omitempty" json:"sources,omitempty"`
+	EtherscanUrl  string   `toml:"etherscanUrl,omitempty" json:"etherscanUrl,omitempty"`
+	EtherscanKey  string   `toml:"etherscanKey,omitempty" json:"etherscanKey,omitempty"`
+	SourcifyUrl   string   `toml:"sourcifyUrl,omitempty" json:"sourcifyUrl,omitempty"`
+	BlockscoutUrl string   `toml:"blockscoutUrl,omitempty" json:"blockscoutUrl,omitempty"`
+	LocalPaths    []string `toml:"localPaths,omitempty" json:"localPaths,omitempty"`
+}
+
+// DefaultAbiSources is the order in which ABI sources are tried if a chain does not say otherwise
+var DefaultAbiSources = []string{"local", "etherscan", "sourcify", "blockscout"}
+
+// DefaultSourcifyUrl is the Sourcify repository, which serves every chain Sourcify supports
+const DefaultSourcifyUrl = "https://repo.sourcify.dev"
+
+// knownEtherscanUrls holds the API endpoints of the Etherscan family of explorers by chain id
+var knownEtherscanUrls = map[string]string{
+	"1":        "https://api.etherscan.io/api",
+	"10":       "https://api-optimistic.etherscan.io/api",
+	"100":      "https://api.gnosisscan.io/api",
+	"137":      "https://api.polygonscan.com/api",
+	"8453":     "https://api.basescan.org/api",
+	"17000":    "https://api-holesky.etherscan.io/api",
+	"42161":    "https://api.arbiscan.io/api",
+	"11155111": "https://api-sepolia.etherscan.io/api",
+}
+
+// knownBlockscoutUrls holds the Blockscout instances by chain id
+var knownBlockscoutUrls = map[string]string{
+	"1":        "https://eth.blockscout.com",
+	"10":       "https://optimism.blockscout.com",
+	"100":      "https://gnosis.blockscout.com",
+	"137":      "https://polygon.blockscout.com",
+	"8453":     "https://base.blockscout.com",
+	"17000":    "https://eth-holesky.blockscout.com",
+	"42161":    "https://arbitrum.blockscout.com",
+	"11155111": "https://eth-sepolia.blockscout.com",
+}
+
+// GetAbiSettings returns the ABI settings for the chain with defaults filled in. The Etherscan and
+// Blockscout endpoints default to the well-known instances for the chain's id (if there is one). The
+// Etherscan API key defaults to the `etherscan` key.
+func GetAbiSettings(chain string) AbiSettings {
+	ch := GetChain(chain)
+	ret := ch.Abis
+	sources := ret.Sources
+	if len(sources) == 0 {
+		sources = DefaultAbiSources
+	}
+	ret.Sources = make([]string, 0, len(sources))
+	for _, source := range sources {
+		ret.Sources = append(ret.Sources, strings.ToLower(strings.TrimSpace(source)))
+	}
+	if len(ret.EtherscanUrl) == 0 {
+		ret.EtherscanUrl = knownEtherscanUrls[ch.ChainId]
+	}
+	if len(ret.EtherscanKey) == 0 {
+		ret.EtherscanKey = "etherscan"
+	}
+	if len(ret.SourcifyUrl) == 0 {
+		ret.SourcifyUrl = DefaultSourcifyUrl
+	}
+	if len(ret.BlockscoutUrl) == 0 {
+		ret.BlockscoutUrl = knownBlockscoutUrls[ch.ChainId]
+	}
+	ret.EtherscanUrl = strings.TrimSuffix(ret.EtherscanUrl, "/")
+	ret.SourcifyUrl = strings.TrimSuffix(ret.SourcifyUrl, "/")
+	ret.BlockscoutUrl = strings.TrimSuffix(ret.BlockscoutUrl, "/")
+	return ret
+}
+
+func (s *AbiSettings) isEmpty() bool {
+	return len(s.Sources) == 0 &&
+		len(s.EtherscanUrl) == 0 &&
+		len(s.EtherscanKey) == 0 &&
+		len(s.SourcifyUrl) == 0 &&
+		len(s.BlockscoutUrl) == 0 &&
+		len(s.LocalPaths) == 0
+}
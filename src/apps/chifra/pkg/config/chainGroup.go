@@ -5,15 +5,19 @@
 package config
 
 type chainGroup struct {
-	Chain          string         `toml:"chain,omitempty"`
-	ChainId        string         `toml:"chainId"`
-	IpfsGateway    string         `toml:"ipfsGateway,omitempty"`
-	KeyEndpoint    string         `toml:"keyEndpoint,omitempty"`
-	LocalExplorer  string         `toml:"localExplorer,omitempty"`
-	RemoteExplorer string         `toml:"remoteExplorer,omitempty"`
-	RpcProvider    string         `toml:"rpcProvider"`
-	Symbol         string         `toml:"symbol"`
-	Scrape         ScrapeSettings `toml:"scrape"`
+	Chain          string          `toml:"chain,omitempty"`
+	ChainId        string          `toml:"chainId"`
+	IpfsGateway    string          `toml:"ipfsGateway,omitempty"`
+	KeyEndpoint    string          `toml:"keyEndpoint,omitempty"`
+	LocalExplorer  string          `toml:"localExplorer,omitempty"`
+	RemoteExplorer string          `toml:"remoteExplorer,omitempty"`
+	RpcProvider    string          `toml:"rpcProvider"`
+	RpcProviders   []string        `toml:"rpcProviders,omitempty"`
+	Rpc            rpcGroup        `toml:"rpc,omitempty"`
+	Pricing        PricingSettings `toml:"pricing,omitempty"`
+	Abis           AbiSettings     `toml:"abis,omitempty"`
+	Symbol         string          `toml:"symbol"`
+	Scrape         ScrapeSettings  `toml:"scrape"`
 }
 
 // GetChain returns the chain for a given chain
@@ -33,5 +37,41 @@ func GetChains() []chainGroup {
 
 // IsChainConfigured returns true if the chain is configured in the config file.
 func IsChainConfigured(needle string) bool {
-	return GetRootConfig().Chains != nil && GetRootConfig().Chains[needle] != chainGroup{}
+	if GetRootConfig().Chains == nil {
+		return false
+	}
+	ch, ok := GetRootConfig().Chains[needle]
+	return ok && !ch.isEmpty()
+}
+
+// GetRpcProviders returns every RPC endpoint configured for the chain. The primary
+// rpcProvider is always first, followed by any additional rpcProviders (duplicates removed).
+func GetRpcProviders(chain string) []string {
+	ch := GetChain(chain)
+	ret := make([]string, 0, len(ch.RpcProviders)+1)
+	seen := make(map[string]bool, len(ch.RpcProviders)+1)
+	for _, provider := range append([]string{ch.RpcProvider}, ch.RpcProviders...) {
+		if len(provider) == 0 || seen[provider] {
+			continue
+		}
+		seen[provider] = true
+		ret = append(ret, provider)
+	}
+	return ret
+}
+
+func (c *chainGroup) isEmpty() bool {
+	return len(c.Chain) == 0 &&
+		len(c.ChainId) == 0 &&
+		len(c.IpfsGateway) == 0 &&
+		len(c.KeyEndpoint) == 0 &&
+		len(c.LocalExplorer) == 0 &&
+		len(c.RemoteExplorer) == 0 &&
+		len(c.RpcProvider) == 0 &&
+		len(c.RpcProviders) == 0 &&
+		len(c.Symbol) == 0 &&
+		c.Rpc == rpcGroup{} &&
+		c.Pricing.isEmpty() &&
+		c.Abis.isEmpty() &&
+		c.Scrape == ScrapeSettings{}
 }
@@ -62,6 +62,10 @@ func init() {
 	trueBlocksViper.SetDefault("Unchained.PreferredPublisher", "publisher.unchainedindex.eth")
 	// V2: The address of the current version of the Unchained Index
 	trueBlocksViper.SetDefault("Unchained.SmartContract", "0x0c316b7042b419d07d343f2f4f5bd54ff731183d")
+	// Declare defaults for the publisher's key so that it may be read from env variables
+	trueBlocksViper.SetDefault("Unchained.Keystore", "")
+	trueBlocksViper.SetDefault("Unchained.Password", "")
+	trueBlocksViper.SetDefault("Unchained.PrivateKey", "")
 }
 
 var configMutex sync.Mutex
@@ -139,10 +143,19 @@ func GetRootConfig() *ConfigFile {
 		ch.IpfsGateway = strings.Replace(ch.IpfsGateway, "[{CHAIN}]", "ipfs", -1)
 		ch.LocalExplorer = clean(ch.LocalExplorer)
 		ch.RemoteExplorer = clean(ch.RemoteExplorer)
+		if len(ch.RpcProvider) == 0 && len(ch.RpcProviders) > 0 {
+			ch.RpcProvider = ch.RpcProviders[0]
+		}
 		ch.RpcProvider = strings.Trim(clean(ch.RpcProvider), "/") // Infura, for example, doesn't like the trailing slash
 		if err := validateRpcEndpoint(ch.Chain, ch.RpcProvider); err != nil {
 			logger.Fatal(err)
 		}
+		for i, provider := range ch.RpcProviders {
+			ch.RpcProviders[i] = strings.Trim(clean(provider), "/")
+			if !utils.IsPermitted() && ch.RpcProviders[i] == "https:" {
+				logger.Fatal(usage.Usage(rpcWarning, chain, provider, `Empty entry in rpcProviders.`))
+			}
+		}
 		ch.IpfsGateway = clean(ch.IpfsGateway)
 		if ch.Scrape.AppsPerChunk == 0 {
 			settings := ScrapeSettings{
@@ -0,0 +1,30 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package config
+
+type daemonGroup struct {
+	// Keys, if present, are required of every request to the API server (see DaemonKey)
+	Keys []DaemonKey `toml:"keys,omitempty" json:"keys,omitempty"`
+	// CertFile and KeyFile, if both present, cause the API server to serve HTTPS
+	CertFile string `toml:"certFile,omitempty" json:"certFile,omitempty"`
+	KeyFile  string `toml:"keyFile,omitempty" json:"keyFile,omitempty"`
+	// AuditLog, if present, is a file to which each request to the API server is appended as a line of JSON
+	AuditLog string `toml:"auditLog,omitempty" json:"auditLog,omitempty"`
+}
+
+// DaemonKey is an API key (or bearer token) accepted by the API server
+type DaemonKey struct {
+	// Name identifies the key in the audit log. The key itself is never logged.
+	Name string `toml:"name" json:"name"`
+	// Key is the key or, if it starts with sha256:, the hex SHA-256 hash of the key
+	Key string `toml:"key" json:"key"`
+	// Scope is either read (routes and options that do not change the node's state) or admin (everything)
+	Scope string `toml:"scope" json:"scope"`
+}
+
+// GetDaemon returns the configuration of the API server
+func GetDaemon() daemonGroup {
+	return GetSettings().Daemon
+}
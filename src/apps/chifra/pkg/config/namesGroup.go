@@ -0,0 +1,20 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package config
+
+type namesGroup struct {
+	// Keystore (decrypted with Password) or, if there is no keystore, PrivateKey signs the bundles made by
+	// chifra names --export. It is kept apart from the key that publishes the Unchained Index.
+	Keystore   string `toml:"keystore,omitempty" json:"keystore,omitempty"`
+	Password   string `toml:"password,omitempty" json:"password,omitempty"`
+	PrivateKey string `toml:"privateKey,omitempty" json:"privateKey,omitempty"`
+	// TrustedSigners are the addresses whose bundles chifra names --import accepts
+	TrustedSigners []string `toml:"trustedSigners,omitempty" json:"trustedSigners,omitempty"`
+}
+
+// GetNames returns the configuration of the names exchanged with others
+func GetNames() namesGroup {
+	return GetSettings().Names
+}
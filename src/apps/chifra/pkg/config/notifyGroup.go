@@ -4,7 +4,65 @@
 
 package config
 
+import "strings"
+
 type notifyGroup struct {
-	Url    string `toml:"url" json:"url,omitempty"`
-	Author string `toml:"author" json:"author,omitempty"`
+	Url    string       `toml:"url" json:"url,omitempty"`
+	Author string       `toml:"author" json:"author,omitempty"`
+	Sinks  []NotifySink `toml:"sinks,omitempty" json:"sinks,omitempty"`
+	// SpoolMax is the most notifications kept for sinks that have not accepted them (see GetNotifySpoolMax)
+	SpoolMax uint64 `toml:"spoolMax,omitempty" json:"spoolMax,omitempty"`
+}
+
+// defaultSpoolMax is the number of notifications kept in the spool if the config does not say otherwise
+const defaultSpoolMax = 100000
+
+// GetNotifySpoolMax returns the most notifications kept in the spool. Once there are more, the oldest
+// are dropped even if a sink has not accepted them.
+func GetNotifySpoolMax() uint64 {
+	if spoolMax := GetSettings().Notify.SpoolMax; spoolMax > 0 {
+		return spoolMax
+	}
+	return defaultSpoolMax
+}
+
+// NotifySink is one of the places to which the scraper's notifications are delivered
+type NotifySink struct {
+	// Name identifies the sink. Each sink keeps its own cursor, so changing the name re-delivers
+	// any notifications still held in the spool.
+	Name string `toml:"name" json:"name"`
+	// Type is one of webhook, file, unix, nats, or redis
+	Type string `toml:"type" json:"type"`
+	// Url is the endpoint for webhook, nats (nats://host:port) and redis (redis://[:password@]host:port) sinks
+	Url string `toml:"url,omitempty" json:"url,omitempty"`
+	// Path is the file for file sinks (one JSON notification per line) or the socket for unix sinks
+	Path string `toml:"path,omitempty" json:"path,omitempty"`
+	// Topic is the nats subject or the redis stream (defaults to trueblocks.<chain>)
+	Topic string `toml:"topic,omitempty" json:"topic,omitempty"`
+	// Secret, if present, is used to sign webhooks (see the X-TrueBlocks-Signature header)
+	Secret string `toml:"secret,omitempty" json:"secret,omitempty"`
+	// Retries is the number of times delivery is retried before leaving the notification for later
+	Retries int `toml:"retries,omitempty" json:"retries,omitempty"`
+	// Messages, if present, limits the sink to the given notification types (e.g. appearance)
+	Messages []string `toml:"messages,omitempty" json:"messages,omitempty"`
+	// Addresses, if present, limits the appearances delivered to those of the given addresses
+	Addresses []string `toml:"addresses,omitempty" json:"addresses,omitempty"`
+	// Monitored, if true, limits the appearances delivered to those of addresses with a monitor
+	Monitored bool `toml:"monitored,omitempty" json:"monitored,omitempty"`
+}
+
+// GetNotifySinks returns the configured notification sinks. A [settings.notify] url, if present, is
+// treated as an unfiltered webhook named default.
+func GetNotifySinks() []NotifySink {
+	notify := GetSettings().Notify
+	sinks := make([]NotifySink, 0, len(notify.Sinks)+1)
+	if len(notify.Url) > 0 {
+		url := notify.Url
+		// If protocol is not specified, use http by default
+		if !strings.HasPrefix(url, "http") {
+			url = "http://" + url
+		}
+		sinks = append(sinks, NotifySink{Name: "default", Type: "webhook", Url: url})
+	}
+	return append(sinks, notify.Sinks...)
 }
@@ -0,0 +1,89 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package config
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// PricingSettings carries config information for the pricing of assets in statements
+type PricingSettings struct {
+	Sources          []string          `toml:"sources,omitempty" json:"sources,omitempty"`
+	PriceFile        string            `toml:"priceFile,omitempty" json:"priceFile,omitempty"`
+	UniswapV3Factory string            `toml:"uniswapV3Factory,omitempty" json:"uniswapV3Factory,omitempty"`
+	UsdToken         string            `toml:"usdToken,omitempty" json:"usdToken,omitempty"`
+	WethToken        string            `toml:"wethToken,omitempty" json:"wethToken,omitempty"`
+	TwapSeconds      uint64            `toml:"twapSeconds,omitempty" json:"twapSeconds,omitempty"`
+	ChainlinkFeeds   map[string]string `toml:"chainlinkFeeds,omitempty" json:"chainlinkFeeds,omitempty"`
+}
+
+// DefaultPriceSources is the order in which price sources are tried if a chain does not say otherwise
+var DefaultPriceSources = []string{"uniswap", "uniswapv3", "chainlink", "file"}
+
+// mainnetPricing holds the well-known contract addresses used to price assets on mainnet
+var mainnetPricing = PricingSettings{
+	UniswapV3Factory: "0x1f98431c8ad98523631ae4a59f267346ea31f984",
+	UsdToken:         "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", // USDC
+	WethToken:        "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2",
+	ChainlinkFeeds: map[string]string{
+		"0xeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee": "0x5f4ec3df9cbd43714fe2740f5e3616155c5b8419", // ETH / USD
+		"0x2260fac5e5542a773aa44fbcfedf7c193bc2c599": "0xf4030086522a5beea4988f8ca5b36dbc97bee88c", // WBTC (BTC / USD)
+		"0x514910771af9ca656af840dff83e8264ecf986ca": "0x2c1d072e956affc0d435cb7ac38ef18d24d9127c", // LINK / USD
+	},
+}
+
+// GetPricingSettings returns the pricing settings for the chain with defaults filled in. On
+// mainnet, the well-known contracts are used unless the config overrides them. The price file
+// defaults to prices.csv in the chain's config folder.
+func GetPricingSettings(chain string) PricingSettings {
+	ret := GetChain(chain).Pricing
+	sources := ret.Sources
+	if len(sources) == 0 {
+		sources = DefaultPriceSources
+	}
+	ret.Sources = make([]string, 0, len(sources))
+	for _, source := range sources {
+		ret.Sources = append(ret.Sources, strings.ToLower(strings.TrimSpace(source)))
+	}
+	if ret.TwapSeconds == 0 {
+		ret.TwapSeconds = 1800
+	}
+	if len(ret.PriceFile) == 0 {
+		ret.PriceFile = filepath.Join(MustGetPathToChainConfig(chain), "prices.csv")
+	}
+
+	feeds := make(map[string]string, len(ret.ChainlinkFeeds)+len(mainnetPricing.ChainlinkFeeds))
+	if chain == "mainnet" {
+		if len(ret.UniswapV3Factory) == 0 {
+			ret.UniswapV3Factory = mainnetPricing.UniswapV3Factory
+		}
+		if len(ret.UsdToken) == 0 {
+			ret.UsdToken = mainnetPricing.UsdToken
+		}
+		if len(ret.WethToken) == 0 {
+			ret.WethToken = mainnetPricing.WethToken
+		}
+		for asset, feed := range mainnetPricing.ChainlinkFeeds {
+			feeds[asset] = feed
+		}
+	}
+	for asset, feed := range ret.ChainlinkFeeds {
+		feeds[strings.ToLower(asset)] = strings.ToLower(feed)
+	}
+	ret.ChainlinkFeeds = feeds
+
+	return ret
+}
+
+func (s *PricingSettings) isEmpty() bool {
+	return len(s.Sources) == 0 &&
+		len(s.PriceFile) == 0 &&
+		len(s.UniswapV3Factory) == 0 &&
+		len(s.UsdToken) == 0 &&
+		len(s.WethToken) == 0 &&
+		s.TwapSeconds == 0 &&
+		len(s.ChainlinkFeeds) == 0
+}
@@ -0,0 +1,65 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package config
+
+// rpcGroup is the [rpc] section of a chain's config. MaxRetries is a pointer so that an explicit
+// zero (no retries) may be told apart from a missing value (the default).
+type rpcGroup struct {
+	Timeout      uint64  `toml:"timeout,omitempty"`
+	MaxRetries   *uint64 `toml:"maxRetries,omitempty"`
+	BackoffMs    uint64  `toml:"backoffMs,omitempty"`
+	MaxBackoffMs uint64  `toml:"maxBackoffMs,omitempty"`
+	MaxPerSecond float64 `toml:"maxPerSecond,omitempty"`
+}
+
+// RpcSettings carries config information for calls to a chain's RPC providers
+type RpcSettings struct {
+	Timeout      uint64  `toml:"timeout" json:"timeout"`
+	MaxRetries   uint64  `toml:"maxRetries" json:"maxRetries"`
+	BackoffMs    uint64  `toml:"backoffMs" json:"backoffMs"`
+	MaxBackoffMs uint64  `toml:"maxBackoffMs" json:"maxBackoffMs"`
+	MaxPerSecond float64 `toml:"maxPerSecond" json:"maxPerSecond,omitempty"`
+}
+
+// DefaultRpcSettings are used for chains that do not have an [rpc] section (and for
+// calls made directly to a url rather than to a chain).
+var DefaultRpcSettings = RpcSettings{
+	Timeout:      60,
+	MaxRetries:   5,
+	BackoffMs:    250,
+	MaxBackoffMs: 10000,
+	MaxPerSecond: 0,
+}
+
+// GetRpcSettings returns the RPC settings per chain
+func GetRpcSettings(chain string) RpcSettings {
+	if ch, ok := GetRootConfig().Chains[chain]; ok {
+		return ch.Rpc.settings()
+	}
+	return DefaultRpcSettings
+}
+
+// settings fills in any missing or zero valued timing fields. A zero MaxRetries or MaxPerSecond
+// is meaningful (no retries, no rate limit) and is left alone.
+func (g rpcGroup) settings() RpcSettings {
+	s := DefaultRpcSettings
+	if g.Timeout != 0 {
+		s.Timeout = g.Timeout
+	}
+	if g.MaxRetries != nil {
+		s.MaxRetries = *g.MaxRetries
+	}
+	if g.BackoffMs != 0 {
+		s.BackoffMs = g.BackoffMs
+	}
+	if g.MaxBackoffMs != 0 {
+		s.MaxBackoffMs = g.MaxBackoffMs
+	}
+	if s.MaxBackoffMs < s.BackoffMs {
+		s.MaxBackoffMs = s.BackoffMs
+	}
+	s.MaxPerSecond = g.MaxPerSecond
+	return s
+}
@@ -0,0 +1,27 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package config
+
+import "testing"
+
+func TestRpcGroupSettings(t *testing.T) {
+	if got := (rpcGroup{}).settings(); got != DefaultRpcSettings {
+		t.Errorf("an empty group should give the defaults, got %+v", got)
+	}
+
+	zero := uint64(0)
+	got := rpcGroup{MaxRetries: &zero}.settings()
+	if got.MaxRetries != 0 {
+		t.Errorf("maxRetries = 0 should mean no retries, got %d", got.MaxRetries)
+	}
+	if got.Timeout != DefaultRpcSettings.Timeout || got.BackoffMs != DefaultRpcSettings.BackoffMs {
+		t.Errorf("missing values should be the defaults, got %+v", got)
+	}
+
+	got = rpcGroup{BackoffMs: 20000}.settings()
+	if got.MaxRetries != DefaultRpcSettings.MaxRetries || got.MaxBackoffMs != 20000 {
+		t.Errorf("expected the default retries and maxBackoffMs raised to backoffMs, got %+v", got)
+	}
+}
@@ -10,6 +10,8 @@ type settingsGroup struct {
 	DefaultChain   string      `toml:"defaultChain"`
 	DefaultGateway string      `toml:"defaultGateway,omitempty"`
 	Notify         notifyGroup `toml:"notify"`
+	Daemon         daemonGroup `toml:"daemon"`
+	Names          namesGroup  `toml:"names"`
 }
 
 func GetSettings() settingsGroup {
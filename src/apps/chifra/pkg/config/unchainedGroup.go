@@ -12,6 +12,9 @@ type unchainedGroup struct {
 	Comment            string `toml:"comment"`
 	PreferredPublisher string `toml:"preferredPublisher,omitempty"`
 	SmartContract      string `toml:"smartContract,omitempty"`
+	Keystore           string `toml:"keystore,omitempty"`
+	Password           string `toml:"password,omitempty"`
+	PrivateKey         string `toml:"privateKey,omitempty"`
 }
 
 func GetUnchained() unchainedGroup {
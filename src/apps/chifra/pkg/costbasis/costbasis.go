@@ -0,0 +1,230 @@
+package costbasis
+
+import (
+	"math/big"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// Method is the way in which disposals are matched to previously acquired lots
+type Method string
+
+const (
+	Fifo    Method = "fifo"    // first in, first out
+	Lifo    Method = "lifo"    // last in, first out
+	Hifo    Method = "hifo"    // highest cost in, first out
+	Average Method = "average" // lots consumed oldest first at the average cost of all open lots
+)
+
+// lot is the (remaining) quantity of an asset acquired by a single statement
+type lot struct {
+	blockNumber base.Blknum
+	timestamp   base.Timestamp
+	amount      base.Wei
+	unitCost    base.Float // US dollars per whole unit of the asset
+}
+
+// holding is the collection of open lots of a single asset along with its most recent price
+type holding struct {
+	addr      base.Address
+	symbol    string
+	decimals  base.Value
+	lots      []*lot
+	lastPrice base.Float
+	lastBlock base.Blknum
+	lastTs    base.Timestamp
+}
+
+// Tracker accumulates the lots of each asset held by an address. Statements must be added in
+// chronological order.
+type Tracker struct {
+	accountedFor base.Address
+	method       Method
+	holdings     map[base.Address]*holding
+	order        []base.Address
+}
+
+// NewTracker returns a Tracker that matches disposals to lots using the given method
+func NewTracker(accountedFor base.Address, method Method) *Tracker {
+	if method == "" {
+		method = Fifo
+	}
+	return &Tracker{
+		accountedFor: accountedFor,
+		method:       method,
+		holdings:     make(map[base.Address]*holding),
+	}
+}
+
+// Add records the statement's net movement of its asset. A net inflow opens a new lot at the
+// statement's spot price. A net outflow (including gas) is a disposal at the spot price and is
+// returned as one Disposal per lot it consumes. NFTs are not priced and are ignored.
+func (t *Tracker) Add(s *types.Statement) []types.Disposal {
+	if s.IsNft() {
+		return []types.Disposal{}
+	}
+
+	h := t.holding(s)
+	if s.SpotPrice != 0 {
+		h.lastPrice = s.SpotPrice
+		h.lastBlock = s.BlockNumber
+		h.lastTs = s.Timestamp
+	}
+
+	net := s.AmountNet()
+	switch net.Cmp(base.NewWei(0)) {
+	case 1:
+		h.lots = append(h.lots, &lot{
+			blockNumber: s.BlockNumber,
+			timestamp:   s.Timestamp,
+			amount:      *net,
+			unitCost:    s.SpotPrice,
+		})
+	case -1:
+		return t.dispose(h, s, new(base.Wei).Sub(base.NewWei(0), net))
+	}
+	return []types.Disposal{}
+}
+
+// Unrealized returns one Disposal for each lot still held, valued at the asset's latest price
+func (t *Tracker) Unrealized() []types.Disposal {
+	ret := make([]types.Disposal, 0)
+	for _, addr := range t.order {
+		h := t.holdings[addr]
+		for _, l := range h.lots {
+			d := t.newDisposal(h, *clone(&l.amount), l.unitCost, h.lastPrice)
+			d.AcquiredBlock = l.blockNumber
+			d.AcquiredTs = l.timestamp
+			d.DisposedBlock = h.lastBlock
+			d.DisposedTs = h.lastTs
+			d.Unrealized = true
+			ret = append(ret, d)
+		}
+	}
+	return ret
+}
+
+func (t *Tracker) holding(s *types.Statement) *holding {
+	if h, ok := t.holdings[s.AssetAddr]; ok {
+		return h
+	}
+	h := &holding{
+		addr:     s.AssetAddr,
+		symbol:   s.AssetSymbol,
+		decimals: s.Decimals,
+	}
+	t.holdings[s.AssetAddr] = h
+	t.order = append(t.order, s.AssetAddr)
+	return h
+}
+
+func (t *Tracker) dispose(h *holding, s *types.Statement, qty *base.Wei) []types.Disposal {
+	ret := make([]types.Disposal, 0)
+	if t.method == Average {
+		// Pooling the open lots at their average cost keeps the cost of what remains unchanged
+		avg := h.averageCost()
+		for _, l := range h.lots {
+			l.unitCost = avg
+		}
+	}
+
+	for qty.Cmp(base.NewWei(0)) > 0 && len(h.lots) > 0 {
+		i := t.nextLot(h)
+		l := h.lots[i]
+
+		take := clone(qty)
+		if l.amount.Cmp(qty) < 0 {
+			take = clone(&l.amount)
+		}
+
+		d := t.newDisposal(h, *take, l.unitCost, s.SpotPrice)
+		d.AcquiredBlock = l.blockNumber
+		d.AcquiredTs = l.timestamp
+		d.DisposedBlock = s.BlockNumber
+		d.DisposedTs = s.Timestamp
+		d.TransactionHash = s.TransactionHash
+		ret = append(ret, d)
+
+		l.amount.Sub(&l.amount, take)
+		qty.Sub(qty, take)
+		if l.amount.IsZero() {
+			h.lots = append(h.lots[:i], h.lots[i+1:]...)
+		}
+	}
+
+	// Disposing of more than we know was acquired (for example, if the export started after the
+	// asset was received) leaves a remainder with an unknown, and therefore zero, cost basis.
+	if qty.Cmp(base.NewWei(0)) > 0 {
+		d := t.newDisposal(h, *clone(qty), 0, s.SpotPrice)
+		d.DisposedBlock = s.BlockNumber
+		d.DisposedTs = s.Timestamp
+		d.TransactionHash = s.TransactionHash
+		ret = append(ret, d)
+	}
+
+	return ret
+}
+
+// nextLot returns the index of the open lot the next disposal consumes
+func (t *Tracker) nextLot(h *holding) int {
+	switch t.method {
+	case Lifo:
+		return len(h.lots) - 1
+	case Hifo:
+		best := 0
+		for i, l := range h.lots {
+			if l.unitCost > h.lots[best].unitCost {
+				best = i
+			}
+		}
+		return best
+	default:
+		return 0
+	}
+}
+
+func (t *Tracker) newDisposal(h *holding, amount base.Wei, unitCost, unitPrice base.Float) types.Disposal {
+	return types.Disposal{
+		AccountedFor: t.accountedFor,
+		AssetAddr:    h.addr,
+		AssetSymbol:  h.symbol,
+		Decimals:     h.decimals,
+		Method:       string(t.method),
+		Amount:       amount,
+		CostBasis:    value(&amount, h.decimals, unitCost),
+		Proceeds:     value(&amount, h.decimals, unitPrice),
+	}
+}
+
+// averageCost returns the average US dollar cost per whole unit of the holding's open lots
+func (h *holding) averageCost() base.Float {
+	total := new(big.Float)
+	cost := new(big.Float)
+	for _, l := range h.lots {
+		amt := new(big.Float).SetInt(l.amount.BigInt())
+		total.Add(total, amt)
+		cost.Add(cost, amt.Mul(amt, big.NewFloat(float64(l.unitCost))))
+	}
+	if total.Sign() == 0 {
+		return 0
+	}
+	f, _ := cost.Quo(cost, total).Float64()
+	return base.Float(f)
+}
+
+// value returns the US dollar value of amount (in the asset's smallest unit) at the given unit price
+func value(amount *base.Wei, decimals base.Value, unitPrice base.Float) base.Float {
+	if unitPrice == 0 {
+		return 0
+	}
+	scale := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	units := new(big.Float).Quo(new(big.Float).SetInt(amount.BigInt()), scale)
+	f, _ := units.Mul(units, big.NewFloat(float64(unitPrice))).Float64()
+	return base.Float(f)
+}
+
+// clone returns a copy of w that shares no storage with it
+func clone(w *base.Wei) *base.Wei {
+	return new(base.Wei).Add(w, base.NewWei(0))
+}
@@ -0,0 +1,112 @@
+package costbasis
+
+import (
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+const (
+	day   = base.Timestamp(86400)
+	start = base.Timestamp(1600000000)
+)
+
+var token = base.HexToAddress("0x6b175474e89094c44da98b954eedeac495271d0f")
+
+func stmt(bn base.Blknum, ts base.Timestamp, in, out int64, price base.Float) types.Statement {
+	return types.Statement{
+		BlockNumber: bn,
+		Timestamp:   start + ts,
+		AssetAddr:   token,
+		AssetSymbol: "TOK",
+		Decimals:    0,
+		AmountIn:    *base.NewWei(in),
+		AmountOut:   *base.NewWei(out),
+		SpotPrice:   price,
+	}
+}
+
+func TestTracker(t *testing.T) {
+	// buy 10 @ 1, buy 10 @ 3, buy 10 @ 2, sell 15 @ 4 (two years later)
+	history := []types.Statement{
+		stmt(1, 0, 10, 0, 1),
+		stmt(2, day, 10, 0, 3),
+		stmt(3, 2*day, 10, 0, 2),
+		stmt(4, 800*day, 0, 15, 4),
+	}
+
+	tests := []struct {
+		method     Method
+		costBasis  base.Float
+		acquired   []base.Blknum
+		unrealized base.Float
+	}{
+		{Fifo, 10*1 + 5*3, []base.Blknum{1, 2}, 5*3 + 10*2},
+		{Lifo, 10*2 + 5*3, []base.Blknum{3, 2}, 10*1 + 5*3},
+		{Hifo, 10*3 + 5*2, []base.Blknum{2, 3}, 10*1 + 5*2},
+		{Average, 15 * 2, []base.Blknum{1, 2}, 15 * 2},
+	}
+
+	for _, tt := range tests {
+		tracker := NewTracker(base.ZeroAddr, tt.method)
+		disposals := []types.Disposal{}
+		for i := range history {
+			disposals = append(disposals, tracker.Add(&history[i])...)
+		}
+
+		if len(disposals) != len(tt.acquired) {
+			t.Errorf("%s: expected %d disposals, got %d", tt.method, len(tt.acquired), len(disposals))
+			continue
+		}
+
+		var costBasis, proceeds base.Float
+		for i, d := range disposals {
+			if d.AcquiredBlock != tt.acquired[i] {
+				t.Errorf("%s: disposal %d expected lot from block %d, got %d", tt.method, i, tt.acquired[i], d.AcquiredBlock)
+			}
+			if !d.IsLongTerm() {
+				t.Errorf("%s: disposal %d expected to be long term", tt.method, i)
+			}
+			costBasis += d.CostBasis
+			proceeds += d.Proceeds
+		}
+		if costBasis != tt.costBasis || proceeds != 60 {
+			t.Errorf("%s: expected cost basis %f and proceeds 60, got %f and %f", tt.method, tt.costBasis, costBasis, proceeds)
+		}
+
+		var unrealizedCost base.Float
+		var held int64
+		for _, d := range tracker.Unrealized() {
+			if !d.Unrealized || d.DisposedBlock != 4 {
+				t.Errorf("%s: unexpected unrealized lot %v", tt.method, d)
+			}
+			unrealizedCost += d.CostBasis
+			held += d.Amount.BigInt().Int64()
+		}
+		if held != 15 || unrealizedCost != tt.unrealized {
+			t.Errorf("%s: expected 15 held at cost %f, got %d at cost %f", tt.method, tt.unrealized, held, unrealizedCost)
+		}
+	}
+}
+
+func TestTrackerUnknownLots(t *testing.T) {
+	tracker := NewTracker(base.ZeroAddr, Fifo)
+	in := stmt(1, 0, 5, 0, 1)
+	out := stmt(2, 10*day, 0, 8, 2)
+	tracker.Add(&in)
+	disposals := tracker.Add(&out)
+	if len(disposals) != 2 {
+		t.Fatalf("expected two disposals, got %d", len(disposals))
+	}
+	remainder := disposals[1]
+	if remainder.AcquiredBlock != 0 || remainder.CostBasis != 0 || remainder.Proceeds != 6 || remainder.HoldingDays() != 0 {
+		t.Errorf("unexpected remainder %v", remainder)
+	}
+	if disposals[0].HoldingDays() != 10 || disposals[0].IsLongTerm() {
+		t.Errorf("unexpected holding period %d", disposals[0].HoldingDays())
+	}
+	if len(tracker.Unrealized()) != 0 {
+		t.Errorf("expected no unrealized lots")
+	}
+}
@@ -0,0 +1,3 @@
+// Package costbasis matches the disposals found in an address's statements to the tax lots in which
+// the assets were acquired, reporting the cost basis, proceeds and gain of each
+package costbasis
@@ -0,0 +1,41 @@
+package decache
+
+import (
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/cache"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// LocationsFromStatements returns the cached prices of every asset found in the address's cached
+// statements. It must be called before the statements themselves are decached.
+func LocationsFromStatements(conn *rpc.Connection, address base.Address, apps []types.Appearance) ([]cache.Locator, error) {
+	locations := make([]cache.Locator, 0)
+	if !conn.StoreReadable() {
+		return locations, nil
+	}
+
+	seen := make(map[string]bool)
+	for _, app := range apps {
+		group := &types.StatementGroup{
+			Address:          address,
+			BlockNumber:      base.Blknum(app.BlockNumber),
+			TransactionIndex: base.Txnum(app.TransactionIndex),
+		}
+		if err := conn.Store.Read(group, nil); err != nil {
+			continue
+		}
+		for _, statement := range group.Statements {
+			// walk.Cache_Prices
+			item := &types.PriceItem{
+				Asset:       statement.AssetAddr,
+				BlockNumber: statement.BlockNumber,
+			}
+			if !seen[item.CacheId()] {
+				seen[item.CacheId()] = true
+				locations = append(locations, item)
+			}
+		}
+	}
+	return locations, nil
+}
@@ -0,0 +1,71 @@
+package decache
+
+import (
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/cache"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/walk"
+)
+
+func TestDecachePrices(t *testing.T) {
+	store, err := cache.NewStore(&cache.StoreOptions{Location: cache.FsCache, RootDir: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn := &rpc.Connection{Store: store}
+
+	address := base.HexToAddress("0xf503017d7baf7fbc0fff7492b751025c6a78179b")
+	weth := base.HexToAddress("0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2")
+	uni := base.HexToAddress("0x1f9840a85d5af5bf1d1762f925bdaddc4201f984")
+	dai := base.HexToAddress("0x6b175474e89094c44da98b954eedeac495271d0f")
+
+	group := &types.StatementGroup{
+		Address:          address,
+		BlockNumber:      17432262,
+		TransactionIndex: 44,
+		Statements: []types.Statement{
+			{AccountedFor: address, AssetAddr: weth, BlockNumber: 17432262, TransactionIndex: 44},
+			{AccountedFor: address, AssetAddr: uni, BlockNumber: 17432262, TransactionIndex: 44},
+		},
+	}
+	if err := store.Write(group, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// A price in the monitor's statements and one that is not
+	prices := []*types.PriceItem{
+		{Asset: weth, BlockNumber: 17432262, Price: 1800, Source: "uniswap"},
+		{Asset: dai, BlockNumber: 17432262, Price: 1, Source: "stable-coin"},
+	}
+	for _, price := range prices {
+		if err := store.Write(price, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	apps := []types.Appearance{
+		{Address: address, BlockNumber: 17432262, TransactionIndex: 44},
+		{Address: address, BlockNumber: 17432263, TransactionIndex: 0}, // not cached
+	}
+	locs, err := LocationsFromStatements(conn, address, apps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(locs) != 2 {
+		t.Fatalf("expected a location for each of the statements' assets, got %d", len(locs))
+	}
+
+	if _, err := Decache(conn, locs, false, walk.Cache_Prices); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Read(&types.PriceItem{Asset: weth, BlockNumber: 17432262}, nil); err == nil {
+		t.Error("expected the price of an asset in the statements to be decached")
+	}
+	if err := store.Read(&types.PriceItem{Asset: dai, BlockNumber: 17432262}, nil); err != nil {
+		t.Error("expected the price of an asset not in the statements to remain cached")
+	}
+}
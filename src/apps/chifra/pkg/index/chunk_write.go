@@ -126,6 +126,19 @@ func (chunk *Chunk) Write(chain string, publisher base.Address, fileName string,
 			// We're sucessfully written the chunk, so we don't need this any more. If the pin
 			// fails we don't want to have to re-do this chunk, so remove this here.
 			os.Remove(backupFn)
+
+			// If the user has built the address shards, we keep them up to date. A failure here is
+			// not fatal since the chunk will be searched by its bloom filter until it's sharded.
+			if HasShards(chain) {
+				addresses := make([]base.Address, 0, len(addressTable))
+				for _, record := range addressTable {
+					addresses = append(addresses, record.Address)
+				}
+				if err := ShardChunk(chain, base.RangeFromFilename(indexFn), addresses); err != nil {
+					logger.Warn("could not add chunk to the address shards:", err)
+				}
+			}
+
 			return &writeReport{
 				Range:        base.RangeFromFilename(indexFn),
 				nAddresses:   len(addressTable),
@@ -0,0 +1,172 @@
+package index
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
+)
+
+// The address shards are an optional secondary index, derived from the chunks, that maps each address
+// to the ranges of the chunks in which it appears. The addresses are split into 4096 shard files by the
+// first three hex characters of the address. Each shard is a list of shardRecords appended to as chunks
+// are added. A separate file (covered.txt) lists the ranges of the chunks whose addresses have been
+// added, so a chunk that is not covered must still be searched by its bloom filter.
+
+// shardPrefixLen is the number of hex characters of an address used to pick its shard
+const shardPrefixLen = 3
+
+// shardRecord is a single record in a shard: an address and the range of a chunk in which it appears
+type shardRecord struct {
+	Address base.Address
+	First   uint32
+	Last    uint32
+}
+
+const shardRecordWidth = 20 + 4 + 4
+
+// ToShardsPath returns the folder holding the chain's address shards
+func ToShardsPath(chain string) string {
+	return filepath.Join(config.PathToIndex(chain), "shards")
+}
+
+func shardPath(chain string, address base.Address) string {
+	return filepath.Join(ToShardsPath(chain), hex.EncodeToString(address.Bytes()[:2])[:shardPrefixLen]+".bin")
+}
+
+func coveredPath(chain string) string {
+	return filepath.Join(ToShardsPath(chain), "covered.txt")
+}
+
+// HasShards returns true if the address shards have been built for the chain. Once built, chifra
+// scrape keeps them up to date.
+func HasShards(chain string) bool {
+	return file.FileExists(coveredPath(chain))
+}
+
+// ShardChunk adds the chunk's addresses to the shards and records the chunk as covered. If this is
+// interrupted, the chunk is not covered and some of its addresses may be added twice when it is
+// retried. That does no harm.
+func ShardChunk(chain string, rng base.FileRange, addresses []base.Address) error {
+	if err := os.MkdirAll(ToShardsPath(chain), 0755); err != nil {
+		return err
+	}
+
+	byShard := make(map[string][]shardRecord)
+	for _, address := range addresses {
+		path := shardPath(chain, address)
+		byShard[path] = append(byShard[path], shardRecord{
+			Address: address,
+			First:   uint32(rng.First),
+			Last:    uint32(rng.Last),
+		})
+	}
+
+	for path, records := range byShard {
+		fp, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return err
+		}
+		if err = binary.Write(fp, binary.LittleEndian, records); err != nil {
+			fp.Close()
+			return err
+		}
+		if err = fp.Close(); err != nil {
+			return err
+		}
+	}
+
+	fp, err := os.OpenFile(coveredPath(chain), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer fp.Close()
+	_, err = fp.WriteString(rng.String() + "\n")
+	return err
+}
+
+// ShardsCovered returns the ranges of the chunks whose addresses are in the shards
+func ShardsCovered(chain string) (map[base.FileRange]bool, error) {
+	ret := make(map[base.FileRange]bool)
+	fp, err := os.Open(coveredPath(chain))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ret, nil
+		}
+		return nil, err
+	}
+	defer fp.Close()
+
+	scanner := bufio.NewScanner(fp)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); len(line) > 0 {
+			ret[base.RangeFromRangeString(line)] = true
+		}
+	}
+	return ret, scanner.Err()
+}
+
+// ShardsContaining returns the ranges of the chunks in which any of the addresses appear. Only covered
+// chunks are reported (see ShardsCovered).
+func ShardsContaining(chain string, addresses []base.Address) (map[base.FileRange]bool, error) {
+	byShard := make(map[string][]base.Address)
+	for _, address := range addresses {
+		path := shardPath(chain, address)
+		byShard[path] = append(byShard[path], address)
+	}
+
+	ret := make(map[base.FileRange]bool)
+	for path, needles := range byShard {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		for offset := 0; offset+shardRecordWidth <= len(contents); offset += shardRecordWidth {
+			record := contents[offset : offset+shardRecordWidth]
+			for _, needle := range needles {
+				if bytes.Equal(record[:20], needle.Bytes()) {
+					ret[base.FileRange{
+						First: base.Blknum(binary.LittleEndian.Uint32(record[20:24])),
+						Last:  base.Blknum(binary.LittleEndian.Uint32(record[24:28])),
+					}] = true
+					break
+				}
+			}
+		}
+	}
+	return ret, nil
+}
+
+// UncoverShards removes the chunks that end at or after the given block from the covered list (for
+// example, when the index is truncated). Their records remain in the shards, but since the chunks are
+// no longer covered, they are not relied upon.
+func UncoverShards(chain string, bn base.Blknum) error {
+	covered, err := ShardsCovered(chain)
+	if err != nil || len(covered) == 0 {
+		return err
+	}
+
+	lines := make([]string, 0, len(covered))
+	for rng := range covered {
+		if rng.Last < bn {
+			lines = append(lines, rng.String())
+		}
+	}
+	sort.Strings(lines)
+	tmpPath := coveredPath(chain) + ".tmp"
+	if err = os.WriteFile(tmpPath, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, coveredPath(chain))
+}
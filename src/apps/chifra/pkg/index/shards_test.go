@@ -0,0 +1,61 @@
+package index
+
+import (
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+)
+
+func TestShards(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	chain := "mainnet"
+
+	if HasShards(chain) {
+		t.Fatal("shards should not exist yet")
+	}
+
+	// The first two share a shard (0xabc), the last does not
+	a1 := base.HexToAddress("0xabc0000000000000000000000000000000000001")
+	a2 := base.HexToAddress("0xabc0000000000000000000000000000000000002")
+	a3 := base.HexToAddress("0x1230000000000000000000000000000000000003")
+
+	r1 := base.FileRange{First: 0, Last: 99}
+	r2 := base.FileRange{First: 100, Last: 199}
+	r3 := base.FileRange{First: 200, Last: 299}
+	if err := ShardChunk(chain, r1, []base.Address{a1, a3}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ShardChunk(chain, r2, []base.Address{a2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ShardChunk(chain, r3, []base.Address{a1}); err != nil {
+		t.Fatal(err)
+	}
+
+	if !HasShards(chain) {
+		t.Fatal("shards should exist")
+	}
+
+	covered, err := ShardsCovered(chain)
+	if err != nil || len(covered) != 3 || !covered[r1] || !covered[r2] || !covered[r3] {
+		t.Error("wrong covered ranges", covered, err)
+	}
+
+	containing, err := ShardsContaining(chain, []base.Address{a1})
+	if err != nil || len(containing) != 2 || !containing[r1] || !containing[r3] {
+		t.Error("wrong ranges for a1", containing, err)
+	}
+
+	containing, err = ShardsContaining(chain, []base.Address{a2, a3})
+	if err != nil || len(containing) != 2 || !containing[r1] || !containing[r2] {
+		t.Error("wrong ranges for a2 and a3", containing, err)
+	}
+
+	if err = UncoverShards(chain, 150); err != nil {
+		t.Fatal(err)
+	}
+	covered, _ = ShardsCovered(chain)
+	if len(covered) != 1 || !covered[r1] {
+		t.Error("expected only the first range to be covered", covered)
+	}
+}
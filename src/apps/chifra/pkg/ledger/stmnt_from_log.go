@@ -18,13 +18,32 @@ var transferTopic = base.HexToHash(
 
 var ErrNonIndexedTransfer = fmt.Errorf("non-indexed transfer")
 
-// getStatementsFromLog returns a statement from a given log
-func (l *Ledger) getStatementsFromLog(conn *rpc.Connection, logIn *types.Log) (types.Statement, error) {
-	if logIn.Topics[0] != transferTopic {
+// getStatementsFromLog returns the statements from a given log. ERC-20 and ERC-721 transfers and ERC-1155
+// single transfers produce one statement, ERC-1155 batch transfers produce one per token id, and other
+// logs produce none.
+func (l *Ledger) getStatementsFromLog(conn *rpc.Connection, logIn *types.Log) ([]types.Statement, error) {
+	if len(logIn.Topics) == 0 {
+		return []types.Statement{}, nil
+	}
+
+	switch logIn.Topics[0] {
+	case transferTopic:
+		if len(logIn.Topics) == 4 {
+			// ERC-721 shares ERC-20's topic, but indexes the tokenId instead of carrying an amount
+			return l.getStatementsFromNftLog(conn, logIn)
+		}
+		s, err := l.getStatementFromTransfer(conn, logIn)
+		return []types.Statement{s}, err
+	case transferSingleTopic, transferBatchTopic:
+		return l.getStatementsFromNftLog(conn, logIn)
+	default:
 		// Not a transfer
-		return types.Statement{}, nil
+		return []types.Statement{}, nil
 	}
+}
 
+// getStatementFromTransfer returns a statement from an ERC-20 transfer log
+func (l *Ledger) getStatementFromTransfer(conn *rpc.Connection, logIn *types.Log) (types.Statement, error) {
 	if log, err := l.normalizeTransfer(logIn); err != nil {
 		return types.Statement{}, err
 
@@ -53,8 +53,10 @@ func TestGetStatementFromLog(t *testing.T) {
 		TransactionIndex: uint32(txid),
 	})
 	l.SetContexts("mainnet", apps)
-	s, _ := l.getStatementsFromLog(conn, &log)
-	b, _ := json.MarshalIndent(s, "", "  ")
-	fmt.Println(string(b))
-	fmt.Println("reconciled:", s.Reconciled())
+	statements, _ := l.getStatementsFromLog(conn, &log)
+	for _, s := range statements {
+		b, _ := json.MarshalIndent(s, "", "  ")
+		fmt.Println(string(b))
+		fmt.Println("reconciled:", s.Reconciled())
+	}
 }
@@ -0,0 +1,238 @@
+package ledger
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/colors"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/utils"
+)
+
+// TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+var transferSingleTopic = base.HexToHash(
+	"0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62",
+)
+
+// TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+var transferBatchTopic = base.HexToHash(
+	"0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb",
+)
+
+// nftTransfer is the movement of a quantity of a single token id found in an ERC-721 or ERC-1155 log
+type nftTransfer struct {
+	sender    base.Address
+	recipient base.Address
+	tokenId   base.Wei
+	amount    base.Wei
+}
+
+// getStatementsFromNftLog returns one statement for each token id moved by an ERC-721 Transfer or an
+// ERC-1155 TransferSingle or TransferBatch log. Each statement is reconciled against the accountedFor
+// address's balance of that token id only.
+func (l *Ledger) getStatementsFromNftLog(conn *rpc.Connection, log *types.Log) ([]types.Statement, error) {
+	isErc1155 := log.Topics[0] != transferTopic
+	transfers, err := parseNftTransfers(log)
+	if err != nil {
+		// A malformed log should not prevent the rest of the transaction's statements
+		logger.TestLog(true, "Skipping log", log.BlockNumber, log.TransactionIndex, log.LogIndex, err)
+		return []types.Statement{}, nil
+	}
+
+	sym := log.Address.Prefix(6)
+	name := l.Names[log.Address]
+	if name.Address == log.Address && name.Symbol != "" {
+		sym = name.Symbol
+	}
+
+	assetType := "erc721"
+	if isErc1155 {
+		assetType = "erc1155"
+	}
+
+	key := l.ctxKey(log.BlockNumber, log.TransactionIndex)
+	ctx := l.Contexts[key]
+
+	statements := make([]types.Statement, 0, len(transfers))
+	for _, transfer := range transfers {
+		s := types.Statement{
+			AccountedFor:     l.AccountFor,
+			Sender:           transfer.sender,
+			Recipient:        transfer.recipient,
+			BlockNumber:      log.BlockNumber,
+			TransactionIndex: log.TransactionIndex,
+			LogIndex:         log.LogIndex,
+			TransactionHash:  log.TransactionHash,
+			Timestamp:        log.Timestamp,
+			AssetAddr:        log.Address,
+			AssetSymbol:      sym,
+			AssetType:        assetType,
+			Decimals:         0,
+			TokenId:          transfer.tokenId,
+			SpotPrice:        0.0,
+			PriceSource:      "not-priced",
+		}
+
+		ofInterest := false
+
+		// Do not collapse, may be both
+		if l.AccountFor == transfer.sender {
+			s.AmountOut = transfer.amount
+			ofInterest = true
+		}
+
+		// Do not collapse, may be both
+		if l.AccountFor == transfer.recipient {
+			s.AmountIn = transfer.amount
+			ofInterest = true
+		}
+
+		if ofInterest {
+			balanceAt := func(bn base.Blknum) (*base.Wei, error) {
+				return conn.GetBalanceAtNft(log.Address, l.AccountFor, &s.TokenId, isErc1155, fmt.Sprintf("0x%x", bn))
+			}
+
+			if pBal, err := balanceAt(ctx.PrevBlock); pBal == nil {
+				return statements, err
+			} else {
+				s.PrevBal = *pBal
+			}
+
+			if bBal, err := balanceAt(ctx.CurBlock - 1); bBal == nil {
+				return statements, err
+			} else {
+				s.BegBal = *bBal
+			}
+
+			if eBal, err := balanceAt(ctx.CurBlock); eBal == nil {
+				return statements, err
+			} else {
+				s.EndBal = *eBal
+			}
+
+			id := fmt.Sprintf(" %d.%d.%d", s.BlockNumber, s.TransactionIndex, s.LogIndex)
+			if !l.trialBalance(assetType, &s) {
+				if !utils.IsFuzzing() {
+					logger.Warn(colors.Yellow+"Log statement at ", id, " (token id ", s.TokenId.String(), ") does not reconcile."+colors.Off)
+				}
+			} else {
+				if !utils.IsFuzzing() {
+					logger.Progress(true, colors.Green+"Transaction", id, "reconciled       "+colors.Off)
+				}
+			}
+		}
+
+		statements = append(statements, s)
+	}
+
+	return statements, nil
+}
+
+var errMalformedNftLog = errors.New("malformed nft transfer")
+
+// parseNftTransfers returns the token ids and quantities moved by an ERC-721 Transfer (always a quantity
+// of one) or an ERC-1155 TransferSingle or TransferBatch log.
+func parseNftTransfers(log *types.Log) ([]nftTransfer, error) {
+	if len(log.Topics) != 4 {
+		return nil, fmt.Errorf("%w: expected four topics, got %d", errMalformedNftLog, len(log.Topics))
+	}
+
+	if log.Topics[0] == transferTopic {
+		return []nftTransfer{{
+			sender:    base.HexToAddress(log.Topics[1].Hex()),
+			recipient: base.HexToAddress(log.Topics[2].Hex()),
+			tokenId:   *base.HexToWei(log.Topics[3].Hex()),
+			amount:    *base.NewWei(1),
+		}}, nil
+	}
+
+	// For ERC-1155, topic one is the operator
+	sender := base.HexToAddress(log.Topics[2].Hex())
+	recipient := base.HexToAddress(log.Topics[3].Hex())
+
+	data, err := hex.DecodeString(strings.TrimPrefix(log.Data, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errMalformedNftLog, err)
+	}
+
+	word := func(i uint64) (*big.Int, error) {
+		if uint64(len(data)) < (i+1)*32 {
+			return nil, fmt.Errorf("%w: data too short", errMalformedNftLog)
+		}
+		return new(big.Int).SetBytes(data[i*32 : (i+1)*32]), nil
+	}
+
+	if log.Topics[0] == transferSingleTopic {
+		id, err := word(0)
+		if err != nil {
+			return nil, err
+		}
+		value, err := word(1)
+		if err != nil {
+			return nil, err
+		}
+		return []nftTransfer{{
+			sender:    sender,
+			recipient: recipient,
+			tokenId:   base.Wei(*id),
+			amount:    base.Wei(*value),
+		}}, nil
+	}
+
+	// TransferBatch carries two dynamic arrays, each found at the byte offset in the first two words
+	array := func(which uint64) ([]*big.Int, error) {
+		offset, err := word(which)
+		if err != nil {
+			return nil, err
+		}
+		if !offset.IsUint64() || offset.Uint64()%32 != 0 {
+			return nil, fmt.Errorf("%w: bad offset", errMalformedNftLog)
+		}
+		start := offset.Uint64() / 32
+		n, err := word(start)
+		if err != nil {
+			return nil, err
+		}
+		if !n.IsUint64() || n.Uint64() > uint64(len(data))/32 {
+			return nil, fmt.Errorf("%w: bad array length", errMalformedNftLog)
+		}
+		ret := make([]*big.Int, 0, n.Uint64())
+		for i := uint64(0); i < n.Uint64(); i++ {
+			v, err := word(start + 1 + i)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, v)
+		}
+		return ret, nil
+	}
+
+	ids, err := array(0)
+	if err != nil {
+		return nil, err
+	}
+	values, err := array(1)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) != len(values) {
+		return nil, fmt.Errorf("%w: %d ids but %d values", errMalformedNftLog, len(ids), len(values))
+	}
+
+	transfers := make([]nftTransfer, 0, len(ids))
+	for i := range ids {
+		transfers = append(transfers, nftTransfer{
+			sender:    sender,
+			recipient: recipient,
+			tokenId:   base.Wei(*ids[i]),
+			amount:    base.Wei(*values[i]),
+		})
+	}
+	return transfers, nil
+}
@@ -0,0 +1,94 @@
+package ledger
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+func TestParseNftTransfers(t *testing.T) {
+	operator := base.HexToHash("0x0000000000000000000000000000000000000001")
+	from := base.HexToHash("0x000000000000000000000000000000000000000a")
+	to := base.HexToHash("0x000000000000000000000000000000000000000b")
+	w := func(v uint64) string { return fmt.Sprintf("%064x", v) }
+
+	tests := []struct {
+		name    string
+		log     types.Log
+		ids     []uint64
+		amounts []uint64
+		wantErr bool
+	}{
+		{
+			name: "erc721",
+			log: types.Log{
+				Topics: []base.Hash{transferTopic, from, to, base.HexToHash("0x" + w(42))},
+				Data:   "0x",
+			},
+			ids:     []uint64{42},
+			amounts: []uint64{1},
+		},
+		{
+			name: "transferSingle",
+			log: types.Log{
+				Topics: []base.Hash{transferSingleTopic, operator, from, to},
+				Data:   "0x" + w(7) + w(3),
+			},
+			ids:     []uint64{7},
+			amounts: []uint64{3},
+		},
+		{
+			name: "transferBatch",
+			log: types.Log{
+				Topics: []base.Hash{transferBatchTopic, operator, from, to},
+				Data:   "0x" + w(64) + w(160) + w(2) + w(7) + w(8) + w(2) + w(5) + w(6),
+			},
+			ids:     []uint64{7, 8},
+			amounts: []uint64{5, 6},
+		},
+		{
+			name: "transferBatch mismatched lengths",
+			log: types.Log{
+				Topics: []base.Hash{transferBatchTopic, operator, from, to},
+				Data:   "0x" + w(64) + w(128) + w(1) + w(7) + w(2) + w(5) + w(6),
+			},
+			wantErr: true,
+		},
+		{
+			name: "transferSingle short data",
+			log: types.Log{
+				Topics: []base.Hash{transferSingleTopic, operator, from, to},
+				Data:   "0x" + w(7),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := parseNftTransfers(&tt.log)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", tt.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+			continue
+		}
+		if len(got) != len(tt.ids) {
+			t.Errorf("%s: expected %d transfers, got %d", tt.name, len(tt.ids), len(got))
+			continue
+		}
+		for i, transfer := range got {
+			if transfer.sender != base.HexToAddress(from.Hex()) || transfer.recipient != base.HexToAddress(to.Hex()) {
+				t.Errorf("%s: wrong sender or recipient %s %s", tt.name, transfer.sender, transfer.recipient)
+			}
+			if transfer.tokenId.Uint64() != tt.ids[i] || transfer.amount.Uint64() != tt.amounts[i] {
+				t.Errorf("%s: transfer %d expected id %d amount %d, got %s %s", tt.name, i, tt.ids[i], tt.amounts[i], transfer.tokenId.String(), transfer.amount.String())
+			}
+		}
+	}
+}
@@ -17,13 +17,15 @@ func (l *Ledger) getStatementsFromReceipt(conn *rpc.Connection, filter *filter.A
 	for _, log := range receipt.Logs {
 		addrArray := []base.Address{l.AccountFor}
 		if filter.ApplyLogFilter(&log, addrArray) && l.assetOfInterest(log.Address) {
-			if statement, err := l.getStatementsFromLog(conn, &log); err != nil {
+			if logStatements, err := l.getStatementsFromLog(conn, &log); err != nil {
 				return statements, err
 			} else {
-				if statement.Sender == l.AccountFor || statement.Recipient == l.AccountFor {
-					add := !l.NoZero || statement.IsMaterial()
-					if add {
-						statements = append(statements, statement)
+				for _, statement := range logStatements {
+					if statement.Sender == l.AccountFor || statement.Recipient == l.AccountFor {
+						add := !l.NoZero || statement.IsMaterial()
+						if add {
+							statements = append(statements, statement)
+						}
 					}
 				}
 			}
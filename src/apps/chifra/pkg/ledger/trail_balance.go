@@ -25,7 +25,8 @@ func (l *Ledger) trialBalance(reason string, s *types.Statement) bool {
 	}
 
 	// TODO: BOGUS PERF
-	if s.IsMaterial() {
+	// NFTs have no fungible price
+	if s.IsMaterial() && !s.IsNft() {
 		s.SpotPrice, s.PriceSource, _ = pricing.PriceUsd(l.Conn, s)
 	}
 
@@ -24,6 +24,9 @@ import (
 	ethAbi "github.com/ethereum/go-ethereum/accounts/abi"
 )
 
+// UnchainedChain is the chain on which the Unchained Index smart contract is deployed
+const UnchainedChain = "mainnet"
+
 // ReadUnchainedIndex calls UnchainedIndex smart contract to get the current manifest IPFS CID as
 // published by the given publisher
 func ReadUnchainedIndex(chain string, publisher base.Address, database string) (string, error) {
@@ -37,10 +40,9 @@ func ReadUnchainedIndex(chain string, publisher base.Address, database string) (
 		return "", err
 	}
 
-	unchainedChain := "mainnet" // the unchained index is on mainnet
-	conn := rpc.TempConnection(unchainedChain)
+	conn := rpc.TempConnection(UnchainedChain)
 	// if conn.LatestBlockTimestamp < 1_705_173_443 { // block 19_000_000
-	// 	provider := config.GetChain(unchainedChain).RpcProvider
+	// 	provider := config.GetChain(UnchainedChain).RpcProvider
 	// 	logger.Fatal(usage.Usage(unchainedWarning, provider))
 	// }
 
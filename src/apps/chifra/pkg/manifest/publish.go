@@ -0,0 +1,217 @@
+package manifest
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc/query"
+	ethAbi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var ErrNoPublisherKey = errors.New("no keystore or private key in the [unchained] section of the config")
+
+// PublisherKey returns the key that signs publications. It is read from the keystore file named in the
+// [unchained] section of the config (decrypted with the section's password) or, if there is no keystore,
+// from the section's privateKey.
+func PublisherKey() (*ecdsa.PrivateKey, error) {
+	unchained := config.GetUnchained()
+	key, err := ReadKey(unchained.Keystore, unchained.Password, unchained.PrivateKey)
+	if err == nil && key == nil {
+		err = ErrNoPublisherKey
+	}
+	return key, err
+}
+
+// ReadKey returns the key in the keystore file (decrypted with the password) or, if there is no keystore,
+// the hex private key. It returns nil if there is neither.
+func ReadKey(keystorePath, password, privateKey string) (*ecdsa.PrivateKey, error) {
+	if len(keystorePath) > 0 {
+		contents, err := os.ReadFile(keystorePath)
+		if err != nil {
+			return nil, fmt.Errorf("reading keystore: %w", err)
+		}
+		key, err := keystore.DecryptKey(contents, password)
+		if err != nil {
+			return nil, fmt.Errorf("decrypting keystore %s: %w", keystorePath, err)
+		}
+		return key.PrivateKey, nil
+
+	} else if len(privateKey) > 0 {
+		key, err := crypto.HexToECDSA(strings.TrimPrefix(privateKey, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid private key: %w", err)
+		}
+		return key, nil
+	}
+
+	return nil, nil
+}
+
+// Publication is a signed transaction that records a manifest's CID in the Unchained Index. The smart
+// contract records the sender of the transaction as the manifest's publisher.
+type Publication struct {
+	Database  string
+	Cid       string
+	Publisher base.Address
+	Contract  base.Address
+	Tx        *ethTypes.Transaction
+}
+
+var publishHashAbi = `[
+  {
+    "name": "publishHash",
+    "type": "function",
+    "inputs": [
+      { "type": "string", "name": "database", "internalType": "string" },
+      { "type": "string", "name": "hash", "internalType": "string" }
+    ],
+    "outputs": [],
+    "stateMutability": "nonpayable"
+  }
+]`
+
+// NewPublication builds a call to publishHash(database, cid) on the given contract and signs it with the
+// key. The chain id, nonce, fees, and gas limit are those reported by the node at rpcUrl.
+func NewPublication(rpcUrl string, contract base.Address, key *ecdsa.PrivateKey, database, cid string) (*Publication, error) {
+	abi, err := ethAbi.JSON(strings.NewReader(publishHashAbi))
+	if err != nil {
+		return nil, err
+	}
+	data, err := abi.Pack("publishHash", database, cid)
+	if err != nil {
+		return nil, err
+	}
+
+	pub := &Publication{
+		Database:  database,
+		Cid:       cid,
+		Publisher: base.Address{Address: crypto.PubkeyToAddress(key.PublicKey)},
+		Contract:  contract,
+	}
+
+	chainId, err := queryBig(rpcUrl, "eth_chainId", query.Params{})
+	if err != nil {
+		return nil, fmt.Errorf("reading chain id: %w", err)
+	}
+	nonce, err := queryBig(rpcUrl, "eth_getTransactionCount", query.Params{pub.Publisher.Hex(), "pending"})
+	if err != nil {
+		return nil, fmt.Errorf("reading nonce: %w", err)
+	}
+	tip, err := queryBig(rpcUrl, "eth_maxPriorityFeePerGas", query.Params{})
+	if err != nil {
+		return nil, fmt.Errorf("reading priority fee: %w", err)
+	}
+	header, err := query.QueryUrl[struct {
+		BaseFee string `json:"baseFeePerGas"`
+	}](rpcUrl, "eth_getBlockByNumber", query.Params{"latest", false})
+	if err != nil {
+		return nil, fmt.Errorf("reading base fee: %w", err)
+	}
+	baseFee, err := hexutil.DecodeBig(header.BaseFee)
+	if err != nil {
+		return nil, fmt.Errorf("reading base fee: %w", err)
+	}
+	gas, err := queryBig(rpcUrl, "eth_estimateGas", query.Params{map[string]string{
+		"from": pub.Publisher.Hex(),
+		"to":   contract.Hex(),
+		"data": hexutil.Encode(data),
+	}})
+	if err != nil {
+		return nil, fmt.Errorf("estimating gas: %w", err)
+	}
+
+	// The fee cap allows the base fee to double before the transaction is priced out of the block
+	feeCap := new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), tip)
+	to := contract.Address
+	tx := ethTypes.NewTx(&ethTypes.DynamicFeeTx{
+		ChainID:   chainId,
+		Nonce:     nonce.Uint64(),
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       gas.Uint64(),
+		To:        &to,
+		Data:      data,
+	})
+
+	if pub.Tx, err = ethTypes.SignTx(tx, ethTypes.LatestSignerForChainID(chainId), key); err != nil {
+		return nil, err
+	}
+	return pub, nil
+}
+
+// Hash returns the hash of the signed transaction
+func (pub *Publication) Hash() base.Hash {
+	return base.HexToHash(pub.Tx.Hash().Hex())
+}
+
+// RawTransaction returns the signed transaction as a hex string suitable for eth_sendRawTransaction
+func (pub *Publication) RawTransaction() (string, error) {
+	raw, err := pub.Tx.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	return hexutil.Encode(raw), nil
+}
+
+// Broadcast sends the signed transaction to the node at rpcUrl
+func (pub *Publication) Broadcast(rpcUrl string) error {
+	raw, err := pub.RawTransaction()
+	if err != nil {
+		return err
+	}
+	hash, err := query.QueryUrl[string](rpcUrl, "eth_sendRawTransaction", query.Params{raw})
+	if err != nil {
+		return err
+	}
+	if base.HexToHash(*hash) != pub.Hash() {
+		return fmt.Errorf("the node reported transaction %s, expected %s", *hash, pub.Tx.Hash().Hex())
+	}
+	return nil
+}
+
+// WaitForReceipt polls the node at rpcUrl until the transaction is mined or the timeout expires. It
+// returns the block in which the transaction was mined or an error if it failed.
+func (pub *Publication) WaitForReceipt(rpcUrl string, timeout time.Duration) (base.Blknum, error) {
+	type receipt struct {
+		BlockNumber string `json:"blockNumber"`
+		Status      string `json:"status"`
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		r, err := query.QueryUrl[receipt](rpcUrl, "eth_getTransactionReceipt", query.Params{pub.Tx.Hash().Hex()})
+		if err != nil {
+			return 0, err
+		}
+		if len(r.BlockNumber) > 0 {
+			bn, _ := hexutil.DecodeUint64(r.BlockNumber)
+			if r.Status != "0x1" {
+				return base.Blknum(bn), fmt.Errorf("transaction %s failed in block %d", pub.Tx.Hash().Hex(), bn)
+			}
+			return base.Blknum(bn), nil
+		}
+		if time.Now().After(deadline) {
+			return 0, fmt.Errorf("transaction %s was not mined within %s", pub.Tx.Hash().Hex(), timeout)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+func queryBig(rpcUrl, method string, params query.Params) (*big.Int, error) {
+	value, err := query.QueryUrl[string](rpcUrl, method, params)
+	if err != nil {
+		return nil, err
+	}
+	return hexutil.DecodeBig(*value)
+}
@@ -0,0 +1,119 @@
+package manifest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	ethAbi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// devChain stands in for a local development chain. It accepts raw transactions and mines each in the
+// next block.
+func devChain(t *testing.T) (*httptest.Server, map[string]*ethTypes.Transaction) {
+	mined := map[string]*ethTypes.Transaction{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := struct {
+			ID     int               `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Error(err)
+			return
+		}
+
+		var result any
+		switch request.Method {
+		case "eth_chainId":
+			result = "0x539"
+		case "eth_getTransactionCount":
+			result = "0x7"
+		case "eth_maxPriorityFeePerGas":
+			result = "0x3b9aca00"
+		case "eth_getBlockByNumber":
+			result = map[string]string{"number": "0x10", "baseFeePerGas": "0x77359400"}
+		case "eth_estimateGas":
+			result = "0xb411"
+		case "eth_sendRawTransaction":
+			var raw string
+			_ = json.Unmarshal(request.Params[0], &raw)
+			tx := new(ethTypes.Transaction)
+			if err := tx.UnmarshalBinary(hexutil.MustDecode(raw)); err != nil {
+				t.Error(err)
+			}
+			mined[tx.Hash().Hex()] = tx
+			result = tx.Hash().Hex()
+		case "eth_getTransactionReceipt":
+			var hash string
+			_ = json.Unmarshal(request.Params[0], &hash)
+			if mined[hash] != nil {
+				result = map[string]string{"blockNumber": "0x11", "status": "0x1"}
+			}
+		default:
+			t.Error("unexpected method", request.Method)
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": request.ID, "result": result})
+	}))
+	t.Cleanup(server.Close)
+	return server, mined
+}
+
+func TestPublication(t *testing.T) {
+	server, mined := devChain(t)
+
+	key, _ := crypto.HexToECDSA("8f2a55949038a9610f50fb23b5883af3b4ecb3c3bb792cbcefbd1542c692be63")
+	contract := base.HexToAddress("0x0c316b7042b419d07d343f2f4f5bd54ff731183d")
+	cid := "QmUou7zX2g2tY58LP1A2GyP5RF9nbJsoxKTp299ah3svgb"
+
+	pub, err := NewPublication(server.URL, contract, key, "mainnet", cid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sender, err := ethTypes.Sender(ethTypes.LatestSignerForChainID(pub.Tx.ChainId()), pub.Tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sender != crypto.PubkeyToAddress(key.PublicKey) || base.HexToAddress(sender.Hex()) != pub.Publisher {
+		t.Error("unexpected sender", sender.Hex())
+	}
+	if pub.Tx.ChainId().Uint64() != 1337 || pub.Tx.Nonce() != 7 || pub.Tx.Gas() != 46097 || *pub.Tx.To() != contract.Address {
+		t.Error("unexpected transaction", pub.Tx.ChainId(), pub.Tx.Nonce(), pub.Tx.Gas(), pub.Tx.To())
+	}
+	if pub.Tx.GasFeeCap().Uint64() != 5_000_000_000 {
+		t.Error("unexpected fee cap", pub.Tx.GasFeeCap())
+	}
+
+	abi, _ := ethAbi.JSON(strings.NewReader(publishHashAbi))
+	args, err := abi.Methods["publishHash"].Inputs.Unpack(pub.Tx.Data()[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if args[0] != "mainnet" || args[1] != cid {
+		t.Error("unexpected arguments", args)
+	}
+
+	raw, err := pub.RawTransaction()
+	if err != nil || !strings.HasPrefix(raw, "0x02") {
+		t.Error("expected a typed transaction, got", raw, err)
+	}
+
+	if err := pub.Broadcast(server.URL); err != nil {
+		t.Fatal(err)
+	}
+	if mined[pub.Tx.Hash().Hex()] == nil {
+		t.Error("the transaction was not sent")
+	}
+	if bn, err := pub.WaitForReceipt(server.URL, time.Second); err != nil || bn != 17 {
+		t.Error("expected the transaction to be mined in block 17, got", bn, err)
+	}
+}
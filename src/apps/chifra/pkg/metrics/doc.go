@@ -0,0 +1,3 @@
+// Package metrics collects counters and timings from the rest of chifra and serves them in
+// the Prometheus text format (see `chifra daemon`'s /metrics route).
+package metrics
@@ -0,0 +1,196 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "chifra"
+
+var registry = prometheus.NewRegistry()
+
+var (
+	rpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "requests_total",
+		Help:      "Number of JSON-RPC calls sent to the node, by method. Each item in a batch counts once.",
+	}, []string{"chain", "method"})
+
+	rpcErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "errors_total",
+		Help:      "Number of JSON-RPC calls that failed (transport, http or JSON-RPC error), by method.",
+	}, []string{"chain", "method"})
+
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "rpc",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of requests to the node (including retries), by method. Batches are reported as method \"batch\".",
+		Buckets:   prometheus.ExponentialBuckets(0.005, 2, 14),
+	}, []string{"chain", "method"})
+
+	cacheReads = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "reads_total",
+		Help:      "Number of binary cache reads, by item type and result (hit, miss or error).",
+	}, []string{"type", "result"})
+
+	cacheWrites = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "writes_total",
+		Help:      "Number of binary cache writes, by item type and result (ok or error).",
+	}, []string{"type", "result"})
+
+	scraperBlocks = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "scraper",
+		Name:      "blocks",
+		Help:      "Number of ripe and unripe blocks processed by the scraper's most recent pass, and number of blocks in the stage.",
+	}, []string{"chain", "stage"})
+
+	scraperHeight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "scraper",
+		Name:      "height",
+		Help:      "Latest block of the chain and of each stage of the index as seen by the scraper.",
+	}, []string{"chain", "stage"})
+
+	notifyDropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "notify",
+		Name:      "dropped_total",
+		Help:      "Number of notifications dropped from the spool before a sink accepted them, by sink.",
+	}, []string{"chain", "sink"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of requests to the daemon's API, by route, http method and status code.",
+		Buckets:   prometheus.ExponentialBuckets(0.005, 2, 16),
+	}, []string{"route", "method", "code"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		rpcRequests,
+		rpcErrors,
+		rpcDuration,
+		cacheReads,
+		cacheWrites,
+		scraperBlocks,
+		scraperHeight,
+		notifyDropped,
+		httpDuration,
+	)
+}
+
+// Handler returns an http.Handler that serves every metric in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// RpcCall records a single request to the node. methods holds the method of each call in
+// the request (more than one for a batch) and failed holds the methods of the calls that
+// failed. If the whole request failed, failed should equal methods.
+func RpcCall(chain string, methods, failed []string, elapsed time.Duration) {
+	if len(methods) == 0 {
+		return
+	}
+
+	label := methods[0]
+	if len(methods) > 1 {
+		label = "batch"
+	}
+	rpcDuration.WithLabelValues(chain, label).Observe(elapsed.Seconds())
+
+	for _, method := range methods {
+		rpcRequests.WithLabelValues(chain, method).Inc()
+	}
+	for _, method := range failed {
+		rpcErrors.WithLabelValues(chain, method).Inc()
+	}
+}
+
+// CacheResult is the outcome of a cache read or write.
+type CacheResult string
+
+const (
+	CacheHit   CacheResult = "hit"
+	CacheMiss  CacheResult = "miss"
+	CacheOk    CacheResult = "ok"
+	CacheError CacheResult = "error"
+)
+
+// CacheRead records a read from the binary cache of an item of the given type.
+func CacheRead(itemType string, result CacheResult) {
+	cacheReads.WithLabelValues(itemType, string(result)).Inc()
+}
+
+// CacheWrite records a write to the binary cache of an item of the given type.
+func CacheWrite(itemType string, result CacheResult) {
+	cacheWrites.WithLabelValues(itemType, string(result)).Inc()
+}
+
+// ScraperProgress records the state of the scraper after one of its passes.
+type ScraperProgress struct {
+	Ripe      uint64
+	Unripe    uint64
+	Staged    uint64
+	Latest    uint64
+	Finalized uint64
+	Staging   uint64
+	UnripeAt  uint64
+}
+
+// SetScraperProgress records the scraper's progress on the given chain.
+func SetScraperProgress(chain string, p ScraperProgress) {
+	scraperBlocks.WithLabelValues(chain, "ripe").Set(float64(p.Ripe))
+	scraperBlocks.WithLabelValues(chain, "unripe").Set(float64(p.Unripe))
+	scraperBlocks.WithLabelValues(chain, "staged").Set(float64(p.Staged))
+	scraperHeight.WithLabelValues(chain, "latest").Set(float64(p.Latest))
+	scraperHeight.WithLabelValues(chain, "finalized").Set(float64(p.Finalized))
+	scraperHeight.WithLabelValues(chain, "staging").Set(float64(p.Staging))
+	scraperHeight.WithLabelValues(chain, "unripe").Set(float64(p.UnripeAt))
+}
+
+// RegisterMonitorCount registers a gauge that reports the number of monitors on the chain by
+// calling count each time the metrics are scraped.
+func RegisterMonitorCount(chain string, count func() int) {
+	_ = registry.Register(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace:   namespace,
+		Subsystem:   "monitors",
+		Name:        "count",
+		Help:        "Number of address monitors on the chain.",
+		ConstLabels: prometheus.Labels{"chain": chain},
+	}, func() float64 {
+		return float64(count())
+	}))
+}
+
+// NotificationsDropped records that n notifications were dropped from the spool before the sink
+// accepted them.
+func NotificationsDropped(chain, sink string, n int) {
+	notifyDropped.WithLabelValues(chain, sink).Add(float64(n))
+}
+
+// HttpRequest records a request to the daemon's API.
+func HttpRequest(route, method string, code int, elapsed time.Duration) {
+	httpDuration.WithLabelValues(route, method, strconv.Itoa(code)).Observe(elapsed.Seconds())
+}
@@ -0,0 +1,32 @@
+package metrics
+
+import (
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler(t *testing.T) {
+	RpcCall("mainnet", []string{"eth_getBalance", "eth_call"}, []string{"eth_call"}, time.Millisecond)
+	CacheRead("Transaction", CacheMiss)
+	HttpRequest("RouteBlocks", "GET", 200, time.Millisecond)
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body, _ := io.ReadAll(rec.Body)
+
+	expected := []string{
+		`chifra_rpc_requests_total{chain="mainnet",method="eth_getBalance"} 1`,
+		`chifra_rpc_errors_total{chain="mainnet",method="eth_call"} 1`,
+		`chifra_rpc_request_duration_seconds_count{chain="mainnet",method="batch"} 1`,
+		`chifra_cache_reads_total{result="miss",type="Transaction"} 1`,
+		`chifra_http_request_duration_seconds_count{code="200",method="GET",route="RouteBlocks"} 1`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(string(body), e) {
+			t.Error("expected metrics to contain", e)
+		}
+	}
+}
@@ -215,6 +215,19 @@ func ListMonitors(chain, watchList string, monitorChan chan<- Monitor) {
 	_ = filepath.Walk(path, walkFunc)
 }
 
+// CountMonitors returns the number of monitor files (including deleted monitors) on the chain.
+func CountMonitors(chain string) int {
+	count := 0
+	walkFunc := func(path string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() && strings.HasSuffix(path, ".mon.bin") {
+			count++
+		}
+		return nil
+	}
+	_ = filepath.WalkDir(config.PathToCache(chain)+"monitors", walkFunc)
+	return count
+}
+
 var monitorMutex sync.Mutex
 
 // MoveToProduction moves a previously staged monitor to the monitors folder.
@@ -2,12 +2,10 @@ package monitor
 
 import (
 	"fmt"
-	"os"
-	"path"
 	"strings"
 
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/abi"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/decache"
-	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/filter"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
@@ -19,6 +17,17 @@ func (mon *Monitor) Decache(conn *rpc.Connection, showProgress bool) (string, er
 		return "", err
 	} else {
 		if cnt > 0 {
+			// Prices are found through the cached statements, so they must be decached first
+			itemsToRemove, err := decache.LocationsFromStatements(conn, mon.Address, apps)
+			if err != nil {
+				return "", err
+			}
+			if msg, err := decache.Decache(conn, itemsToRemove, showProgress, walk.Cache_Prices); err != nil {
+				return "", err
+			} else {
+				logger.Progress(showProgress, msg)
+			}
+
 			monitorCacheTypes := []walk.CacheType{
 				walk.Cache_Statements,
 				walk.Cache_Traces,
@@ -41,10 +50,8 @@ func (mon *Monitor) Decache(conn *rpc.Connection, showProgress bool) (string, er
 			}
 		}
 
-		abiPath := path.Join(walk.GetRootPathFromCacheType(conn.Chain, walk.Cache_Abis), mon.Address.Hex()+".json")
-		if file.FileExists(abiPath) {
-			os.Remove(abiPath)
-			logger.Progress(showProgress, "Abi "+abiPath+" file removed.")
+		if removed, _ := abi.RemoveAbi(conn.Chain, mon.Address); removed {
+			logger.Progress(showProgress, "Abi file for "+mon.Address.Hex()+" removed.")
 		}
 
 		return mon.RemoveMonitor(), nil
@@ -134,6 +134,23 @@ func (updater *MonitorUpdate) FreshenMonitors(monitorArray *[]Monitor) (bool, er
 		return canceled, err
 	}
 
+	// If the address shards have been built, the chunks they cover need only be visited if they contain
+	// one of the monitored addresses. Other chunks are searched by their bloom filters as usual.
+	var covered, containing map[base.FileRange]bool
+	if index.HasShards(updater.Chain) {
+		addrs := make([]base.Address, 0, len(updater.MonitorMap))
+		for addr := range updater.MonitorMap {
+			addrs = append(addrs, addr)
+		}
+		if covered, err = index.ShardsCovered(updater.Chain); err == nil {
+			containing, err = index.ShardsContaining(updater.Chain, addrs)
+		}
+		if err != nil {
+			logger.Warn("could not read the address shards:", err)
+			covered = nil
+		}
+	}
+
 	var wg sync.WaitGroup
 	resultChannel := make(chan []index.AppearanceResult, len(files))
 
@@ -170,6 +187,13 @@ func (updater *MonitorUpdate) FreshenMonitors(monitorArray *[]Monitor) (bool, er
 				continue
 			}
 
+			// None of the addresses are in this chunk's shards. As with a bloom miss, we note the
+			// range so each monitor's lastScanned moves past it.
+			if covered[fileRange] && !containing[fileRange] {
+				updater.updateMonitors(&index.AppearanceResult{Range: fileRange})
+				continue
+			}
+
 			if taskCount >= updater.MaxTasks {
 				resArray := <-resultChannel
 				for _, r := range resArray {
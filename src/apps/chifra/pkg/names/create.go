@@ -1,8 +1,7 @@
 package names
 
 import (
-	"os"
-
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
 )
@@ -20,24 +19,42 @@ func CreateName(dbType DatabaseType, chain string, name *types.Name) (err error)
 }
 
 func customCreateName(chain string, name *types.Name) (err error) {
-	db, err := openDatabaseFile(chain, DatabaseCustom, os.O_WRONLY|os.O_TRUNC)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-
 	name.IsCustom = true
-	loadedCustomNamesMutex.Lock()
-	defer loadedCustomNamesMutex.Unlock()
-	loadedCustomNames[name.Address] = *name
-	return writeCustomNames(db)
+	return getDatabase(chain, DatabaseCustom).commit(func(names map[base.Address]types.Name) error {
+		names[name.Address] = *name
+		return nil
+	})
 }
 
 func regularCreateName(chain string, name *types.Name) (err error) {
-	loadedRegularNamesMutex.Lock()
-	defer loadedRegularNamesMutex.Unlock()
-
 	name.IsCustom = false
-	loadedRegularNames[name.Address] = *name
+	return getDatabase(chain, DatabaseRegular).edit(func(names map[base.Address]types.Name) error {
+		names[name.Address] = *name
+		return nil
+	})
+}
+
+// CreateNames is like CreateName, but creates all of the names at once
+func CreateNames(dbType DatabaseType, chain string, names []types.Name) (err error) {
+	switch dbType {
+	case DatabaseCustom:
+		return getDatabase(chain, DatabaseCustom).commit(func(all map[base.Address]types.Name) error {
+			for _, name := range names {
+				name.IsCustom = true
+				all[name.Address] = name
+			}
+			return nil
+		})
+	case DatabaseRegular:
+		return getDatabase(chain, DatabaseRegular).edit(func(all map[base.Address]types.Name) error {
+			for _, name := range names {
+				name.IsCustom = false
+				all[name.Address] = name
+			}
+			return nil
+		})
+	default:
+		logger.Fatal("should not happen ==> unknown database type")
+	}
 	return
 }
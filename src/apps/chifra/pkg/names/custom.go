@@ -4,48 +4,23 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"os"
-	"sort"
-	"sync"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
-	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
 )
 
-var loadedCustomNames map[base.Address]types.Name = map[base.Address]types.Name{}
-var loadedCustomNamesMutex sync.Mutex
-
-func loadCustomMap(chain string, terms []string, parts Parts, namesMap *map[base.Address]types.Name) (err error) {
-	if len(loadedCustomNames) != 0 {
-		// We have already loaded the data
-		for _, name := range loadedCustomNames {
-			if doSearch(&name, terms, parts) {
-				(*namesMap)[name.Address] = name
-			}
-		}
-		return
-	}
-	loadedCustomNamesMutex.Lock()
-	defer loadedCustomNamesMutex.Unlock()
-
-	db, err := openDatabaseFile(chain, DatabaseCustom, os.O_RDONLY)
+// loadCustomMap adds the custom names that match the search to found
+func loadCustomMap(chain string, q *query, parts Parts, found *found) error {
+	snap, err := getDatabase(chain, DatabaseCustom).load(parts)
 	if err != nil {
 		return err
 	}
-	defer db.Close()
-
-	loadedCustomNames, err = unmarshallCustomNames(db, terms, parts, namesMap)
-	if err != nil {
-		return err
-	}
-	if parts&Testing != 0 {
-		loadTestNames(terms, parts, &loadedCustomNames, namesMap)
-	}
-	return
+	snap.search(q, found)
+	return nil
 }
 
-func unmarshallCustomNames(source io.Reader, terms []string, parts Parts, namesMap *map[base.Address]types.Name) (customNames map[base.Address]types.Name, err error) {
+// readCustomNames reads the custom names database. The database may be empty.
+func readCustomNames(source io.Reader) (customNames map[base.Address]types.Name, err error) {
 	customNames = map[base.Address]types.Name{}
 
 	var reader NameReader
@@ -70,14 +45,12 @@ func unmarshallCustomNames(source io.Reader, terms []string, parts Parts, namesM
 			return
 		}
 		customNames[name.Address] = name
-		if doSearch(&name, terms, parts) {
-			(*namesMap)[name.Address] = name
-		}
 	}
 	return
 }
 
-func loadTestNames(terms []string, parts Parts, all *map[base.Address]types.Name, namesMap *map[base.Address]types.Name) {
+// loadTestNames adds the names used during testing to the custom names
+func loadTestNames(all map[base.Address]types.Name) {
 	for i := 1; i < 5; i++ {
 		addressStr := fmt.Sprintf("0x%040d", i)
 		num := fmt.Sprintf("%d", i)
@@ -92,48 +65,8 @@ func loadTestNames(terms []string, parts Parts, all *map[base.Address]types.Name
 			Petname:  base.AddrToPetname(addressStr, "-"),
 			IsCustom: true,
 		}
-		if _, ok := (*all)[address]; !ok {
-			(*all)[address] = name
-		}
-		if doSearch(&name, terms, parts) {
-			(*namesMap)[name.Address] = name
-		}
-	}
-}
-
-func writeCustomNames(output *os.File) (err error) {
-	if err = file.Lock(output); err != nil {
-		return
-	}
-	defer func() {
-		_ = file.Unlock(output)
-	}()
-
-	// We don't want to save test names even in test database
-	var testAddresses map[string]bool = map[string]bool{
-		"0x0000000000000000000000000000000000000001": true,
-		"0x0000000000000000000000000000000000000002": true,
-		"0x0000000000000000000000000000000000000003": true,
-		"0x0000000000000000000000000000000000000004": true,
-	}
-
-	sorted := make([]types.Name, 0, len(loadedCustomNames))
-	for _, name := range loadedCustomNames {
-		sorted = append(sorted, name)
-	}
-	sort.Slice(sorted, func(i, j int) bool {
-		return sorted[i].Address.Hex() < sorted[j].Address.Hex()
-	})
-
-	writer := NewNameWriter(output)
-	for _, name := range sorted {
-		if testAddresses[name.Address.Hex()] {
-			continue
-		}
-		if err = writer.Write(&name); err != nil {
-			return
+		if _, ok := all[address]; !ok {
+			all[address] = name
 		}
 	}
-	writer.Flush()
-	return writer.Error()
 }
@@ -3,7 +3,6 @@ package names
 import (
 	"encoding/csv"
 	"errors"
-	"fmt"
 	"io"
 	"os"
 	"path"
@@ -25,19 +24,15 @@ func TestCrudIntegration(t *testing.T) {
 			t.Fatal("os.RemoveAll:", err)
 		}
 	}()
-	loadTestDatabase := func() *os.File {
-		tempFile, err := os.OpenFile(
-			path.Join(os.TempDir(), "trueblocks", "names_custom.tab"),
-			os.O_RDWR|os.O_CREATE|os.O_TRUNC,
-			0777,
-		)
-		if err != nil {
-			t.Fatal(err)
-		}
-		return tempFile
+	dbPath := path.Join(tmpDirPath, "names_custom.tab")
+	if err := os.WriteFile(dbPath, []byte{}, 0666); err != nil {
+		t.Fatal(err)
 	}
-	tempFile := loadTestDatabase()
-	loadedCustomNames = map[base.Address]types.Name{}
+	databasesMutex.Lock()
+	databases[dbKey{chain: chain, kind: DatabaseCustom}] = &database{path: dbPath, kind: DatabaseCustom}
+	databasesMutex.Unlock()
+	defer ClearCache()
+
 	addrStr := "0x1f9090aae28b8a3dceadf281b0f12828e676c326"
 	addr := base.HexToAddress(addrStr)
 	expected := types.Name{
@@ -45,19 +40,20 @@ func TestCrudIntegration(t *testing.T) {
 		Address: addr,
 	}
 
-	expected.IsCustom = true
-	loadedCustomNamesMutex.Lock()
-	loadedCustomNames[expected.Address] = expected
-	loadedCustomNamesMutex.Unlock()
-	if err := writeCustomNames(tempFile); err != nil {
+	// Create
+	if err := CreateName(DatabaseCustom, chain, &expected); err != nil {
 		t.Fatal(err)
 	}
 
-	tempFile.Seek(0, 0)
+	tempFile, err := os.Open(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
 	r := csv.NewReader(tempFile)
 	r.Comma = '\t'
 
 	result, err := r.ReadAll()
+	tempFile.Close()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -80,13 +76,16 @@ func TestCrudIntegration(t *testing.T) {
 	}
 
 	// Update
-	updated, err := setIfExists(tempFile, &types.Name{
-		Name:    "new name",
-		Address: addr,
-	})
-	if err != nil {
+	if err := UpdateName(DatabaseCustom, chain, &types.Name{Name: "new name", Address: addr}); err != nil {
 		t.Fatal("update:", err)
 	}
+	if name := ReadName(DatabaseCustom, chain, addr).Name; name != "test name" {
+		t.Fatal("update: readers should not see the update until it is written", name)
+	}
+	if err := WriteNames(DatabaseCustom, chain, false); err != nil {
+		t.Fatal("update: write:", err)
+	}
+	updated := ReadName(DatabaseCustom, chain, addr)
 	if name := updated.Name; name != "new name" {
 		t.Fatal("wrong name", name)
 	}
@@ -95,7 +94,7 @@ func TestCrudIntegration(t *testing.T) {
 	}
 
 	// Delete
-	deleted, err := changeDeleted(tempFile, addr, true)
+	deleted, err := SetDeleted(DatabaseCustom, chain, addr, true)
 	if err != nil {
 		t.Fatal("delete:", err)
 	}
@@ -107,7 +106,7 @@ func TestCrudIntegration(t *testing.T) {
 	}
 
 	// Undelete
-	undeleted, err := changeDeleted(tempFile, addr, false)
+	undeleted, err := SetDeleted(DatabaseCustom, chain, addr, false)
 	if err != nil {
 		t.Fatal("undelete:", err)
 	}
@@ -127,11 +126,11 @@ func TestCrudIntegration(t *testing.T) {
 
 	// Remove
 	// Set flag first
-	_, err = changeDeleted(tempFile, addr, true)
+	_, err = SetDeleted(DatabaseCustom, chain, addr, true)
 	if err != nil {
 		t.Fatal("remove: delete:", err)
 	}
-	removed, err := removeIfExists(tempFile, addr)
+	removed, err := RemoveName(DatabaseCustom, chain, addr)
 	if err != nil {
 		t.Fatal("remove:", err)
 	}
@@ -143,36 +142,16 @@ func TestCrudIntegration(t *testing.T) {
 	}
 
 	// Check what was written to the file
-	tempFile = loadTestDatabase()
-	testDb, err := unmarshallCustomNames(tempFile, nil, Parts(Custom), &map[base.Address]types.Name{})
+	tempFile, err = os.Open(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tempFile.Close()
+	testDb, err := readCustomNames(tempFile)
 	if err != nil && !errors.Is(err, io.EOF) {
-		t.Fatal("remove: unmarshallCustomNames:", err)
+		t.Fatal("remove: readCustomNames:", err)
 	}
 	if _, ok := testDb[addr]; ok {
 		t.Fatal("record was removed, but it is still present")
 	}
 }
-
-func setIfExists(output *os.File, name *types.Name) (result *types.Name, err error) {
-	if _, ok := loadedCustomNames[name.Address]; !ok {
-		return nil, fmt.Errorf("no custom name for address %s", name.Address.Hex())
-	}
-
-	name.IsCustom = true
-	loadedCustomNamesMutex.Lock()
-	defer loadedCustomNamesMutex.Unlock()
-	loadedCustomNames[name.Address] = *name
-	return name, writeCustomNames(output)
-}
-
-func removeIfExists(output *os.File, address base.Address) (name *types.Name, err error) {
-	found, ok := loadedCustomNames[address]
-	if !ok {
-		return nil, fmt.Errorf("no custom name for address %s", address.Hex())
-	}
-	loadedCustomNamesMutex.Lock()
-	defer loadedCustomNamesMutex.Unlock()
-
-	delete(loadedCustomNames, address)
-	return &found, writeCustomNames(output)
-}
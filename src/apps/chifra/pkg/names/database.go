@@ -0,0 +1,231 @@
+package names
+
+import (
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// Each chain's names databases are kept in memory once they are loaded. Readers share a snapshot of a
+// database that is never modified, so a long running process (chifra daemon, for example) serving many
+// chains while names are being edited always sees a consistent view of each chain's names. Edits are
+// made to a copy of the snapshot, which replaces it once the edits are saved. A database is reloaded
+// before it is read if its file has changed (for example, in another process) since it was loaded.
+
+// dbKey identifies a database in memory
+type dbKey struct {
+	chain string
+	kind  DatabaseType
+}
+
+var databases = map[dbKey]*database{}
+var databasesMutex sync.Mutex
+
+// database is the in-memory copy of one of a chain's names files
+type database struct {
+	path    string
+	kind    DatabaseType
+	mutex   sync.Mutex // serializes loads, edits, and saves
+	current atomic.Pointer[snapshot]
+	staged  map[base.Address]types.Name // edits that have not been saved, if any
+}
+
+// snapshot is the content of a database at the time it was loaded or saved
+type snapshot struct {
+	names     map[base.Address]types.Name
+	modTime   time.Time
+	size      int64
+	indexOnce sync.Once
+	idx       *nameIndex // built the first time the snapshot is searched
+}
+
+// getDatabase returns the chain's database of the given kind
+func getDatabase(chain string, kind DatabaseType) *database {
+	databasesMutex.Lock()
+	defer databasesMutex.Unlock()
+
+	key := dbKey{chain: chain, kind: kind}
+	if db, ok := databases[key]; ok {
+		return db
+	}
+	db := &database{path: databasePath(chain, kind), kind: kind}
+	databases[key] = db
+	return db
+}
+
+// load returns the current snapshot of the database, reading the file if the database has not been
+// loaded or the file has changed since it was. The file is not re-read while there are unsaved edits.
+// If a changed file cannot be read (for example, it is being edited by hand), the names loaded before
+// are kept until the file changes again.
+func (db *database) load(parts Parts) (*snapshot, error) {
+	if snap := db.current.Load(); snap != nil && !db.changed(snap) {
+		return snap, nil
+	}
+
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+	return db.loadLocked(parts)
+}
+
+func (db *database) loadLocked(parts Parts) (*snapshot, error) {
+	snap := db.current.Load()
+	if snap != nil && (db.staged != nil || !db.changed(snap)) {
+		return snap, nil
+	}
+
+	info, _ := os.Stat(db.path)
+	names, err := db.read(parts)
+	if err != nil {
+		if snap == nil {
+			return nil, err
+		}
+		logger.Warn("Could not read", db.path, "so the names read before are kept:", err)
+		names = snap.names
+	}
+
+	snap = &snapshot{names: names}
+	if info != nil {
+		snap.modTime, snap.size = info.ModTime(), info.Size()
+	}
+	db.current.Store(snap)
+	return snap, nil
+}
+
+// read reads the names in the database's file
+func (db *database) read(parts Parts) (map[base.Address]types.Name, error) {
+	input, err := openDatabasePath(db.path, db.kind, os.O_RDONLY)
+	if err != nil {
+		return nil, err
+	}
+	defer input.Close()
+
+	if db.kind == DatabaseCustom {
+		names, err := readCustomNames(input)
+		if err != nil {
+			return nil, err
+		}
+		if parts&Testing != 0 {
+			loadTestNames(names)
+		}
+		return names, nil
+	}
+	return readRegularNames(input)
+}
+
+// changed returns true if the database's file has changed since the snapshot was taken
+func (db *database) changed(snap *snapshot) bool {
+	info, err := os.Stat(db.path)
+	if err != nil {
+		return false
+	}
+	return !info.ModTime().Equal(snap.modTime) || info.Size() != snap.size
+}
+
+// find returns the name of the address in the current snapshot of the database, if any
+func (db *database) find(address base.Address) *types.Name {
+	snap, err := db.load(None)
+	if err != nil {
+		return nil
+	}
+	if found, ok := snap.names[address]; ok {
+		return &found
+	}
+	return nil
+}
+
+// staging returns the names to which edits are made, copying the current snapshot if there are no
+// unsaved edits. The caller must hold the mutex.
+func (db *database) staging() (map[base.Address]types.Name, error) {
+	if db.staged != nil {
+		return db.staged, nil
+	}
+	snap, err := db.loadLocked(None)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[base.Address]types.Name, len(snap.names)+1)
+	for addr, name := range snap.names {
+		names[addr] = name
+	}
+	return names, nil
+}
+
+// edit applies the change to the database. Readers do not see the edit until the database is saved.
+func (db *database) edit(change func(names map[base.Address]types.Name) error) error {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	names, err := db.staging()
+	if err != nil {
+		return err
+	}
+	if err = change(names); err != nil {
+		return err
+	}
+	db.staged = names
+	return nil
+}
+
+// commit applies the change to the database and saves it
+func (db *database) commit(change func(names map[base.Address]types.Name) error) error {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	names, err := db.staging()
+	if err != nil {
+		return err
+	}
+	if err = change(names); err != nil {
+		return err
+	}
+	return db.saveLocked(names)
+}
+
+// save writes the database, including any unsaved edits, to its file or, if dryRun is true, to the
+// screen. A dry run discards the unsaved edits.
+func (db *database) save(dryRun bool) error {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	names := db.staged
+	if names == nil {
+		snap := db.current.Load()
+		if snap == nil {
+			return nil // nothing was loaded, so there is nothing to write
+		}
+		names = snap.names
+	}
+
+	if dryRun {
+		db.staged = nil
+		return writeDatabase(os.Stdout, db.kind, names, false /* lock */)
+	}
+	return db.saveLocked(names)
+}
+
+// saveLocked writes the names to the database's file and makes them the current snapshot. The caller
+// must hold the mutex.
+func (db *database) saveLocked(names map[base.Address]types.Name) error {
+	output, err := openDatabasePath(db.path, db.kind, os.O_RDWR|os.O_TRUNC)
+	if err != nil {
+		return err
+	}
+	err = writeDatabase(output, db.kind, names, true /* lock */)
+	output.Close()
+	if err != nil {
+		return err
+	}
+
+	snap := &snapshot{names: names}
+	if info, err := os.Stat(db.path); err == nil {
+		snap.modTime, snap.size = info.ModTime(), info.Size()
+	}
+	db.current.Store(snap)
+	db.staged = nil
+	return nil
+}
@@ -0,0 +1,143 @@
+package names
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+func writeTestDatabase(t *testing.T, path string, modTime time.Time, names ...types.Name) {
+	output, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer output.Close()
+
+	writer := NewNameWriter(output)
+	for _, name := range names {
+		if err := writer.Write(&name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writer.Flush()
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func useTestDatabase(t *testing.T, chain string, kind DatabaseType, path string) *database {
+	db := &database{path: path, kind: kind}
+	databasesMutex.Lock()
+	databases[dbKey{chain: chain, kind: kind}] = db
+	databasesMutex.Unlock()
+	t.Cleanup(ClearCache)
+	return db
+}
+
+var (
+	name1 = types.Name{Address: base.HexToAddress("0x1f9090aae28b8a3dceadf281b0f12828e676c326"), Name: "one", Tags: "31-Contracts"}
+	name2 = types.Name{Address: base.HexToAddress("0x000000000000000000000000000000000000dead"), Name: "two", Tags: "55-Defi"}
+)
+
+func TestDatabasePerChain(t *testing.T) {
+	dir := t.TempDir()
+	then := time.Now().Add(-time.Hour)
+	writeTestDatabase(t, filepath.Join(dir, "a.tab"), then, name1)
+	writeTestDatabase(t, filepath.Join(dir, "b.tab"), then, name2)
+	useTestDatabase(t, "chain-a", DatabaseCustom, filepath.Join(dir, "a.tab"))
+	useTestDatabase(t, "chain-b", DatabaseCustom, filepath.Join(dir, "b.tab"))
+
+	for chain, expected := range map[string]types.Name{"chain-a": name1, "chain-b": name2} {
+		found, err := LoadNamesMap(chain, Custom, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(found) != 1 || found[expected.Address].Name != expected.Name {
+			t.Errorf("%s: expected only %s, got %v", chain, expected.Name, found)
+		}
+	}
+}
+
+func TestDatabaseReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "names.tab")
+	writeTestDatabase(t, path, time.Now().Add(-time.Hour), name1)
+	db := useTestDatabase(t, "chain-a", DatabaseRegular, path)
+
+	before, err := db.load(None)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(before.names) != 1 {
+		t.Fatal("expected one name, got", len(before.names))
+	}
+
+	// Another process changes the file
+	writeTestDatabase(t, path, time.Now(), name1, name2)
+	if name := ReadName(DatabaseRegular, "chain-a", name2.Address); name == nil || name.Name != "two" {
+		t.Error("expected the changed file to be reloaded, got", name)
+	}
+	if len(before.names) != 1 {
+		t.Error("a snapshot should never change")
+	}
+}
+
+func TestDatabaseReloadBadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "names.tab")
+	writeTestDatabase(t, path, time.Now().Add(-time.Hour), name1)
+	db := useTestDatabase(t, "chain-a", DatabaseRegular, path)
+	if _, err := db.load(None); err != nil {
+		t.Fatal(err)
+	}
+
+	// The file is saved half edited
+	if err := os.WriteFile(path, []byte("tags\taddress\tname\n31-Contracts\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if name := ReadName(DatabaseRegular, "chain-a", name1.Address); name == nil || name.Name != "one" {
+		t.Error("expected the names read before to be kept, got", name)
+	}
+
+	// ...and then fixed
+	writeTestDatabase(t, path, time.Now().Add(time.Minute), name2)
+	if name := ReadName(DatabaseRegular, "chain-a", name2.Address); name == nil || name.Name != "two" {
+		t.Error("expected the fixed file to be reloaded, got", name)
+	}
+}
+
+func TestDatabaseEdits(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "names.tab")
+	writeTestDatabase(t, path, time.Now().Add(-time.Hour), name1)
+	useTestDatabase(t, "chain-a", DatabaseRegular, path)
+	if _, err := LoadNamesMap("chain-a", Regular, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	edited := name1
+	edited.Name = "edited"
+	if err := UpdateName(DatabaseRegular, "chain-a", &edited); err != nil {
+		t.Fatal(err)
+	}
+	if err := UpdateName(DatabaseRegular, "chain-a", &name2); err == nil {
+		t.Error("expected an error updating a name that does not exist")
+	}
+	if name := ReadName(DatabaseRegular, "chain-a", name1.Address); name.Name != "one" {
+		t.Error("readers should not see edits until they are written, got", name.Name)
+	}
+
+	if err := WriteNames(DatabaseRegular, "chain-a", false); err != nil {
+		t.Fatal(err)
+	}
+	if name := ReadName(DatabaseRegular, "chain-a", name1.Address); name.Name != "edited" {
+		t.Error("expected the edit once written, got", name.Name)
+	}
+
+	ClearCache()
+	useTestDatabase(t, "chain-a", DatabaseRegular, path)
+	if name := ReadName(DatabaseRegular, "chain-a", name1.Address); name == nil || name.Name != "edited" {
+		t.Error("expected the edit to be written to the file, got", name)
+	}
+}
@@ -2,7 +2,6 @@ package names
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
@@ -23,25 +22,23 @@ func SetDeleted(dbType DatabaseType, chain string, address base.Address, deleted
 }
 
 func customSetDeleted(chain string, address base.Address, deleted bool) (name *types.Name, err error) {
-	db, err := openDatabaseFile(chain, DatabaseCustom, os.O_WRONLY|os.O_TRUNC)
-	if err != nil {
-		return
-	}
-	defer db.Close()
-
-	return changeDeleted(db, address, deleted)
+	return changeDeleted(getDatabase(chain, DatabaseCustom), address, deleted)
 }
 
-func changeDeleted(output *os.File, address base.Address, deleted bool) (*types.Name, error) {
-	name, ok := loadedCustomNames[address]
-	if !ok {
-		return nil, fmt.Errorf("no custom name for address %s", address.Hex())
+func changeDeleted(db *database, address base.Address, deleted bool) (*types.Name, error) {
+	var name types.Name
+	err := db.commit(func(names map[base.Address]types.Name) error {
+		var ok bool
+		if name, ok = names[address]; !ok {
+			return fmt.Errorf("no custom name for address %s", address.Hex())
+		}
+		name.Deleted = deleted
+		name.IsCustom = true
+		names[name.Address] = name
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
-	name.Deleted = deleted
-	name.IsCustom = true
-	loadedCustomNamesMutex.Lock()
-	defer loadedCustomNamesMutex.Unlock()
-	loadedCustomNames[name.Address] = name
-	return &name, writeCustomNames(output)
+	return &name, nil
 }
@@ -0,0 +1,303 @@
+package names
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Names are exchanged with other teams in csv, tsv, or json files, or in bundles, which are json files
+// signed by the team that made them. The format of a file is given by its extension.
+
+type ExchangeFormat string
+
+const (
+	ExchangeCsv    ExchangeFormat = "csv"
+	ExchangeTsv    ExchangeFormat = "tsv"
+	ExchangeJson   ExchangeFormat = "json"
+	ExchangeBundle ExchangeFormat = "bundle"
+)
+
+// ExchangeFormatFromPath returns the format of the names file given its extension
+func ExchangeFormatFromPath(path string) (ExchangeFormat, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return ExchangeCsv, nil
+	case ".tsv", ".tab":
+		return ExchangeTsv, nil
+	case ".json":
+		return ExchangeJson, nil
+	case ".bundle":
+		return ExchangeBundle, nil
+	}
+	return "", fmt.Errorf("unknown format for names file %s (the extension must be .csv, .tsv, .json, or .bundle)", path)
+}
+
+// Exchange is the content of a names file
+type Exchange struct {
+	Names  []types.Name
+	Chain  string       // for bundles, the chain the names are for
+	Signer base.Address // for bundles, the address that signed the names
+}
+
+// exchangeName is a name as it appears in json files and bundles
+type exchangeName struct {
+	Address    base.Address `json:"address"`
+	Name       string       `json:"name"`
+	Symbol     string       `json:"symbol,omitempty"`
+	Tags       string       `json:"tags,omitempty"`
+	Source     string       `json:"source,omitempty"`
+	Decimals   uint64       `json:"decimals,omitempty"`
+	IsContract bool         `json:"isContract,omitempty"`
+	IsErc20    bool         `json:"isErc20,omitempty"`
+	IsErc721   bool         `json:"isErc721,omitempty"`
+}
+
+func toExchangeNames(names []types.Name) []exchangeName {
+	ret := make([]exchangeName, 0, len(names))
+	for _, name := range names {
+		ret = append(ret, exchangeName{
+			Address:    name.Address,
+			Name:       name.Name,
+			Symbol:     name.Symbol,
+			Tags:       name.Tags,
+			Source:     name.Source,
+			Decimals:   name.Decimals,
+			IsContract: name.IsContract,
+			IsErc20:    name.IsErc20,
+			IsErc721:   name.IsErc721,
+		})
+	}
+	return ret
+}
+
+func fromExchangeNames(names []exchangeName) []types.Name {
+	ret := make([]types.Name, 0, len(names))
+	for _, name := range names {
+		ret = append(ret, types.Name{
+			Address:    name.Address,
+			Name:       name.Name,
+			Symbol:     name.Symbol,
+			Tags:       name.Tags,
+			Source:     name.Source,
+			Decimals:   name.Decimals,
+			IsContract: name.IsContract,
+			IsErc20:    name.IsErc20,
+			IsErc721:   name.IsErc721,
+		})
+	}
+	return ret
+}
+
+// Bundle is a set of names signed by the team that made it. The signature covers everything but the
+// signer, which is recovered from the signature.
+type Bundle struct {
+	Version   int             `json:"version"`
+	Chain     string          `json:"chain"`
+	Timestamp base.Timestamp  `json:"timestamp"`
+	Signer    base.Address    `json:"signer"`
+	Names     json.RawMessage `json:"names"`
+	Signature string          `json:"signature"`
+}
+
+const bundleVersion = 1
+
+var ErrBadSignature = errors.New("the bundle's signature does not match its signer")
+var ErrUntrustedSigner = errors.New("the bundle's signer is not trusted")
+
+// NewBundle returns the names signed with the key
+func NewBundle(chain string, names []types.Name, key *ecdsa.PrivateKey, ts base.Timestamp) (*Bundle, error) {
+	raw, err := json.Marshal(toExchangeNames(names))
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &Bundle{
+		Version:   bundleVersion,
+		Chain:     chain,
+		Timestamp: ts,
+		Signer:    base.Address{Address: crypto.PubkeyToAddress(key.PublicKey)},
+		Names:     raw,
+	}
+	digest, err := bundle.digest()
+	if err != nil {
+		return nil, err
+	}
+	sig, err := crypto.Sign(digest, key)
+	if err != nil {
+		return nil, err
+	}
+	bundle.Signature = hexutil.Encode(sig)
+	return bundle, nil
+}
+
+// digest returns the hash that is signed. Like a message signed by a wallet (EIP-191), the hash is
+// prefixed so that a signed bundle cannot be mistaken for a signed transaction.
+func (b *Bundle) digest() ([]byte, error) {
+	// The names are hashed in compact form so that reformatting the file does not invalidate the signature
+	var names bytes.Buffer
+	if err := json.Compact(&names, b.Names); err != nil {
+		return nil, err
+	}
+	header := fmt.Sprintf("%d\n%s\n%d\n", b.Version, b.Chain, b.Timestamp)
+	return accounts.TextHash(crypto.Keccak256([]byte(header), names.Bytes())), nil
+}
+
+// Verify returns an error if the bundle was not signed by its signer
+func (b *Bundle) Verify() error {
+	if b.Version != bundleVersion {
+		return fmt.Errorf("unsupported bundle version %d", b.Version)
+	}
+	sig, err := hexutil.Decode(b.Signature)
+	if err != nil || len(sig) != crypto.SignatureLength {
+		return ErrBadSignature
+	}
+	digest, err := b.digest()
+	if err != nil {
+		return err
+	}
+	pub, err := crypto.SigToPub(digest, sig)
+	if err != nil {
+		return ErrBadSignature
+	}
+	if base.HexToAddress(crypto.PubkeyToAddress(*pub).Hex()) != b.Signer {
+		return ErrBadSignature
+	}
+	return nil
+}
+
+// ReadExchange reads a names file. A bundle's signature is verified and its signer must be one of the
+// trusted signers. A valid signature only proves who made the bundle, not that its names are right.
+func ReadExchange(source io.Reader, format ExchangeFormat, trusted []base.Address) (*Exchange, error) {
+	ret := &Exchange{}
+	switch format {
+	case ExchangeCsv, ExchangeTsv:
+		mode := NameReaderComma
+		if format == ExchangeTsv {
+			mode = NameReaderTab
+		}
+		reader, err := newNameReader(source, mode, []string{"address", "name"})
+		if err != nil {
+			return nil, err
+		}
+		for {
+			name, err := reader.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			ret.Names = append(ret.Names, name)
+		}
+
+	case ExchangeJson:
+		// Either an array of names or the output of chifra names
+		contents, err := io.ReadAll(source)
+		if err != nil {
+			return nil, err
+		}
+		var names []exchangeName
+		if bytes.HasPrefix(bytes.TrimSpace(contents), []byte("[")) {
+			err = json.Unmarshal(contents, &names)
+		} else {
+			data := struct {
+				Data []exchangeName `json:"data"`
+			}{}
+			err = json.Unmarshal(contents, &data)
+			names = data.Data
+		}
+		if err != nil {
+			return nil, err
+		}
+		ret.Names = fromExchangeNames(names)
+
+	case ExchangeBundle:
+		var bundle Bundle
+		if err := json.NewDecoder(source).Decode(&bundle); err != nil {
+			return nil, err
+		}
+		if err := bundle.Verify(); err != nil {
+			return nil, err
+		}
+		if !isTrusted(bundle.Signer, trusted) {
+			return nil, fmt.Errorf("%w: %s", ErrUntrustedSigner, bundle.Signer.Hex())
+		}
+		var names []exchangeName
+		if err := json.Unmarshal(bundle.Names, &names); err != nil {
+			return nil, err
+		}
+		ret.Names = fromExchangeNames(names)
+		ret.Chain = bundle.Chain
+		ret.Signer = bundle.Signer
+
+	default:
+		return nil, fmt.Errorf("unknown names file format %s", format)
+	}
+
+	for i := range ret.Names {
+		if ret.Names[i].Address.IsZero() {
+			return nil, fmt.Errorf("name %d (%s) has an invalid address", i+1, ret.Names[i].Name)
+		}
+		ret.Names[i].Petname = base.AddrToPetname(ret.Names[i].Address.Hex(), "-")
+	}
+	return ret, nil
+}
+
+func isTrusted(signer base.Address, trusted []base.Address) bool {
+	for _, t := range trusted {
+		if t == signer {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteExchange writes the names to a names file. Bundles are signed with the key, which is not used
+// otherwise.
+func WriteExchange(dest io.Writer, format ExchangeFormat, chain string, names []types.Name, key *ecdsa.PrivateKey) error {
+	switch format {
+	case ExchangeCsv, ExchangeTsv:
+		writer := NewNameWriter(dest)
+		if format == ExchangeCsv {
+			writer.setFormat(NameWriterCsv)
+		}
+		for _, name := range names {
+			if err := writer.Write(&name); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+
+	case ExchangeJson:
+		encoder := json.NewEncoder(dest)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(toExchangeNames(names))
+
+	case ExchangeBundle:
+		if key == nil {
+			return errors.New("a key is required to sign a bundle")
+		}
+		bundle, err := NewBundle(chain, names, key, base.Timestamp(time.Now().Unix()))
+		if err != nil {
+			return err
+		}
+		encoder := json.NewEncoder(dest)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(bundle)
+	}
+	return fmt.Errorf("unknown names file format %s", format)
+}
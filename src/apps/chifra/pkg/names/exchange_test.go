@@ -0,0 +1,131 @@
+package names
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var exchangedNames = []types.Name{
+	{Address: base.HexToAddress("0x28c6c06298d514db089934071355e5743bf21d60"), Name: "Binance 14", Tags: "90-Exchanges:Binance", Source: "EtherScan.io"},
+	{Address: base.HexToAddress("0xdac17f958d2ee523a2206206994597c13d831ec7"), Name: "Tether USD", Symbol: "USDT", Tags: "50-Tokens:ERC20", Decimals: 6, IsContract: true, IsErc20: true},
+}
+
+func withPetnames(names []types.Name) []types.Name {
+	ret := make([]types.Name, 0, len(names))
+	for _, name := range names {
+		name.Petname = base.AddrToPetname(name.Address.Hex(), "-")
+		ret = append(ret, name)
+	}
+	return ret
+}
+
+func TestExchangeRoundTrip(t *testing.T) {
+	key, _ := crypto.HexToECDSA("8f2a55949038a9610f50fb23b5883af3b4ecb3c3bb792cbcefbd1542c692be63")
+	signer := base.HexToAddress(crypto.PubkeyToAddress(key.PublicKey).Hex())
+	for _, format := range []ExchangeFormat{ExchangeCsv, ExchangeTsv, ExchangeJson, ExchangeBundle} {
+		var buf bytes.Buffer
+		if err := WriteExchange(&buf, format, "mainnet", exchangedNames, key); err != nil {
+			t.Fatal(format, err)
+		}
+		exchange, err := ReadExchange(&buf, format, []base.Address{signer})
+		if err != nil {
+			t.Fatal(format, err)
+		}
+		if !reflect.DeepEqual(exchange.Names, withPetnames(exchangedNames)) {
+			t.Errorf("%s: expected %v, got %v", format, exchangedNames, exchange.Names)
+		}
+		if format == ExchangeBundle {
+			if exchange.Signer != signer || exchange.Chain != "mainnet" {
+				t.Error("unexpected signer or chain", exchange.Signer, exchange.Chain)
+			}
+		}
+	}
+}
+
+func TestExchangeUntrustedSigner(t *testing.T) {
+	key, _ := crypto.HexToECDSA("8f2a55949038a9610f50fb23b5883af3b4ecb3c3bb792cbcefbd1542c692be63")
+	var buf bytes.Buffer
+	if err := WriteExchange(&buf, ExchangeBundle, "mainnet", exchangedNames, key); err != nil {
+		t.Fatal(err)
+	}
+	other := base.HexToAddress("0x000000000000000000000000000000000000dead")
+	if _, err := ReadExchange(&buf, ExchangeBundle, []base.Address{other}); !errors.Is(err, ErrUntrustedSigner) {
+		t.Error("expected an untrusted signer, got", err)
+	}
+}
+
+func TestExchangeBundleSignature(t *testing.T) {
+	key, _ := crypto.HexToECDSA("8f2a55949038a9610f50fb23b5883af3b4ecb3c3bb792cbcefbd1542c692be63")
+	bundle, err := NewBundle("mainnet", exchangedNames, key, 1700000000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Reformatting the names does not invalidate the signature...
+	var indented bytes.Buffer
+	_ = json.Indent(&indented, bundle.Names, "", "    ")
+	reformatted := *bundle
+	reformatted.Names = indented.Bytes()
+	if err := reformatted.Verify(); err != nil {
+		t.Error("expected a reformatted bundle to verify, got", err)
+	}
+
+	// ...but changing them, the chain, or the signer does
+	tampered := *bundle
+	tampered.Names = []byte(strings.Replace(string(bundle.Names), "Binance 14", "Binance 15", 1))
+	otherChain := *bundle
+	otherChain.Chain = "gnosis"
+	otherSigner := *bundle
+	otherSigner.Signer = base.HexToAddress("0x000000000000000000000000000000000000dead")
+	for _, b := range []Bundle{tampered, otherChain, otherSigner} {
+		if err := b.Verify(); err != ErrBadSignature {
+			t.Error("expected a bad signature, got", err)
+		}
+	}
+}
+
+func TestExchangeRead(t *testing.T) {
+	csv := "address,name,tags\n0x28c6c06298d514db089934071355e5743bf21d60,Binance 14,90-Exchanges\n"
+	exchange, err := ReadExchange(strings.NewReader(csv), ExchangeCsv, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exchange.Names) != 1 || exchange.Names[0].Name != "Binance 14" || exchange.Names[0].Tags != "90-Exchanges" {
+		t.Error("unexpected names", exchange.Names)
+	}
+
+	if _, err := ReadExchange(strings.NewReader("address,tags\n0x28c6c06298d514db089934071355e5743bf21d60,90-Exchanges\n"), ExchangeCsv, nil); err == nil {
+		t.Error("expected an error for a missing name column")
+	}
+	if _, err := ReadExchange(strings.NewReader("address,name\nnot-an-address,Nobody\n"), ExchangeCsv, nil); err == nil {
+		t.Error("expected an error for an invalid address")
+	}
+
+	output := `{ "data": [ { "address": "0x28c6c06298d514db089934071355e5743bf21d60", "name": "Binance 14", "prefund": "0" } ] }`
+	if exchange, err = ReadExchange(strings.NewReader(output), ExchangeJson, nil); err != nil || len(exchange.Names) != 1 {
+		t.Error("expected to read the output of chifra names, got", exchange, err)
+	}
+}
+
+func TestExchangeFormatFromPath(t *testing.T) {
+	for path, expected := range map[string]ExchangeFormat{
+		"names.csv":          ExchangeCsv,
+		"names_custom.tab":   ExchangeTsv,
+		"/tmp/Exchanges.TSV": ExchangeTsv,
+		"labels.json":        ExchangeJson,
+		"partner.bundle":     ExchangeBundle,
+		"names.txt":          "",
+	} {
+		if got, _ := ExchangeFormatFromPath(path); got != expected {
+			t.Errorf("%s: expected %q, got %q", path, expected, got)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package names
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// nameIndex is an inverted index of the words in each searchable field of a snapshot's names. It is
+// used to find the few names that may match a search without examining all of them. Because snapshots
+// never change, neither does the index, so it is built once, the first time the snapshot is searched.
+type nameIndex struct {
+	docs     []*document
+	postings [nFields]map[string][]int32 // the documents containing each word of each field
+	vocab    [nFields][]string           // the words of each field, sorted
+}
+
+func newNameIndex(names map[base.Address]types.Name) *nameIndex {
+	idx := &nameIndex{docs: make([]*document, 0, len(names))}
+	for _, name := range names {
+		idx.docs = append(idx.docs, newDocument(&name))
+	}
+	sort.Slice(idx.docs, func(i, j int) bool {
+		return idx.docs[i].values[fieldAddress] < idx.docs[j].values[fieldAddress]
+	})
+
+	for f := field(0); f < nFields; f++ {
+		idx.postings[f] = map[string][]int32{}
+		for i, doc := range idx.docs {
+			for _, token := range doc.tokens[f] {
+				list := idx.postings[f][token]
+				if len(list) == 0 || list[len(list)-1] != int32(i) {
+					idx.postings[f][token] = append(list, int32(i))
+				}
+			}
+		}
+		idx.vocab[f] = make([]string, 0, len(idx.postings[f]))
+		for token := range idx.postings[f] {
+			idx.vocab[f] = append(idx.vocab[f], token)
+		}
+		sort.Strings(idx.vocab[f])
+	}
+	return idx
+}
+
+// index returns the snapshot's index, building it if needed
+func (snap *snapshot) index() *nameIndex {
+	snap.indexOnce.Do(func() {
+		snap.idx = newNameIndex(snap.names)
+	})
+	return snap.idx
+}
+
+// search adds the snapshot's names that match the query to found
+func (snap *snapshot) search(q *query, found *found) {
+	if len(q.clauses) == 0 {
+		for _, name := range snap.names {
+			found.add(name, 0)
+		}
+		return
+	}
+
+	idx := snap.index()
+	for _, i := range idx.candidates(q) {
+		if score, ok := q.score(idx.docs[i]); ok {
+			found.add(idx.docs[i].name, score)
+		}
+	}
+}
+
+// candidates returns the documents that may match the query. Each clause that can be answered from the
+// index narrows the candidates. The candidates must still be scored to see if they match.
+func (idx *nameIndex) candidates(q *query) []int32 {
+	var ret []int32
+	narrowed := false
+	for i := range q.clauses {
+		docs, ok := idx.lookup(&q.clauses[i])
+		if !ok {
+			continue
+		}
+		if !narrowed {
+			ret, narrowed = docs, true
+		} else {
+			ret = intersect(ret, docs)
+		}
+		if len(ret) == 0 {
+			return ret
+		}
+	}
+
+	if !narrowed {
+		ret = make([]int32, len(idx.docs))
+		for i := range ret {
+			ret[i] = int32(i)
+		}
+	}
+	return ret
+}
+
+// lookup returns the documents that contain a word matching the clause in one of its fields, or false if
+// the clause cannot be answered from the index
+func (idx *nameIndex) lookup(c *clause) ([]int32, bool) {
+	var matches func(token string) bool
+	text := strings.ToLower(c.text)
+
+	switch c.kind {
+	case matchPrefix:
+		// handled below with a binary search of the vocabulary
+	case matchFuzzy:
+		matches = func(token string) bool {
+			return editDistance(text, token, c.distance) >= 0
+		}
+	case matchContains:
+		// A term that is a single word can only appear inside of a single word of the field
+		if len(text) == 0 || strings.IndexFunc(text, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) >= 0 {
+			return nil, false
+		}
+		matches = func(token string) bool {
+			return strings.Contains(token, text)
+		}
+	default:
+		return nil, false
+	}
+
+	// Mark the documents containing a matching word, then list them in order
+	marked := make([]bool, len(idx.docs))
+	mark := func(f field, token string) {
+		for _, i := range idx.postings[f][token] {
+			marked[i] = true
+		}
+	}
+	for _, f := range c.fields {
+		vocab := idx.vocab[f]
+		if c.kind == matchPrefix {
+			for k := sort.SearchStrings(vocab, text); k < len(vocab) && strings.HasPrefix(vocab[k], text); k++ {
+				mark(f, vocab[k])
+			}
+			continue
+		}
+		for _, token := range vocab {
+			if matches(token) {
+				mark(f, token)
+			}
+		}
+	}
+
+	ret := []int32{}
+	for i, ok := range marked {
+		if ok {
+			ret = append(ret, int32(i))
+		}
+	}
+	return ret, true
+}
+
+// intersect returns the documents in both sorted lists
+func intersect(a, b []int32) []int32 {
+	ret := make([]int32, 0, min(len(a), len(b)))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			ret = append(ret, a[i])
+			i++
+			j++
+		}
+	}
+	return ret
+}
@@ -0,0 +1,213 @@
+package names
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// Imported names are merged into the custom names database. The merge is three-way: the regular names
+// database is the common ancestor of my names (the custom database) and their names (the imported
+// file). For each field, a change made on only one side wins. A field changed differently on both sides
+// is a conflict, which is resolved by the merge policy. An empty field in an imported name is not a change.
+
+type MergePolicy string
+
+const (
+	PreferMine   MergePolicy = "mine"   // keep my value
+	PreferTheirs MergePolicy = "theirs" // take their value
+	KeepBoth     MergePolicy = "tags"   // keep my value and add theirs to the tags
+)
+
+// Conflict is a field of a name that both sides changed differently
+type Conflict struct {
+	Address base.Address
+	Field   string
+	Mine    string
+	Theirs  string
+	Kept    string
+}
+
+func (c *Conflict) String() string {
+	return fmt.Sprintf("%s %s: mine %q, theirs %q, kept %q", c.Address.Hex(), c.Field, c.Mine, c.Theirs, c.Kept)
+}
+
+// MergeResult reports the changes made (or, for a dry run, that would be made) by a merge
+type MergeResult struct {
+	Added     int
+	Updated   int
+	Unchanged int
+	Conflicts []Conflict
+}
+
+// mergedField is a field of a name that is merged
+type mergedField struct {
+	name string
+	get  func(*types.Name) string
+	set  func(*types.Name, string)
+}
+
+var mergedFields = []mergedField{
+	{"name", func(n *types.Name) string { return n.Name }, func(n *types.Name, v string) { n.Name = v }},
+	{"symbol", func(n *types.Name) string { return n.Symbol }, func(n *types.Name, v string) { n.Symbol = v }},
+	{"tags", func(n *types.Name) string { return n.Tags }, func(n *types.Name, v string) { n.Tags = v }},
+	{"decimals", func(n *types.Name) string {
+		if n.Decimals == 0 {
+			return ""
+		}
+		return fmt.Sprint(n.Decimals)
+	}, func(n *types.Name, v string) { n.Decimals = base.MustParseUint64(v) }},
+}
+
+// MergeNames merges the imported names into the chain's custom names. Each name taken in whole or in part
+// from the import records the provenance in its source. If dryRun is true, the custom names are not changed.
+func MergeNames(chain string, theirs []types.Name, provenance string, policy MergePolicy, dryRun bool) (*MergeResult, error) {
+	regular, err := getDatabase(chain, DatabaseRegular).load(None)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MergeResult{}
+	merge := func(mine map[base.Address]types.Name) error {
+		for _, name := range theirs {
+			mergeName(mine, regular.names, name, provenance, policy, result)
+		}
+		sort.SliceStable(result.Conflicts, func(i, j int) bool {
+			return result.Conflicts[i].Address.Hex() < result.Conflicts[j].Address.Hex()
+		})
+		return nil
+	}
+
+	db := getDatabase(chain, DatabaseCustom)
+	if dryRun {
+		snap, err := db.load(None)
+		if err != nil {
+			return nil, err
+		}
+		mine := make(map[base.Address]types.Name, len(snap.names))
+		for addr, name := range snap.names {
+			mine[addr] = name
+		}
+		_ = merge(mine)
+		return result, nil
+	}
+
+	if err := db.commit(merge); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func mergeName(mine, ancestors map[base.Address]types.Name, theirs types.Name, provenance string, policy MergePolicy, result *MergeResult) {
+	ancestor, hasAncestor := ancestors[theirs.Address]
+	current, hasMine := mine[theirs.Address]
+
+	if !hasMine {
+		if !hasAncestor && theirs.Name == "" {
+			result.Unchanged++ // there is nothing to add
+			return
+		}
+		if hasAncestor {
+			if sameFields(&ancestor, &theirs) {
+				result.Unchanged++
+				return
+			}
+			// Fields they left empty come from the regular name
+			merged := ancestor
+			for _, f := range mergedFields {
+				if t := f.get(&theirs); t != "" {
+					f.set(&merged, t)
+				}
+			}
+			merged.Source = theirs.Source
+			theirs = merged
+		}
+		theirs.IsCustom = true
+		theirs.Source = withProvenance(theirs.Source, provenance)
+		mine[theirs.Address] = theirs
+		result.Added++
+		return
+	}
+
+	merged := current
+	changed := false
+	keptAsTags := []string{}
+	for _, f := range mergedFields {
+		m, t, a := f.get(&current), f.get(&theirs), f.get(&ancestor)
+		if t == "" || t == m || t == a {
+			continue // they did not change it
+		}
+		if m == a {
+			f.set(&merged, t) // only they changed it
+			changed = true
+			continue
+		}
+
+		conflict := Conflict{Address: theirs.Address, Field: f.name, Mine: m, Theirs: t, Kept: m}
+		switch policy {
+		case PreferTheirs:
+			f.set(&merged, t)
+			conflict.Kept = t
+			changed = true
+		case KeepBoth:
+			if f.name != "decimals" {
+				keptAsTags = append(keptAsTags, t)
+			}
+		}
+		result.Conflicts = append(result.Conflicts, conflict)
+	}
+	for _, t := range keptAsTags {
+		merged.Tags = addTags(merged.Tags, t)
+		changed = true
+	}
+
+	if !changed {
+		result.Unchanged++
+		return
+	}
+	merged.Source = withProvenance(merged.Source, provenance)
+	mine[merged.Address] = merged
+	result.Updated++
+}
+
+// sameFields returns true if the merged fields of the names are the same. Empty fields in b are ignored.
+func sameFields(a, b *types.Name) bool {
+	for _, f := range mergedFields {
+		if v := f.get(b); v != "" && v != f.get(a) {
+			return false
+		}
+	}
+	return true
+}
+
+// addTags adds the parts of the value (separated by colons) that are not already among the tags
+func addTags(tags, value string) string {
+	parts := []string{}
+	if tags != "" {
+		parts = strings.Split(tags, ":")
+	}
+	for _, part := range strings.Split(value, ":") {
+		found := false
+		for _, existing := range parts {
+			found = found || strings.EqualFold(existing, part)
+		}
+		if !found && part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ":")
+}
+
+// withProvenance adds where a name was imported from to its source, if it is not already there
+func withProvenance(source, provenance string) string {
+	if source == "" {
+		return provenance
+	}
+	if strings.Contains(source, provenance) {
+		return source
+	}
+	return provenance + " (" + source + ")"
+}
@@ -0,0 +1,125 @@
+package names
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+var (
+	addrA = base.HexToAddress("0x000000000000000000000000000000000000000a")
+	addrB = base.HexToAddress("0x000000000000000000000000000000000000000b")
+	addrC = base.HexToAddress("0x000000000000000000000000000000000000000c")
+	addrD = base.HexToAddress("0x000000000000000000000000000000000000000d")
+)
+
+// mergeTestNames sets up the databases for a merge: A and B are regular names, and I have renamed A and
+// tagged B in my custom names
+func mergeTestNames(t *testing.T) {
+	dir := t.TempDir()
+	then := time.Now().Add(-time.Hour)
+	writeTestDatabase(t, filepath.Join(dir, "names.tab"), then,
+		types.Name{Address: addrA, Name: "Alpha", Tags: "31-Contracts"},
+		types.Name{Address: addrB, Name: "Bravo", Tags: "31-Contracts", Source: "EtherScan.io"},
+	)
+	writeTestDatabase(t, filepath.Join(dir, "names_custom.tab"), then,
+		types.Name{Address: addrA, Name: "My Alpha", Tags: "31-Contracts", IsCustom: true},
+		types.Name{Address: addrB, Name: "Bravo", Tags: "90-Exchanges", IsCustom: true},
+	)
+	useTestDatabase(t, "chain-a", DatabaseRegular, filepath.Join(dir, "names.tab"))
+	useTestDatabase(t, "chain-a", DatabaseCustom, filepath.Join(dir, "names_custom.tab"))
+}
+
+var theirNames = []types.Name{
+	{Address: addrA, Name: "Their Alpha", Tags: "55-Defi"}, // conflicts with my name; they changed the tags
+	{Address: addrB, Name: "Bravo Exchange"},               // they changed the name; I changed the tags
+	{Address: addrC, Name: "Charlie"},                      // new
+	{Address: addrD, Name: ""},                             // new, but without a name
+}
+
+func TestMergeNames(t *testing.T) {
+	tests := []struct {
+		policy   MergePolicy
+		expected map[base.Address][2]string // name and tags
+	}{
+		{PreferMine, map[base.Address][2]string{
+			addrA: {"My Alpha", "55-Defi"},
+			addrB: {"Bravo Exchange", "90-Exchanges"},
+			addrC: {"Charlie", ""},
+		}},
+		{PreferTheirs, map[base.Address][2]string{
+			addrA: {"Their Alpha", "55-Defi"},
+			addrB: {"Bravo Exchange", "90-Exchanges"},
+		}},
+		{KeepBoth, map[base.Address][2]string{
+			addrA: {"My Alpha", "55-Defi:Their Alpha"},
+		}},
+	}
+
+	for _, test := range tests {
+		mergeTestNames(t)
+		result, err := MergeNames("chain-a", theirNames, "import:test.csv", test.policy, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Added != 1 || result.Updated != 2 || result.Unchanged != 1 || len(result.Conflicts) != 1 {
+			t.Errorf("%s: unexpected result %+v", test.policy, result)
+		} else if c := result.Conflicts[0]; c.Address != addrA || c.Field != "name" || c.Mine != "My Alpha" || c.Theirs != "Their Alpha" {
+			t.Errorf("%s: unexpected conflict %s", test.policy, c.String())
+		}
+
+		for addr, expected := range test.expected {
+			name := ReadName(DatabaseCustom, "chain-a", addr)
+			if name == nil || name.Name != expected[0] || name.Tags != expected[1] {
+				t.Errorf("%s: expected %v, got %v", test.policy, expected, name)
+			} else if name.Source != "import:test.csv" {
+				t.Errorf("%s: expected the provenance in the source, got %s", test.policy, name.Source)
+			}
+		}
+		ClearCache()
+	}
+}
+
+func TestMergeNamesUnchanged(t *testing.T) {
+	mergeTestNames(t)
+
+	// A name that matches the regular name (ignoring empty fields) is not added to the custom names
+	same := []types.Name{{Address: addrB, Name: "Bravo"}}
+	result, err := MergeNames("chain-a", same, "import:test.csv", PreferMine, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Unchanged != 1 || result.Added+result.Updated != 0 {
+		t.Errorf("unexpected result %+v", result)
+	}
+
+	// A dry run reports the changes without making them
+	result, err = MergeNames("chain-a", theirNames, "import:test.csv", PreferTheirs, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Added != 1 || result.Updated != 2 {
+		t.Errorf("unexpected result %+v", result)
+	}
+	if name := ReadName(DatabaseCustom, "chain-a", addrA); name.Name != "My Alpha" {
+		t.Error("a dry run should not change the names, got", name.Name)
+	}
+	if name := ReadName(DatabaseCustom, "chain-a", addrC); name != nil {
+		t.Error("a dry run should not add names, got", name)
+	}
+}
+
+func TestAddTags(t *testing.T) {
+	for _, test := range [][3]string{
+		{"90-Exchanges", "Binance", "90-Exchanges:Binance"},
+		{"90-Exchanges:Binance", "90-exchanges:Hot Wallet", "90-Exchanges:Binance:Hot Wallet"},
+		{"", "55-Defi", "55-Defi"},
+	} {
+		if got := addTags(test[0], test[1]); got != test[2] {
+			t.Errorf("addTags(%s, %s): expected %s, got %s", test[0], test[1], test[2], got)
+		}
+	}
+}
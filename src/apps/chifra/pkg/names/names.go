@@ -43,15 +43,18 @@ const (
 	// SortByDecimals
 	SortByTags
 	// SortByPetname
+	SortByRank
 )
 
-// LoadNamesArray loads the names from the cache and returns an array of names
+// LoadNamesArray loads the names from the cache and returns an array of names. When sorted by rank,
+// the names that best match the search terms come first.
 func LoadNamesArray(chain string, parts Parts, sortBy SortBy, terms []string) ([]types.Name, error) {
 	var names []types.Name
-	if namesMap, err := LoadNamesMap(chain, parts, terms); err != nil {
+	found, err := searchNames(chain, parts, terms)
+	if err != nil {
 		return nil, err
 	} else {
-		for _, name := range namesMap {
+		for _, name := range found.names {
 			// Custom names with Individual tag or tags under 30 are private during testing
 			isTesting := parts&Testing != 0
 			isPrivate := strings.Contains(name.Tags, "Individual") || (name.IsCustom && name.Tags < "3")
@@ -67,6 +70,12 @@ func LoadNamesArray(chain string, parts Parts, sortBy SortBy, terms []string) ([
 			return names[i].Name < names[j].Name
 		case SortByTags:
 			return names[i].Tags < names[j].Tags
+		case SortByRank:
+			si, sj := found.scores[names[i].Address], found.scores[names[j].Address]
+			if si != sj {
+				return si > sj
+			}
+			return names[i].Address.Hex() < names[j].Address.Hex()
 		case SortByAddress:
 			fallthrough
 		default:
@@ -85,45 +94,68 @@ func LoadNamesArray(chain string, parts Parts, sortBy SortBy, terms []string) ([
 
 // LoadNamesMap loads the names from the cache and returns a map of names
 func LoadNamesMap(chain string, parts Parts, terms []string) (map[base.Address]types.Name, error) {
-	namesMap := map[base.Address]types.Name{}
+	found, err := searchNames(chain, parts, terms)
+	if err != nil {
+		return map[base.Address]types.Name{}, err
+	}
+	return found.names, nil
+}
+
+// found collects the names that match a search and their scores
+type found struct {
+	names  map[base.Address]types.Name
+	scores map[base.Address]float64
+}
+
+func (f *found) add(name types.Name, score float64) {
+	f.names[name.Address] = name
+	f.scores[name.Address] = score
+}
+
+// searchNames returns the names that match the search terms
+func searchNames(chain string, parts Parts, terms []string) (*found, error) {
+	ret := &found{
+		names:  map[base.Address]types.Name{},
+		scores: map[base.Address]float64{},
+	}
+
+	q, err := newQuery(terms, parts)
+	if err != nil {
+		return ret, err
+	}
 
 	// Load the prefund names first...
 	if parts&Prefund != 0 {
 		prefundPath := prefunds.GetPrefundPath(chain)
 		if prefundMap, err := prefunds.LoadPrefundMap(chain, prefundPath); err != nil {
-			return namesMap, err
+			return ret, err
 		} else {
-			for k, v := range *prefundMap {
-				if doSearch(&v, terms, parts) {
-					namesMap[k] = v
+			for _, v := range *prefundMap {
+				if score, ok := q.scoreName(&v); ok {
+					ret.add(v, score)
 				}
 			}
 		}
 	}
 
 	if parts&Regular != 0 {
-		namesPath := filepath.Join(config.MustGetPathToChainConfig(chain), "names.tab")
-		_ = loadRegularMap(chain, namesPath, terms, parts, &namesMap)
+		_ = loadRegularMap(chain, q, parts, ret)
 	}
 
 	// Load the custom names (note that these may overwrite the prefund and regular names)
 	if parts&Custom != 0 {
-		_ = loadCustomMap(chain, terms, parts, &namesMap)
+		_ = loadCustomMap(chain, q, parts, ret)
 	}
 
-	return namesMap, nil
+	return ret, nil
 }
 
 // ClearCache removes names that are cached in-memory
 func ClearCache() {
-	loadedRegularNamesMutex.Lock()
-	defer loadedRegularNamesMutex.Unlock()
-
-	loadedCustomNamesMutex.Lock()
-	defer loadedCustomNamesMutex.Unlock()
+	databasesMutex.Lock()
+	defer databasesMutex.Unlock()
 
-	loadedRegularNames = make(map[base.Address]types.Name)
-	loadedCustomNames = make(map[base.Address]types.Name)
+	databases = map[dbKey]*database{}
 }
 
 var requiredColumns = []string{
@@ -150,20 +182,28 @@ func (gr *NameReader) Read() (types.Name, error) {
 		return types.Name{}, err
 	}
 
+	// Columns that are not required may be missing
+	field := func(column string) string {
+		if index, ok := gr.header[column]; ok && index < len(record) {
+			return record[index]
+		}
+		return ""
+	}
+
 	return types.Name{
-		Tags:       record[gr.header["tags"]],
-		Address:    base.HexToAddress(strings.ToLower(record[gr.header["address"]])),
-		Name:       record[gr.header["name"]],
-		Decimals:   base.MustParseUint64(record[gr.header["decimals"]]),
-		Symbol:     record[gr.header["symbol"]],
-		Source:     record[gr.header["source"]],
-		Petname:    record[gr.header["petname"]],
-		Deleted:    record[gr.header["deleted"]] == "true",
-		IsCustom:   record[gr.header["isCustom"]] == "true",
-		IsPrefund:  record[gr.header["isPrefund"]] == "true",
-		IsContract: record[gr.header["isContract"]] == "true",
-		IsErc20:    record[gr.header["isErc20"]] == "true",
-		IsErc721:   record[gr.header["isErc721"]] == "true",
+		Tags:       field("tags"),
+		Address:    base.HexToAddress(strings.ToLower(field("address"))),
+		Name:       field("name"),
+		Decimals:   base.MustParseUint64(field("decimals")),
+		Symbol:     field("symbol"),
+		Source:     field("source"),
+		Petname:    field("petname"),
+		Deleted:    field("deleted") == "true",
+		IsCustom:   field("isCustom") == "true",
+		IsPrefund:  field("isPrefund") == "true",
+		IsContract: field("isContract") == "true",
+		IsErc20:    field("isErc20") == "true",
+		IsErc721:   field("isErc721") == "true",
 	}, nil
 }
 
@@ -175,6 +215,10 @@ const (
 )
 
 func NewNameReader(source io.Reader, mode nameReaderMode) (NameReader, error) {
+	return newNameReader(source, mode, requiredColumns)
+}
+
+func newNameReader(source io.Reader, mode nameReaderMode, required []string) (NameReader, error) {
 	reader := csv.NewReader(source)
 	reader.Comma = '\t'
 	if mode == NameReaderComma {
@@ -190,10 +234,10 @@ func NewNameReader(source io.Reader, mode nameReaderMode) (NameReader, error) {
 		header[columnName] = index
 	}
 
-	for _, required := range requiredColumns {
-		_, ok := header[required]
+	for _, column := range required {
+		_, ok := header[column]
 		if !ok {
-			err = fmt.Errorf(`required column "%s" missing`, required) //, path)
+			err = fmt.Errorf(`required column "%s" missing`, column) //, path)
 			return NameReader{}, err
 		}
 	}
@@ -216,21 +260,24 @@ const (
 	DatabaseDryRun  DatabaseType = "<dryrun>"
 )
 
-func openDatabaseFile(chain string, kind DatabaseType, openFlag int) (*os.File, error) {
-	if kind == DatabaseDryRun {
-		return os.Stdout, nil
+// databasePath returns the path to the chain's database. During testing, the custom names are kept in
+// a temporary file.
+func databasePath(chain string, kind DatabaseType) string {
+	if kind == DatabaseCustom && os.Getenv("TEST_MODE") == "true" {
+		return path.Join(os.TempDir(), "trueblocks", "names_custom.tab")
 	}
+	return filepath.Join(config.MustGetPathToChainConfig(chain), string(kind))
+}
 
-	filePath := filepath.Join(config.MustGetPathToChainConfig(chain), string(kind))
+func openDatabasePath(filePath string, kind DatabaseType, openFlag int) (*os.File, error) {
 	var permissions fs.FileMode = 0666
 
 	if kind == DatabaseCustom && os.Getenv("TEST_MODE") == "true" {
 		// Create temp database, just for tests. On Mac, the permissions must be set to 0777
-		if err := os.MkdirAll(path.Join(os.TempDir(), "trueblocks"), 0777); err != nil {
+		if err := os.MkdirAll(filepath.Dir(filePath), 0777); err != nil {
 			return nil, err
 		}
 
-		filePath = path.Join(os.TempDir(), "trueblocks", "names_custom.tab")
 		openFlag |= os.O_CREATE
 		// On Mac, the permissions must be set to 0777
 		permissions = 0777
@@ -8,26 +8,18 @@ import (
 func ReadName(dbType DatabaseType, chain string, address base.Address) (name *types.Name) {
 	switch dbType {
 	case DatabaseCustom:
-		return customReadName(address)
+		return customReadName(chain, address)
 	case DatabaseRegular:
-		return regularReadName(address)
+		return regularReadName(chain, address)
 	default:
 		return nil
 	}
 }
 
-func customReadName(address base.Address) (name *types.Name) {
-	found, ok := loadedCustomNames[address]
-	if ok {
-		return &found
-	}
-	return nil
+func customReadName(chain string, address base.Address) (name *types.Name) {
+	return getDatabase(chain, DatabaseCustom).find(address)
 }
 
-func regularReadName(address base.Address) (name *types.Name) {
-	found, ok := loadedRegularNames[address]
-	if ok {
-		return &found
-	}
-	return nil
+func regularReadName(chain string, address base.Address) (name *types.Name) {
+	return getDatabase(chain, DatabaseRegular).find(address)
 }
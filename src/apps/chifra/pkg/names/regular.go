@@ -2,67 +2,50 @@ package names
 
 import (
 	"io"
-	"os"
-	"sync"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
-	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
 )
 
-// TODO: Test if there's a performance differnce between using an array here (which would work just as well) and a map
-var loadedRegularNames map[base.Address]types.Name = map[base.Address]types.Name{}
-var loadedRegularNamesMutex sync.Mutex
-
-// loadRegularMap loads the regular names from the cache
-func loadRegularMap(chain string, thePath string, terms []string, parts Parts, ret *map[base.Address]types.Name) error {
-	if len(loadedRegularNames) != 0 {
-		// We have already loaded the data
-		for _, name := range loadedRegularNames {
-			if doSearch(&name, terms, parts) {
-				(*ret)[name.Address] = name
-			}
-		}
-		return nil
-	}
-
-	loadedRegularNamesMutex.Lock()
-	defer loadedRegularNamesMutex.Unlock()
-
-	db, err := openDatabaseFile(chain, DatabaseRegular, os.O_RDONLY)
+// loadRegularMap adds the regular names that match the search to found
+func loadRegularMap(chain string, q *query, parts Parts, found *found) error {
+	snap, err := getDatabase(chain, DatabaseRegular).load(parts)
 	if err != nil {
 		return err
 	}
-	defer db.Close()
+	snap.search(q, found)
+
+	if parts&Baddress != 0 {
+		loadKnownBadresses(q, found)
+	}
+
+	return nil
+}
 
-	reader, err := NewNameReader(db, NameReaderTab)
+// readRegularNames reads the regular names database. It returns an error if any line cannot be read.
+// TODO: Test if there's a performance differnce between using an array here (which would work just as well) and a map
+func readRegularNames(source io.Reader) (map[base.Address]types.Name, error) {
+	reader, err := NewNameReader(source, NameReaderTab)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	regularNames := map[base.Address]types.Name{}
 	for {
 		n, err := reader.Read()
 		if err == io.EOF {
 			break
 		}
 		if err != nil {
-			logger.Fatal(err)
-		}
-		loadedRegularNames[n.Address] = n
-		if doSearch(&n, terms, parts) {
-			(*ret)[n.Address] = n
+			return nil, err
 		}
+		regularNames[n.Address] = n
 	}
-
-	if parts&Baddress != 0 {
-		loadKnownBadresses(terms, parts, ret)
-	}
-
-	return nil
+	return regularNames, nil
 }
 
 // loadKnownBadresses loads the known bad addresses from the cache
-func loadKnownBadresses(terms []string, parts Parts, ret *map[base.Address]types.Name) {
+func loadKnownBadresses(q *query, found *found) {
 	knownBadAddresses := []types.Name{
 		{
 			Address: base.PrefundSender,
@@ -86,8 +69,8 @@ func loadKnownBadresses(terms []string, parts Parts, ret *map[base.Address]types
 		},
 	}
 	for _, n := range knownBadAddresses {
-		if doSearch(&n, terms, parts) {
-			(*ret)[n.Address] = n
+		if score, ok := q.scoreName(&n); ok {
+			found.add(n, score)
 		}
 	}
 }
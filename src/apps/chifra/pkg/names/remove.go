@@ -2,7 +2,6 @@ package names
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
@@ -23,19 +22,21 @@ func RemoveName(dbType DatabaseType, chain string, address base.Address) (name *
 }
 
 func customRemoveName(chain string, address base.Address) (*types.Name, error) {
-	name, exists := loadedCustomNames[address]
-	if !exists {
-		return nil, fmt.Errorf("cannot remove non-existant custom name for address %s", address.Hex())
-	}
+	return removeName(getDatabase(chain, DatabaseCustom), address)
+}
 
-	db, err := openDatabaseFile(chain, DatabaseCustom, os.O_WRONLY|os.O_TRUNC)
+func removeName(db *database, address base.Address) (*types.Name, error) {
+	var name types.Name
+	err := db.commit(func(names map[base.Address]types.Name) error {
+		var exists bool
+		if name, exists = names[address]; !exists {
+			return fmt.Errorf("cannot remove non-existant custom name for address %s", address.Hex())
+		}
+		delete(names, address)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer db.Close()
-
-	loadedCustomNamesMutex.Lock()
-	defer loadedCustomNamesMutex.Unlock()
-	delete(loadedCustomNames, address)
-	return &name, writeCustomNames(db)
+	return &name, nil
 }
@@ -1,35 +1,331 @@
 package names
 
 import (
+	"fmt"
 	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
 )
 
-func doSearch(name *types.Name, terms []string, parts Parts) bool {
-	if len(terms) == 0 {
-		return true
-	}
+// A search is made of one or more terms, all of which must match a name. By default, a term matches
+// any name whose name, symbol, address, or tags contain the term (ignoring case unless MatchCase is
+// set). With Expanded, the source and petname are searched as well. With Tags, only the tags are. A term
+// containing regular expression characters is treated as a regular expression. In addition:
+//
+//	field:term  searches only the given field (name, symbol, address, tag, source, or petname)
+//	term*       matches words that start with term
+//	term~       matches words within two edits of term (one edit if term is short)
+//	term~N      matches words within N edits of term
+//
+// Prefix and fuzzy terms ignore case. Each matching name is given a score that favors whole words over
+// prefixes over partial matches, and the name and symbol over the other fields.
 
-	cnt := 0
-	searchStr := name.Name + "\t" + name.Symbol + "\t" + name.Address.Hex() + "\t" + name.Tags
-	if parts&Expanded != 0 {
-		searchStr += "\t" + name.Source + "\t" + name.Petname
+// field is a searchable part of a name
+type field int
+
+const (
+	fieldName field = iota
+	fieldSymbol
+	fieldAddress
+	fieldTags
+	fieldSource
+	fieldPetname
+	nFields
+)
+
+var fieldsByName = map[string]field{
+	"name":    fieldName,
+	"symbol":  fieldSymbol,
+	"address": fieldAddress,
+	"addr":    fieldAddress,
+	"tag":     fieldTags,
+	"tags":    fieldTags,
+	"source":  fieldSource,
+	"petname": fieldPetname,
+}
+
+// fieldWeights favors matches in some fields over others when ranking
+var fieldWeights = [nFields]float64{
+	fieldName:    4,
+	fieldSymbol:  3,
+	fieldAddress: 3,
+	fieldTags:    2,
+	fieldSource:  1,
+	fieldPetname: 1,
+}
+
+func fieldValue(name *types.Name, f field) string {
+	switch f {
+	case fieldName:
+		return name.Name
+	case fieldSymbol:
+		return name.Symbol
+	case fieldAddress:
+		return name.Address.Hex()
+	case fieldTags:
+		return name.Tags
+	case fieldSource:
+		return name.Source
+	case fieldPetname:
+		return name.Petname
 	}
+	return ""
+}
+
+// searchedFields returns the fields searched by terms that do not name a field
+func searchedFields(parts Parts) []field {
 	if parts&Tags != 0 {
-		// only search tags
-		searchStr = name.Tags
+		return []field{fieldTags}
 	}
+	fields := []field{fieldName, fieldSymbol, fieldAddress, fieldTags}
+	if parts&Expanded != 0 {
+		fields = append(fields, fieldSource, fieldPetname)
+	}
+	return fields
+}
+
+// tokenize splits a field into lower case words
+func tokenize(value string) []string {
+	return strings.FieldsFunc(strings.ToLower(value), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+type matchKind int
+
+const (
+	matchContains matchKind = iota
+	matchRegex
+	matchPrefix
+	matchFuzzy
+)
+
+// clause is a single parsed search term
+type clause struct {
+	fields    []field
+	qualified bool // the term names the field to search
+	kind      matchKind
+	text      string
+	matchCase bool
+	re        *regexp.Regexp
+	distance  int
+}
 
-	verb := "(?i)"
-	if parts&MatchCase != 0 {
-		verb = "(?)"
+// query is a parsed search
+type query struct {
+	clauses []clause
+}
+
+// UsesQuerySyntax returns true if any of the terms names a field or asks for a prefix or fuzzy match. The
+// results of such searches are best sorted by rank.
+func UsesQuerySyntax(terms []string) bool {
+	for _, term := range terms {
+		if c, err := parseTerm(term, None); err == nil && (c.qualified || c.kind == matchPrefix || c.kind == matchFuzzy) {
+			return true
+		}
 	}
+	return false
+}
+
+func newQuery(terms []string, parts Parts) (*query, error) {
+	q := &query{clauses: make([]clause, 0, len(terms))}
 	for _, term := range terms {
-		if regexp.MustCompile(verb + term).MatchString(searchStr) {
-			cnt++
+		c, err := parseTerm(term, parts)
+		if err != nil {
+			return nil, err
+		}
+		q.clauses = append(q.clauses, c)
+	}
+	return q, nil
+}
+
+var fuzzyPattern = regexp.MustCompile(`^(.+)~(\d?)$`)
+
+func parseTerm(term string, parts Parts) (clause, error) {
+	c := clause{
+		fields:    searchedFields(parts),
+		kind:      matchContains,
+		matchCase: parts&MatchCase != 0,
+	}
+
+	text := term
+	if prefix, rest, found := strings.Cut(term, ":"); found && len(rest) > 0 {
+		if f, ok := fieldsByName[strings.ToLower(prefix)]; ok {
+			c.fields = []field{f}
+			c.qualified = true
+			text = rest
+		}
+	}
+
+	if m := fuzzyPattern.FindStringSubmatch(text); m != nil {
+		c.kind = matchFuzzy
+		c.text = strings.ToLower(m[1])
+		if len(m[2]) > 0 {
+			c.distance, _ = strconv.Atoi(m[2])
+		} else if len(c.text) > 4 {
+			c.distance = 2
+		} else {
+			c.distance = 1
+		}
+		return c, nil
+	}
+
+	if len(text) > 1 && strings.HasSuffix(text, "*") && regexp.QuoteMeta(text[:len(text)-1]) == text[:len(text)-1] {
+		c.kind = matchPrefix
+		c.text = strings.ToLower(strings.TrimSuffix(text, "*"))
+		return c, nil
+	}
+
+	if regexp.QuoteMeta(text) != text {
+		verb := "(?i)"
+		if c.matchCase {
+			verb = ""
+		}
+		re, err := regexp.Compile(verb + text)
+		if err != nil {
+			return c, fmt.Errorf("invalid search term %s: %w", term, err)
 		}
+		c.kind = matchRegex
+		c.re = re
+		c.text = text
+		return c, nil
+	}
+
+	c.text = text
+	if !c.matchCase {
+		c.text = strings.ToLower(text)
 	}
+	return c, nil
+}
+
+// document is a name prepared for searching
+type document struct {
+	name   types.Name
+	values [nFields]string   // the fields as they are
+	lower  [nFields]string   // the fields in lower case
+	tokens [nFields][]string // the words in each field
+}
+
+func newDocument(name *types.Name) *document {
+	doc := &document{name: *name}
+	for f := field(0); f < nFields; f++ {
+		doc.values[f] = fieldValue(name, f)
+		doc.lower[f] = strings.ToLower(doc.values[f])
+		doc.tokens[f] = tokenize(doc.values[f])
+	}
+	return doc
+}
+
+// scoreName returns the name's score for the query and false if it does not match
+func (q *query) scoreName(name *types.Name) (float64, bool) {
+	if len(q.clauses) == 0 {
+		return 0, true
+	}
+	return q.score(newDocument(name))
+}
+
+// score returns the document's score for the query and false if it does not match
+func (q *query) score(doc *document) (float64, bool) {
+	total := 0.0
+	for i := range q.clauses {
+		best := 0.0
+		for _, f := range q.clauses[i].fields {
+			if s := q.clauses[i].score(doc, f) * fieldWeights[f]; s > best {
+				best = s
+			}
+		}
+		if best == 0 {
+			return 0, false
+		}
+		total += best
+	}
+	return total, true
+}
+
+// score returns how well a single field of the document matches the clause (zero if it does not)
+func (c *clause) score(doc *document, f field) float64 {
+	switch c.kind {
+	case matchContains:
+		value := doc.lower[f]
+		if c.matchCase {
+			value = doc.values[f]
+		}
+		if !strings.Contains(value, c.text) {
+			return 0
+		}
+		return 1 + c.wordScore(doc.tokens[f])
 
-	return len(terms) <= cnt
+	case matchRegex:
+		if c.re.MatchString(doc.values[f]) {
+			return 1
+		}
+
+	case matchPrefix:
+		return c.wordScore(doc.tokens[f])
+
+	case matchFuzzy:
+		best := -1
+		for _, token := range doc.tokens[f] {
+			if d := editDistance(c.text, token, c.distance); d >= 0 && (best < 0 || d < best) {
+				best = d
+			}
+		}
+		if best >= 0 {
+			return 1 + 1/float64(best+1)
+		}
+	}
+	return 0
+}
+
+// wordScore scores a field's words against the clause's text: 2 for a whole word, 1 for the start of a
+// word, and 0 otherwise
+func (c *clause) wordScore(tokens []string) float64 {
+	text := strings.ToLower(c.text)
+	best := 0.0
+	for _, token := range tokens {
+		if token == text {
+			return 2
+		} else if strings.HasPrefix(token, text) {
+			best = 1
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b or -1 if it is greater than max
+func editDistance(a, b string, max int) int {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra)-len(rb) > max || len(rb)-len(ra) > max {
+		return -1
+	}
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			rowMin = min(rowMin, curr[j])
+		}
+		if rowMin > max {
+			return -1
+		}
+		prev, curr = curr, prev
+	}
+
+	if prev[len(rb)] > max {
+		return -1
+	}
+	return prev[len(rb)]
 }
@@ -0,0 +1,162 @@
+package names
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+var searchableNames = []types.Name{
+	{Address: base.HexToAddress("0x1f9840a85d5af5bf1d1762f925bdaddc4201f984"), Name: "Uniswap", Symbol: "UNI", Tags: "50-Tokens:ERC20", Source: "On chain"},
+	{Address: base.HexToAddress("0x7a250d5630b4cf539739df2c5dacb4c659f2488d"), Name: "Uniswap V2: Router 2", Tags: "30-Contracts:DeFi", Source: "EtherScan.io"},
+	{Address: base.HexToAddress("0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"), Name: "Centre: USD Coin", Symbol: "USDC", Tags: "50-Tokens:ERC20", Source: "On chain"},
+	{Address: base.HexToAddress("0xdac17f958d2ee523a2206206994597c13d831ec7"), Name: "Tether USD", Symbol: "USDT", Tags: "50-Tokens:ERC20", Source: "On chain"},
+	{Address: base.HexToAddress("0x28c6c06298d514db089934071355e5743bf21d60"), Name: "Binance 14", Tags: "90-Exchanges:Binance", Source: "EtherScan.io"},
+	{Address: base.HexToAddress("0x71660c4005ba85c37ccec55d0c4493e66fe775d3"), Name: "Coinbase 1", Tags: "90-Exchanges:Coinbase", Source: "EtherScan.io", Petname: "usually-busy-otter"},
+}
+
+func searchTestNames(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "names.tab")
+	writeTestDatabase(t, path, time.Now().Add(-time.Hour), searchableNames...)
+	useTestDatabase(t, "chain-a", DatabaseRegular, path)
+}
+
+func searched(t *testing.T, parts Parts, sortBy SortBy, terms ...string) string {
+	found, err := LoadNamesArray("chain-a", Regular|parts, sortBy, terms)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ret := []string{}
+	for _, name := range found {
+		ret = append(ret, name.Name)
+	}
+	return strings.Join(ret, ", ")
+}
+
+func TestSearch(t *testing.T) {
+	searchTestNames(t)
+
+	tests := []struct {
+		terms    []string
+		parts    Parts
+		expected string
+	}{
+		{[]string{"uniswap"}, None, "Uniswap, Uniswap V2: Router 2"},
+		{[]string{"uniswap", "router"}, None, "Uniswap V2: Router 2"},
+		{[]string{"Uniswap"}, MatchCase, "Uniswap, Uniswap V2: Router 2"},
+		{[]string{"uniSwap"}, MatchCase, ""},
+		{[]string{"0xdac17f"}, None, "Tether USD"},
+		{[]string{"^Coin"}, None, "Coinbase 1"},
+		{[]string{"usd"}, None, "Centre: USD Coin, Tether USD"},
+		{[]string{"busy"}, None, ""},
+		{[]string{"busy"}, Expanded, "Coinbase 1"},
+		{[]string{"exchanges"}, Tags, "Binance 14, Coinbase 1"},
+		{[]string{"binance"}, Tags, "Binance 14"},
+		{[]string{"tag:Exchanges", "symbol:USD*"}, None, ""},
+		{[]string{"tag:ERC20", "symbol:USD*"}, None, "Centre: USD Coin, Tether USD"},
+		{[]string{"name:uni*"}, None, "Uniswap, Uniswap V2: Router 2"},
+		{[]string{"symbol:uni"}, None, "Uniswap"},
+		{[]string{"source:etherscan", "coin*"}, None, "Coinbase 1"},
+		{[]string{"petname:otter"}, None, "Coinbase 1"},
+		{[]string{"address:0x28c6"}, None, "Binance 14"},
+		{[]string{"unswap~"}, None, "Uniswap, Uniswap V2: Router 2"},
+		{[]string{"tethr~1"}, None, "Tether USD"},
+		{[]string{"tethr~0"}, None, ""},
+		{[]string{"coinbse~", "tag:exchanges"}, None, "Coinbase 1"},
+		{[]string{"color:blue"}, None, ""},
+	}
+	for _, test := range tests {
+		if got := searched(t, test.parts, SortByName, test.terms...); got != test.expected {
+			t.Errorf("%v: expected [%s], got [%s]", test.terms, test.expected, got)
+		}
+	}
+}
+
+func TestSearchRanked(t *testing.T) {
+	searchTestNames(t)
+
+	// A whole word in the name beats the start of a word in the name beats a word in the tags
+	if got := searched(t, None, SortByRank, "coin*"); got != "Centre: USD Coin, Coinbase 1" {
+		t.Error("unexpected ranking:", got)
+	}
+	// A closer fuzzy match ranks higher
+	if got := searched(t, None, SortByRank, "name:coinb~3"); got != "Centre: USD Coin, Coinbase 1" {
+		t.Error("unexpected ranking:", got)
+	}
+}
+
+func TestSearchInvalidTerm(t *testing.T) {
+	searchTestNames(t)
+	if _, err := LoadNamesArray("chain-a", Regular, SortByAddress, []string{"[unclosed"}); err == nil {
+		t.Error("expected an error for an invalid regular expression")
+	}
+}
+
+// The index should find exactly the names that examining every name finds
+func TestSearchIndex(t *testing.T) {
+	names := map[base.Address]types.Name{}
+	for _, name := range searchableNames {
+		names[name.Address] = name
+	}
+	idx := newNameIndex(names)
+
+	for _, terms := range [][]string{
+		{"usd"}, {"sd"}, {"usd coin"}, {"coin", "tag:erc20"}, {"co*"}, {"tag:exc*"}, {"unswap~"},
+		{"0x"}, {"v2:"}, {"^Tether"}, {"source:on", "usdt~2"}, {"nothing"},
+	} {
+		q, err := newQuery(terms, Expanded)
+		if err != nil {
+			t.Fatal(err)
+		}
+		candidates := map[int32]bool{}
+		for _, i := range idx.candidates(q) {
+			candidates[i] = true
+		}
+		for i, doc := range idx.docs {
+			if _, ok := q.score(doc); ok && !candidates[int32(i)] {
+				t.Errorf("%v: the index missed %s", terms, doc.name.Name)
+			}
+		}
+	}
+}
+
+func TestUsesQuerySyntax(t *testing.T) {
+	for terms, expected := range map[string]bool{
+		"uniswap":          false,
+		"^Uni.*":           false,
+		"unknown:field":    false,
+		"tag:Exchange":     true,
+		"USD*":             true,
+		"uniswop~":         true,
+		"name:Tether USD~": true,
+	} {
+		if got := UsesQuerySyntax([]string{terms}); got != expected {
+			t.Errorf("%s: expected %t, got %t", terms, expected, got)
+		}
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		max      int
+		expected int
+	}{
+		{"uniswap", "uniswap", 2, 0},
+		{"unswap", "uniswap", 2, 1},
+		{"uniswop", "uniswap", 2, 1},
+		{"kitten", "sitting", 3, 3},
+		{"kitten", "sitting", 2, -1},
+		{"usd", "usdcoin", 2, -1},
+		{"", "ab", 2, 2},
+	}
+	for _, test := range tests {
+		if got := editDistance(test.a, test.b, test.max); got != test.expected {
+			t.Errorf("editDistance(%s, %s, %d): expected %d, got %d", test.a, test.b, test.max, test.expected, got)
+		}
+	}
+}
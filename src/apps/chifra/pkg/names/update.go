@@ -3,6 +3,7 @@ package names
 import (
 	"fmt"
 
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
 )
@@ -20,24 +21,23 @@ func UpdateName(dbType DatabaseType, chain string, name *types.Name) (err error)
 }
 
 func updateCustomName(chain string, name *types.Name) (err error) {
-	loadedCustomNamesMutex.Lock()
-	defer loadedCustomNamesMutex.Unlock()
-
 	name.IsCustom = true
-	if _, ok := loadedCustomNames[name.Address]; !ok {
-		err = fmt.Errorf("no custom name for address %s", name.Address.Hex())
-		return
-	}
-
-	loadedCustomNames[name.Address] = *name
-	return
+	return getDatabase(chain, DatabaseCustom).edit(func(names map[base.Address]types.Name) error {
+		if _, ok := names[name.Address]; !ok {
+			return fmt.Errorf("no custom name for address %s", name.Address.Hex())
+		}
+		names[name.Address] = *name
+		return nil
+	})
 }
 
 func updateRegularName(chain string, name *types.Name) (err error) {
-	if _, ok := loadedRegularNames[name.Address]; !ok {
-		err = fmt.Errorf("no name for address: %s", name.Address)
-		return
-	}
-
-	return regularCreateName(chain, name)
+	name.IsCustom = false
+	return getDatabase(chain, DatabaseRegular).edit(func(names map[base.Address]types.Name) error {
+		if _, ok := names[name.Address]; !ok {
+			return fmt.Errorf("no name for address: %s", name.Address)
+		}
+		names[name.Address] = *name
+		return nil
+	})
 }
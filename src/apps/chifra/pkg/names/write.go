@@ -1,7 +1,6 @@
 package names
 
 import (
-	"errors"
 	"os"
 	"sort"
 
@@ -24,69 +23,44 @@ func WriteNames(dbType DatabaseType, chain string, dryRun bool) (err error) {
 }
 
 func customWriteNames(chain string, dryRun bool) (err error) {
-	database := DatabaseCustom
-	if dryRun {
-		database = DatabaseDryRun
-	}
-
-	return writeDatabase(
-		chain,
-		Custom,
-		database,
-		loadedCustomNames,
-	)
+	return getDatabase(chain, DatabaseCustom).save(dryRun)
 }
 
 func regularWriteNames(chain string, dryRun bool) (err error) {
-	database := DatabaseRegular
-	if dryRun {
-		database = DatabaseDryRun
-	}
-
-	return writeDatabase(
-		chain,
-		Regular,
-		database,
-		loadedRegularNames,
-	)
+	return getDatabase(chain, DatabaseRegular).save(dryRun)
 }
 
-func writeDatabase(chain string, kind Parts, database DatabaseType, names map[base.Address]types.Name) (err error) {
-	switch kind {
-	case Regular:
-		loadedRegularNamesMutex.Lock()
-		defer loadedRegularNamesMutex.Unlock()
-	case Custom:
-		loadedCustomNamesMutex.Lock()
-		defer loadedCustomNamesMutex.Unlock()
-	default:
-		return errors.New("kind should be Regular or Custom")
-	}
-
-	db, err := openDatabaseFile(chain, database, os.O_RDWR|os.O_TRUNC)
-	if err != nil {
-		return
-	}
-	defer db.Close()
+// We don't want to save test names even in test database
+var testAddresses map[string]bool = map[string]bool{
+	"0x0000000000000000000000000000000000000001": true,
+	"0x0000000000000000000000000000000000000002": true,
+	"0x0000000000000000000000000000000000000003": true,
+	"0x0000000000000000000000000000000000000004": true,
+}
 
+// writeDatabase writes the names, sorted by address, to the output
+func writeDatabase(output *os.File, kind DatabaseType, names map[base.Address]types.Name, lock bool) (err error) {
 	sorted := make([]types.Name, 0, len(names))
 	for _, name := range names {
+		if kind == DatabaseCustom && testAddresses[name.Address.Hex()] {
+			continue
+		}
 		sorted = append(sorted, name)
 	}
 	sort.SliceStable(sorted, func(i, j int) bool {
 		return sorted[i].Address.Hex() < sorted[j].Address.Hex()
 	})
 
-	if database != DatabaseDryRun {
-		if err = file.Lock(db); err != nil {
+	if lock {
+		if err = file.Lock(output); err != nil {
 			return err
 		}
 		defer func() {
-			_ = file.Unlock(db)
+			_ = file.Unlock(output)
 		}()
 	}
 
-	writer := NewNameWriter(db)
+	writer := NewNameWriter(output)
 	for _, name := range sorted {
 		if err = writer.Write(&name); err != nil {
 			return err
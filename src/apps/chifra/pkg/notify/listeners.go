@@ -0,0 +1,47 @@
+package notify
+
+import "sync"
+
+// Listeners receive notifications in-process, for example the daemon's websocket subscriptions when the
+// daemon runs the scraper. Unlike the sinks, listeners do not depend on --notify and nothing is spooled.
+
+// Listener is called with each published notification (a Notification[T]). It must not block.
+type Listener func(chain string, notification any)
+
+var (
+	listenerMutex sync.RWMutex
+	listeners     = make(map[int]Listener)
+	nextListener  = 0
+)
+
+// Listen adds a listener and returns a function that removes it
+func Listen(listener Listener) func() {
+	listenerMutex.Lock()
+	defer listenerMutex.Unlock()
+
+	id := nextListener
+	nextListener++
+	listeners[id] = listener
+	return func() {
+		listenerMutex.Lock()
+		defer listenerMutex.Unlock()
+		delete(listeners, id)
+	}
+}
+
+// Listening returns true if there are any listeners. Publishers may use it to avoid building
+// notifications no one will receive.
+func Listening() bool {
+	listenerMutex.RLock()
+	defer listenerMutex.RUnlock()
+	return len(listeners) > 0
+}
+
+// Publish delivers the notification to each of the listeners
+func Publish[T NotificationPayload](chain string, notification Notification[T]) {
+	listenerMutex.RLock()
+	defer listenerMutex.RUnlock()
+	for _, listener := range listeners {
+		listener(chain, notification)
+	}
+}
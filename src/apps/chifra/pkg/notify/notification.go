@@ -5,6 +5,9 @@ import "github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
 type Message string
 
 type Notification[T NotificationPayload] struct {
+	// Seq is assigned when the notification is spooled (see Send). Since notifications are delivered
+	// at least once, consumers may use it to ignore duplicates.
+	Seq     uint64          `json:"seq,omitempty"`
 	Msg     Message         `json:"msg"`
 	Meta    *types.MetaData `json:"meta"`
 	Payload T               `json:"payload"`
@@ -14,5 +17,7 @@ type NotificationPayload interface {
 	[]NotificationPayloadAppearance |
 		[]NotificationPayloadChunkWritten |
 		NotificationPayloadChunkWritten |
+		NotificationPayloadBlock |
+		NotificationPayloadProgress |
 		string
 }
@@ -3,6 +3,7 @@ package notify
 import (
 	"fmt"
 
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/uniq"
 )
@@ -11,6 +12,9 @@ const (
 	MessageChunkWritten Message = "chunkWritten"
 	MessageStageUpdated Message = "stageUpdated"
 	MessageAppearance   Message = "appearance"
+	MessageReorg        Message = "reorg"
+	MessageBlock        Message = "block"
+	MessageProgress     Message = "progress"
 )
 
 type NotificationPayloadAppearance struct {
@@ -45,7 +49,7 @@ func NewChunkWrittenNotification(meta *types.MetaData, chunk string) *Notificati
 
 func NewStageUpdatedNotification(meta *types.MetaData, chunkRange string) *Notification[string] {
 	return &Notification[string]{
-		Msg:     MessageChunkWritten,
+		Msg:     MessageStageUpdated,
 		Meta:    meta,
 		Payload: chunkRange,
 	}
@@ -53,7 +57,17 @@ func NewStageUpdatedNotification(meta *types.MetaData, chunkRange string) *Notif
 
 func NewAppearanceNotification(meta *types.MetaData, appearances []NotificationPayloadAppearance) *Notification[[]NotificationPayloadAppearance] {
 	return &Notification[[]NotificationPayloadAppearance]{
-		Msg:     MessageChunkWritten,
+		Msg:     MessageAppearance,
+		Meta:    meta,
+		Payload: appearances,
+	}
+}
+
+// NewReorgNotification tells listeners that the appearances, which were found in unripe blocks that
+// have since been re-orged, are no longer valid
+func NewReorgNotification(meta *types.MetaData, appearances []NotificationPayloadAppearance) *Notification[[]NotificationPayloadAppearance] {
+	return &Notification[[]NotificationPayloadAppearance]{
+		Msg:     MessageReorg,
 		Meta:    meta,
 		Payload: appearances,
 	}
@@ -64,3 +78,38 @@ type NotificationPayloadChunkWritten struct {
 	Range  string `json:"range"`
 	Author string `json:"author"`
 }
+
+// NotificationPayloadBlock describes a block the scraper has visited. Unripe blocks may yet be
+// re-orged (see MessageReorg).
+type NotificationPayloadBlock struct {
+	BlockNumber  base.Blknum    `json:"blockNumber"`
+	Timestamp    base.Timestamp `json:"timestamp"`
+	NAppearances int            `json:"nAppearances"`
+	Unripe       bool           `json:"unripe,omitempty"`
+}
+
+// NotificationPayloadProgress describes a single pass of the scraper
+type NotificationPayloadProgress struct {
+	FirstBlock base.Blknum `json:"firstBlock"`
+	LastBlock  base.Blknum `json:"lastBlock"`
+	NRipe      int         `json:"nRipe"`
+	NUnripe    int         `json:"nUnripe"`
+}
+
+// NewBlockNotification tells listeners about a block the scraper has visited
+func NewBlockNotification(meta *types.MetaData, block NotificationPayloadBlock) *Notification[NotificationPayloadBlock] {
+	return &Notification[NotificationPayloadBlock]{
+		Msg:     MessageBlock,
+		Meta:    meta,
+		Payload: block,
+	}
+}
+
+// NewProgressNotification tells listeners about a completed pass of the scraper
+func NewProgressNotification(meta *types.MetaData, progress NotificationPayloadProgress) *Notification[NotificationPayloadProgress] {
+	return &Notification[NotificationPayloadProgress]{
+		Msg:     MessageProgress,
+		Meta:    meta,
+		Payload: progress,
+	}
+}
@@ -0,0 +1,43 @@
+package notify
+
+import (
+	"net"
+	"os"
+	"time"
+)
+
+// fileSink appends each notification to a file as a line of JSON (NDJSON). The file is synced before
+// the notification is considered delivered.
+type fileSink struct {
+	path string
+}
+
+func (s *fileSink) deliver(seq uint64, body []byte) error {
+	fp, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer fp.Close()
+
+	if _, err = fp.Write(nextLine(body)); err != nil {
+		return err
+	}
+	return fp.Sync()
+}
+
+// unixSink writes each notification as a line of JSON to a listener on a unix domain socket
+type unixSink struct {
+	path string
+}
+
+func (s *unixSink) deliver(seq uint64, body []byte) error {
+	conn, err := net.DialTimeout("unix", s.path, 5*time.Second)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_ = conn.SetWriteDeadline(time.Now().Add(5 * time.Second))
+	_, err = conn.Write(nextLine(body))
+	return err
+}
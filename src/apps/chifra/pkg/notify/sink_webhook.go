@@ -0,0 +1,57 @@
+package notify
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var webhookClient = &http.Client{
+	Timeout: 10 * time.Second,
+}
+
+// webhookSink POSTs each notification as JSON. If a secret is configured, the body is signed with
+// HMAC-SHA256 and the signature sent as X-TrueBlocks-Signature: sha256=<hex>. The sequence number is
+// sent as X-TrueBlocks-Sequence. Any response other than 2xx is a failure.
+type webhookSink struct {
+	url    string
+	secret string
+}
+
+func (s *webhookSink) deliver(seq uint64, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-TrueBlocks-Sequence", strconv.FormatUint(seq, 10))
+	if len(s.secret) > 0 {
+		req.Header.Set("X-TrueBlocks-Signature", "sha256="+Sign(s.secret, body))
+	}
+
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending notification: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("listener responded with %d: %s", resp.StatusCode, respBody)
+	}
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the body. Receivers of signed webhooks may use it to
+// check the X-TrueBlocks-Signature header.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,200 @@
+package notify
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+)
+
+// sink delivers a notification (a single JSON object) to one destination. Delivery must not return
+// until the destination has accepted the notification.
+type sink interface {
+	deliver(seq uint64, body []byte) error
+}
+
+const defaultRetries = 3
+
+// newSink returns the sink described by the configuration
+func newSink(chain string, sinkConfig config.NotifySink) (sink, error) {
+	switch sinkConfig.Type {
+	case "webhook":
+		if len(sinkConfig.Url) == 0 {
+			return nil, fmt.Errorf("a webhook sink requires a url")
+		}
+		return &webhookSink{url: sinkConfig.Url, secret: sinkConfig.Secret}, nil
+	case "file":
+		if len(sinkConfig.Path) == 0 {
+			return nil, fmt.Errorf("a file sink requires a path")
+		}
+		return &fileSink{path: sinkConfig.Path}, nil
+	case "unix":
+		if len(sinkConfig.Path) == 0 {
+			return nil, fmt.Errorf("a unix sink requires a path")
+		}
+		return &unixSink{path: sinkConfig.Path}, nil
+	case "nats", "redis":
+		if len(sinkConfig.Url) == 0 {
+			return nil, fmt.Errorf("a %s sink requires a url", sinkConfig.Type)
+		}
+		topic := sinkConfig.Topic
+		if len(topic) == 0 {
+			topic = "trueblocks." + chain
+		}
+		if sinkConfig.Type == "nats" {
+			return newNatsSink(sinkConfig.Url, topic), nil
+		}
+		return newRedisSink(sinkConfig.Url, topic), nil
+	default:
+		return nil, fmt.Errorf("unknown sink type '%s' (must be webhook, file, unix, nats, or redis)", sinkConfig.Type)
+	}
+}
+
+// Sinks are kept from one delivery to the next so that those holding a connection (nats and redis)
+// reuse it. A sink is replaced if its configuration changes.
+var (
+	openSinks      = make(map[string]openSink)
+	openSinksMutex sync.Mutex
+)
+
+type openSink struct {
+	config config.NotifySink
+	sink   sink
+}
+
+// getSink returns the sink described by the configuration, reusing the one made for an earlier
+// delivery if the configuration has not changed since
+func getSink(chain string, sinkConfig config.NotifySink) (sink, error) {
+	openSinksMutex.Lock()
+	defer openSinksMutex.Unlock()
+
+	key := chain + "/" + sinkConfig.Name
+	if open, ok := openSinks[key]; ok {
+		if reflect.DeepEqual(open.config, sinkConfig) {
+			return open.sink, nil
+		}
+		if closer, ok := open.sink.(io.Closer); ok {
+			_ = closer.Close()
+		}
+		delete(openSinks, key)
+	}
+
+	s, err := newSink(chain, sinkConfig)
+	if err != nil {
+		return nil, err
+	}
+	openSinks[key] = openSink{config: sinkConfig, sink: s}
+	return s, nil
+}
+
+// deliverWithRetry tries to deliver the notification, backing off between attempts
+func deliverWithRetry(s sink, seq uint64, body []byte, retries int) (err error) {
+	if retries <= 0 {
+		retries = defaultRetries
+	}
+	wait := 250 * time.Millisecond
+	for attempt := 0; attempt <= retries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(wait)
+			wait *= 2
+		}
+		if err = s.deliver(seq, body); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// filterCache applies the sinks' filters, remembering the monitored addresses so they are only
+// read once per dispatch
+type filterCache struct {
+	chain     string
+	monitored map[base.Address]bool
+}
+
+func newFilterCache(chain string) *filterCache {
+	return &filterCache{chain: chain}
+}
+
+// apply returns the body to deliver to the sink, or false if the sink does not want the notification.
+// If the sink filters by address, appearance and reorg notifications are delivered with only the
+// appearances of those addresses. Other notifications are not filtered by address.
+func (f *filterCache) apply(sinkConfig config.NotifySink, record spoolRecord) ([]byte, bool) {
+	if len(sinkConfig.Messages) > 0 {
+		found := false
+		for _, msg := range sinkConfig.Messages {
+			if Message(msg) == record.Msg {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+	}
+
+	if len(sinkConfig.Addresses) == 0 && !sinkConfig.Monitored {
+		return record.Line, true
+	}
+	if record.Msg != MessageAppearance && record.Msg != MessageReorg {
+		return record.Line, true
+	}
+
+	notification := Notification[[]NotificationPayloadAppearance]{}
+	if err := json.Unmarshal(record.Line, &notification); err != nil {
+		// Not what we expected, so we can't filter it. Better to deliver it than to lose it.
+		return record.Line, true
+	}
+
+	wanted := make(map[base.Address]bool, len(sinkConfig.Addresses))
+	for _, addr := range sinkConfig.Addresses {
+		wanted[base.HexToAddress(addr)] = true
+	}
+
+	filtered := make([]NotificationPayloadAppearance, 0, len(notification.Payload))
+	for _, app := range notification.Payload {
+		addr := base.HexToAddress(app.Address)
+		if wanted[addr] || (sinkConfig.Monitored && f.isMonitored(addr)) {
+			filtered = append(filtered, app)
+		}
+	}
+	if len(filtered) == 0 {
+		return nil, false
+	}
+
+	notification.Payload = filtered
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return record.Line, true
+	}
+	return body, true
+}
+
+// isMonitored returns true if there is a monitor for the address
+func (f *filterCache) isMonitored(addr base.Address) bool {
+	if f.monitored == nil {
+		f.monitored = make(map[base.Address]bool)
+		paths, _ := filepath.Glob(filepath.Join(config.PathToCache(f.chain), "monitors", "*.mon.bin"))
+		for _, path := range paths {
+			if a, err := base.AddressFromPath(path, ".mon.bin"); err == nil {
+				f.monitored[a] = true
+			}
+		}
+	}
+	return f.monitored[addr]
+}
+
+// nextLine returns the body terminated with a newline (for sinks that deliver a stream of JSON lines)
+func nextLine(body []byte) []byte {
+	if strings.HasSuffix(string(body), "\n") {
+		return body
+	}
+	return append(append([]byte{}, body...), '\n')
+}
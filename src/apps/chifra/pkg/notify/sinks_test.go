@@ -0,0 +1,374 @@
+package notify
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+var testAppearance = NotificationPayloadAppearance{
+	Address:          "0xfffd8963efd1fc6a506488495d951d5263988d25",
+	BlockNumber:      "18509161",
+	TransactionIndex: 132,
+}
+
+func TestNotify(t *testing.T) {
+	results := make([]string, 0)
+	signatures := make([]string, 0)
+	var mutex sync.Mutex
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Body.Close()
+
+		mutex.Lock()
+		results = append(results, string(body))
+		signatures = append(signatures, r.Header.Get("X-TrueBlocks-Signature"))
+		mutex.Unlock()
+	}))
+	defer ts.Close()
+
+	newAppNotification := Notification[[]NotificationPayloadAppearance]{
+		Msg:     MessageAppearance,
+		Meta:    &types.MetaData{Chain: "ethereum"},
+		Payload: []NotificationPayloadAppearance{testAppearance},
+	}
+
+	expected, err := json.Marshal(newAppNotification)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sink := &webhookSink{url: ts.URL, secret: "shh"}
+	if err := sink.deliver(1, expected); err != nil {
+		t.Fatal(err)
+	}
+
+	if results[0] != string(expected) {
+		t.Fatalf("wrong result: %+v", results[0])
+	}
+	if signatures[0] != "sha256="+Sign("shh", expected) {
+		t.Fatalf("wrong signature: %s", signatures[0])
+	}
+}
+
+// useSpoolMax sets the most notifications the spool may hold for the duration of the test
+func useSpoolMax(t *testing.T, spoolMax uint64) {
+	saved := getSpoolMax
+	t.Cleanup(func() { getSpoolMax = saved })
+	getSpoolMax = func() uint64 { return spoolMax }
+}
+
+func TestDispatch(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	useSpoolMax(t, 100)
+	backoffPeriod = 0
+	chain := "mainnet"
+
+	failing := true
+	received := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failing {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		received++
+	}))
+	defer ts.Close()
+
+	outPath := filepath.Join(t.TempDir(), "out.ndjson")
+	sinks := []config.NotifySink{
+		{Name: "hook", Type: "webhook", Url: ts.URL, Retries: 1},
+		{Name: "deposits", Type: "file", Path: outPath, Messages: []string{"appearance"}, Addresses: []string{testAppearance.Address}},
+	}
+
+	other := testAppearance
+	other.Address = "0x0000000000000000000000000000000000000001"
+	_ = writeTestSpool(t, chain, []any{
+		NewAppearanceNotification(nil, []NotificationPayloadAppearance{testAppearance, other}),
+		NewStageUpdatedNotification(nil, "000000000-000000001"),
+		NewAppearanceNotification(nil, []NotificationPayloadAppearance{other}),
+	})
+
+	dispatch(chain, sinks)
+
+	// The file sink received only the matching appearance...
+	lines := readLines(t, outPath)
+	if len(lines) != 1 || strings.Contains(lines[0], other.Address) || !strings.Contains(lines[0], testAppearance.Address) {
+		t.Fatal("wrong notifications in file sink", lines)
+	}
+	// ...but since the webhook failed, everything is still spooled
+	if records, _ := readSpool(chain); len(records) != 3 {
+		t.Fatal("expected the spool to be kept", len(records))
+	}
+	if cursor, _ := readUint(cursorPath(chain, "deposits")); cursor != 3 {
+		t.Error("wrong cursor for the file sink", cursor)
+	}
+
+	failing = false
+	dispatch(chain, sinks)
+	if received != 3 {
+		t.Error("expected the webhook to receive every notification", received)
+	}
+	if len(readLines(t, outPath)) != 1 {
+		t.Error("the file sink should not receive notifications twice")
+	}
+	if records, _ := readSpool(chain); len(records) != 0 {
+		t.Error("expected the spool to be emptied", len(records))
+	}
+}
+
+func TestTrimSpool(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	useSpoolMax(t, 2)
+	chain := "mainnet"
+
+	notifications := []any{}
+	for i := 0; i < 5; i++ {
+		notifications = append(notifications, NewStageUpdatedNotification(nil, "000000000-000000001"))
+	}
+	_ = writeTestSpool(t, chain, notifications)
+	_ = writeUint(cursorPath(chain, "behind"), 1)
+	_ = writeUint(cursorPath(chain, "current"), 4)
+
+	sinks := []config.NotifySink{{Name: "behind"}, {Name: "current"}}
+	records, _ := readSpool(chain)
+	kept, err := trimSpool(chain, records, sinks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kept) != 2 || kept[0].Seq != 4 {
+		t.Fatal("expected the newest two notifications to be kept, got", len(kept))
+	}
+	if records, _ := readSpool(chain); len(records) != 2 || records[0].Seq != 4 {
+		t.Error("expected the spool to hold the newest two notifications, got", len(records))
+	}
+	// The sink that was behind skips the dropped notifications. The other is not changed.
+	if cursor, _ := readUint(cursorPath(chain, "behind")); cursor != 3 {
+		t.Error("wrong cursor for the sink that was behind", cursor)
+	}
+	if cursor, _ := readUint(cursorPath(chain, "current")); cursor != 4 {
+		t.Error("wrong cursor for the sink that was current", cursor)
+	}
+}
+
+// fakeNats is a nats server that closes the connection after closeAfter messages, if it is not zero
+func fakeNats(t *testing.T, closeAfter int) chan string {
+	return fakeServer(t, func(conn net.Conn, reader *bufio.Reader, received chan<- string) {
+		_, _ = conn.Write([]byte("INFO {}\r\n"))
+		nMessages := 0
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			switch line = strings.TrimSpace(line); {
+			case strings.HasPrefix(line, "CONNECT"):
+				received <- "CONNECT"
+			case strings.HasPrefix(line, "PUB"):
+				payload, _ := reader.ReadString('\n')
+				received <- line + " " + strings.TrimSpace(payload)
+				nMessages++
+			case line == "PING":
+				_, _ = conn.Write([]byte("PONG\r\n"))
+				if nMessages == closeAfter {
+					return
+				}
+			}
+		}
+	})
+}
+
+func TestNatsSink(t *testing.T) {
+	body := []byte(`{"seq":7,"msg":"reorg"}`)
+	received := fakeNats(t, 0)
+
+	sink := newNatsSink("nats://"+<-received, "trueblocks.mainnet")
+	defer sink.Close()
+	for i := 0; i < 2; i++ {
+		if err := sink.deliver(7, body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The connection is kept open for the second notification
+	for _, expected := range []string{"CONNECT", "PUB trueblocks.mainnet 23 " + string(body), "PUB trueblocks.mainnet 23 " + string(body)} {
+		if got := <-received; got != expected {
+			t.Error("expected", expected, "got", got)
+		}
+	}
+}
+
+func TestNatsSinkReconnects(t *testing.T) {
+	body := []byte(`{"seq":7,"msg":"reorg"}`)
+	received := fakeNats(t, 1)
+
+	sink := newNatsSink("nats://"+<-received, "trueblocks.mainnet")
+	defer sink.Close()
+	for i := 0; i < 2; i++ {
+		if err := sink.deliver(7, body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The server closed the connection after the first notification, so the second reconnects
+	pub := "PUB trueblocks.mainnet 23 " + string(body)
+	for _, expected := range []string{"CONNECT", pub, "CONNECT", pub} {
+		if got := <-received; got != expected {
+			t.Error("expected", expected, "got", got)
+		}
+	}
+}
+
+func TestRedisSink(t *testing.T) {
+	body := []byte(`{"seq":7,"msg":"reorg"}`)
+	received := fakeServer(t, func(conn net.Conn, reader *bufio.Reader, received chan<- string) {
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			n, _ := strconv.Atoi(strings.TrimSpace(line)[1:])
+			args := make([]string, 0, n)
+			for i := 0; i < n; i++ {
+				_, _ = reader.ReadString('\n') // the length
+				arg, _ := reader.ReadString('\n')
+				args = append(args, strings.TrimSpace(arg))
+			}
+			received <- strings.Join(args, " ")
+			if args[0] == "AUTH" || args[0] == "SELECT" {
+				_, _ = conn.Write([]byte("+OK\r\n"))
+			} else {
+				_, _ = conn.Write([]byte("$3\r\n1-0\r\n"))
+			}
+		}
+	})
+
+	sink := newRedisSink("redis://:secret@"+<-received+"/2", "trueblocks.mainnet")
+	defer sink.Close()
+	for i := 0; i < 2; i++ {
+		if err := sink.deliver(7, body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The connection is authenticated once and kept open for the second notification
+	xadd := "XADD trueblocks.mainnet * seq 7 notification " + string(body)
+	for _, expected := range []string{"AUTH secret", "SELECT 2", xadd, xadd} {
+		if got := <-received; got != expected {
+			t.Error("expected", expected, "got", got)
+		}
+	}
+}
+
+// fakeServer starts a server that speaks just enough of a protocol for a sink. Its address is the
+// first value sent on the returned channel. The handler sends what it receives on the channel.
+func fakeServer(t *testing.T, handle func(conn net.Conn, reader *bufio.Reader, received chan<- string)) chan string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	received := make(chan string, 10)
+	received <- listener.Addr().String()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				handle(conn, bufio.NewReader(conn), received)
+			}()
+		}
+	}()
+	return received
+}
+
+func writeTestSpool(t *testing.T, chain string, notifications []any) error {
+	if err := os.MkdirAll(spoolFolder(chain), 0755); err != nil {
+		t.Fatal(err)
+	}
+	var sb strings.Builder
+	for i, n := range notifications {
+		bytes, _ := json.Marshal(n)
+		line := strings.Replace(string(bytes), `{"msg"`, `{"seq":`+strconv.Itoa(i+1)+`,"msg"`, 1)
+		sb.WriteString(line + "\n")
+	}
+	return os.WriteFile(spoolPath(chain), []byte(sb.String()), 0644)
+}
+
+func readLines(t *testing.T, path string) []string {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Split(strings.TrimSpace(string(contents)), "\n")
+}
+
+func TestSendDoesNotWaitForSinks(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	useSpoolMax(t, 100)
+	chain := "sepolia"
+
+	release := make(chan struct{})
+	var received sync.WaitGroup
+	received.Add(1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		received.Done()
+	}))
+	defer ts.Close()
+
+	saved := getSinks
+	defer func() { getSinks = saved }()
+	getSinks = func() []config.NotifySink {
+		return []config.NotifySink{{Name: "slow", Type: "webhook", Url: ts.URL, Retries: 1}}
+	}
+
+	sent := make(chan error)
+	go func() {
+		sent <- Send(chain, *NewStageUpdatedNotification(nil, "000000000-000000001"))
+	}()
+	select {
+	case err := <-sent:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Send waited for the sink")
+	}
+
+	// The notification is spooled until the sink accepts it...
+	if records, _ := readSpool(chain); len(records) != 1 {
+		t.Fatal("expected the notification to be spooled", len(records))
+	}
+
+	// ...and is then delivered in the background
+	close(release)
+	received.Wait()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if cursor, _ := readUint(cursorPath(chain, "slow")); cursor == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the notification was not delivered")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
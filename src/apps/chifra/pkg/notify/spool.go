@@ -0,0 +1,347 @@
+package notify
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/metrics"
+)
+
+// Notifications are written to a spool before they are delivered so that each sink receives every
+// notification at least once, even if the sink is unavailable for a time or the scraper is restarted.
+// Each sink records the sequence number of the last notification it accepted in a cursor file. A
+// notification is removed from the spool once every sink has accepted it. So that a sink that is gone
+// for good does not fill the disk, the spool holds at most config.GetNotifySpoolMax notifications.
+
+var spoolMutex sync.Mutex
+
+// A sink that fails is not tried again until its backoff has passed, so an unavailable sink does not
+// hold up the delivery of notifications to the other sinks. Its notifications wait in the spool.
+var (
+	sinkBackoff   = make(map[string]time.Time)
+	backoffMutex  sync.Mutex
+	backoffPeriod = 30 * time.Second
+)
+
+// Notifications are delivered by a goroutine per chain so that Send (which the scraper calls as it
+// writes appearances) never waits on a sink. Sending on a chain's channel wakes its deliverer.
+var (
+	deliverers      = make(map[string]chan struct{})
+	deliverersMutex sync.Mutex
+	getSinks        = config.GetNotifySinks
+	getSpoolMax     = config.GetNotifySpoolMax
+)
+
+// spoolRecord is a single notification in the spool
+type spoolRecord struct {
+	Seq  uint64
+	Msg  Message
+	Line []byte
+}
+
+// spoolFolder returns the folder holding the chain's spool and the sinks' cursors
+func spoolFolder(chain string) string {
+	return filepath.Join(config.PathToCache(chain), "notify")
+}
+
+func spoolPath(chain string) string {
+	return filepath.Join(spoolFolder(chain), "spool.ndjson")
+}
+
+func sequencePath(chain string) string {
+	return filepath.Join(spoolFolder(chain), "sequence")
+}
+
+func cursorPath(chain, sinkName string) string {
+	return filepath.Join(spoolFolder(chain), sinkName+".cursor")
+}
+
+// Send spools the notification and wakes the chain's deliverer, which delivers every pending
+// notification to each of the configured sinks (see config.GetNotifySinks) in the background. An error
+// is returned only if the notification could not be spooled. Sinks that cannot be reached are reported
+// and will receive the notification on a later delivery.
+func Send[T NotificationPayload](chain string, notification Notification[T]) error {
+	spoolMutex.Lock()
+	defer spoolMutex.Unlock()
+
+	if err := file.EstablishFolder(spoolFolder(chain)); err != nil {
+		return err
+	}
+
+	seq, err := readUint(sequencePath(chain))
+	if err != nil {
+		return err
+	}
+	notification.Seq = seq + 1
+
+	line, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("marshalling message: %w", err)
+	}
+
+	fp, err := os.OpenFile(spoolPath(chain), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	// If we were interrupted while writing the last line, terminate it so it's ignored (see readSpool)
+	if info, err := fp.Stat(); err == nil && info.Size() > 0 && !endsWithNewline(spoolPath(chain), info.Size()) {
+		line = append([]byte{'\n'}, line...)
+	}
+	if _, err = fp.Write(append(line, '\n')); err == nil {
+		err = fp.Sync()
+	}
+	fp.Close()
+	if err != nil {
+		return err
+	}
+	if err = writeUint(sequencePath(chain), notification.Seq); err != nil {
+		return err
+	}
+
+	wake(chain)
+	return nil
+}
+
+// Flush asks the chain's deliverer to deliver any notifications left in the spool by sinks that were
+// previously unavailable. It does not wait for the delivery.
+func Flush(chain string) {
+	wake(chain)
+}
+
+// wake starts the chain's deliverer if it isn't running and asks it to deliver the spool. If a
+// delivery is already pending, that delivery picks up the latest notifications.
+func wake(chain string) {
+	deliverersMutex.Lock()
+	defer deliverersMutex.Unlock()
+
+	ch, ok := deliverers[chain]
+	if !ok {
+		ch = make(chan struct{}, 1)
+		deliverers[chain] = ch
+		go func() {
+			for range ch {
+				dispatch(chain, getSinks())
+			}
+		}()
+	}
+
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
+
+// dispatch delivers the spooled notifications to each sink in order, stopping for a given sink at
+// the first failure. Once every sink has accepted every notification, the spool is emptied.
+func dispatch(chain string, sinkConfigs []config.NotifySink) {
+	spoolMutex.Lock()
+	records, err := readSpool(chain)
+	if err == nil {
+		records, err = trimSpool(chain, records, sinkConfigs)
+	}
+	spoolMutex.Unlock()
+	if err != nil {
+		logger.Warn("could not read the notification spool:", err)
+		return
+	} else if len(records) == 0 {
+		return
+	}
+
+	last := records[len(records)-1].Seq
+	allDelivered := true
+	filters := newFilterCache(chain)
+	for _, sinkConfig := range sinkConfigs {
+		if len(sinkConfig.Name) == 0 {
+			logger.Warn("notification sinks must have a name")
+			continue
+		}
+
+		cursor, err := readUint(cursorPath(chain, sinkConfig.Name))
+		if err != nil {
+			logger.Warn("could not read the cursor for notification sink", sinkConfig.Name, err)
+			allDelivered = false
+			continue
+		}
+		if cursor >= last {
+			continue
+		}
+		if backingOff(chain, sinkConfig.Name) {
+			allDelivered = false
+			continue
+		}
+
+		theSink, err := getSink(chain, sinkConfig)
+		if err != nil {
+			logger.Warn("notification sink", sinkConfig.Name, "is misconfigured:", err)
+			allDelivered = false
+			continue
+		}
+
+		for _, record := range records {
+			if record.Seq <= cursor {
+				continue
+			}
+			if body, ok := filters.apply(sinkConfig, record); ok {
+				if err = deliverWithRetry(theSink, record.Seq, body, sinkConfig.Retries); err != nil {
+					logger.Warn("could not deliver notification", record.Seq, "to", sinkConfig.Name+":", err)
+					backOff(chain, sinkConfig.Name)
+					break
+				}
+			}
+			cursor = record.Seq
+			if err = writeUint(cursorPath(chain, sinkConfig.Name), cursor); err != nil {
+				logger.Warn("could not write the cursor for notification sink", sinkConfig.Name, err)
+				break
+			}
+		}
+
+		if cursor < last {
+			allDelivered = false
+		}
+	}
+
+	if allDelivered {
+		spoolMutex.Lock()
+		defer spoolMutex.Unlock()
+		// Notifications spooled while we were delivering are kept for the next delivery
+		if current, err := readSpool(chain); err != nil || len(current) == 0 || current[len(current)-1].Seq != last {
+			return
+		}
+		if err := os.Remove(spoolPath(chain)); err != nil && !os.IsNotExist(err) {
+			logger.Warn("could not empty the notification spool:", err)
+		}
+	}
+}
+
+// trimSpool drops the oldest notifications if the spool holds more than it may. Each sink that had
+// not accepted them skips them, and the number it missed is reported and counted in the metrics. The
+// caller must hold spoolMutex.
+func trimSpool(chain string, records []spoolRecord, sinkConfigs []config.NotifySink) ([]spoolRecord, error) {
+	spoolMax := getSpoolMax()
+	if uint64(len(records)) <= spoolMax {
+		return records, nil
+	}
+
+	dropped, kept := records[:uint64(len(records))-spoolMax], records[uint64(len(records))-spoolMax:]
+	lastDropped := dropped[len(dropped)-1].Seq
+	for _, sinkConfig := range sinkConfigs {
+		if len(sinkConfig.Name) == 0 {
+			continue
+		}
+		cursor, err := readUint(cursorPath(chain, sinkConfig.Name))
+		if err != nil || cursor >= lastDropped {
+			continue
+		}
+		nMissed := 0
+		for _, record := range dropped {
+			if record.Seq > cursor {
+				nMissed++
+			}
+		}
+		logger.Warn(nMissed, "notifications were dropped from the spool before notification sink", sinkConfig.Name, "accepted them")
+		metrics.NotificationsDropped(chain, sinkConfig.Name, nMissed)
+		if err = writeUint(cursorPath(chain, sinkConfig.Name), lastDropped); err != nil {
+			return nil, err
+		}
+	}
+
+	var contents bytes.Buffer
+	for _, record := range kept {
+		contents.Write(record.Line)
+		contents.WriteByte('\n')
+	}
+	tmpPath := spoolPath(chain) + ".tmp"
+	if err := os.WriteFile(tmpPath, contents.Bytes(), 0644); err != nil {
+		return nil, err
+	}
+	return kept, os.Rename(tmpPath, spoolPath(chain))
+}
+
+func backingOff(chain, sinkName string) bool {
+	backoffMutex.Lock()
+	defer backoffMutex.Unlock()
+	return time.Now().Before(sinkBackoff[chain+sinkName])
+}
+
+func backOff(chain, sinkName string) {
+	backoffMutex.Lock()
+	defer backoffMutex.Unlock()
+	sinkBackoff[chain+sinkName] = time.Now().Add(backoffPeriod)
+}
+
+// readSpool returns the notifications in the spool. A partially written last line (if we were
+// interrupted while writing it) is ignored.
+func readSpool(chain string) ([]spoolRecord, error) {
+	fp, err := os.Open(spoolPath(chain))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer fp.Close()
+
+	ret := make([]spoolRecord, 0)
+	reader := bufio.NewReader(fp)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			break
+		}
+		line = bytes.TrimSpace(line)
+		header := struct {
+			Seq uint64  `json:"seq"`
+			Msg Message `json:"msg"`
+		}{}
+		if len(line) == 0 || json.Unmarshal(line, &header) != nil {
+			continue
+		}
+		ret = append(ret, spoolRecord{Seq: header.Seq, Msg: header.Msg, Line: line})
+	}
+	return ret, nil
+}
+
+func endsWithNewline(path string, size int64) bool {
+	fp, err := os.Open(path)
+	if err != nil {
+		return true
+	}
+	defer fp.Close()
+	last := make([]byte, 1)
+	if _, err := fp.ReadAt(last, size-1); err != nil {
+		return true
+	}
+	return last[0] == '\n'
+}
+
+// readUint reads a sequence number or cursor from the file, returning zero if the file does not exist
+func readUint(path string) (uint64, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(contents)), 10, 64)
+}
+
+// writeUint writes the value to a temporary file and renames it, so the file is never left half written
+func writeUint(path string, value uint64) error {
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, []byte(strconv.FormatUint(value, 10)+"\n"), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
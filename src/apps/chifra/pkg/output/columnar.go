@@ -0,0 +1,452 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/apache/arrow/go/v16/arrow"
+	"github.com/apache/arrow/go/v16/arrow/array"
+	"github.com/apache/arrow/go/v16/arrow/decimal256"
+	"github.com/apache/arrow/go/v16/arrow/ipc"
+	"github.com/apache/arrow/go/v16/arrow/memory"
+	"github.com/apache/arrow/go/v16/parquet"
+	"github.com/apache/arrow/go/v16/parquet/compress"
+	"github.com/apache/arrow/go/v16/parquet/pqarrow"
+)
+
+// IsColumnarFormat returns true for the binary, typed formats (Parquet and the Arrow IPC stream)
+func IsColumnarFormat(format string) bool {
+	return format == "parquet" || format == "arrow"
+}
+
+// columnarBatchSize is the number of rows buffered before they are written as a Parquet row group
+// or an Arrow record batch. It bounds the memory used by large exports.
+const columnarBatchSize = 8192
+
+// weiPrecision is the largest decimal precision Arrow supports. Values that do not fit are null.
+const weiPrecision = 76
+
+var maxWei = new(big.Int).Sub(new(big.Int).Exp(big.NewInt(10), big.NewInt(weiPrecision), nil), big.NewInt(1))
+
+type columnKind int
+
+const (
+	colString columnKind = iota
+	colJson
+	colBool
+	colInt
+	colUint
+	colFloat
+	colAddress
+	colHash
+	colWei
+)
+
+var (
+	addressType = reflect.TypeOf(base.Address{})
+	hashType    = reflect.TypeOf(base.Hash{})
+	weiType     = reflect.TypeOf(base.Wei{})
+)
+
+// columnarWriter writes models as Parquet or as an Arrow IPC stream. The schema is derived from
+// the first model. Its columns are the model's Order followed by the fields only the verbose model
+// shows and then by the remaining fields of the underlying struct, so that fields the first model
+// happens not to show (for example, those shown only when non-zero) are not lost for the rows that
+// follow. The types of the struct's fields (falling back to the values in the model's Data) give the
+// columns' types. Addresses and hashes are stored as fixed-size binary, wei as 76-digit decimals, and
+// nested values as JSON strings.
+type columnarWriter struct {
+	w       io.Writer
+	format  string
+	chain   string
+	extra   map[string]any
+	keys    []string
+	kinds   []columnKind
+	schema  *arrow.Schema
+	builder *array.RecordBuilder
+	rows    int
+	pw      *pqarrow.FileWriter
+	iw      *ipc.Writer
+	dropped map[string]bool
+}
+
+func newColumnarWriter(w io.Writer, format, chain string, extra map[string]any) *columnarWriter {
+	return &columnarWriter{
+		w:       w,
+		format:  format,
+		chain:   chain,
+		extra:   extra,
+		dropped: make(map[string]bool),
+	}
+}
+
+func (cw *columnarWriter) write(modeler types.Modeler, model types.Model) error {
+	if cw.schema == nil {
+		if err := cw.init(modeler, model); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range model.Order {
+		if !cw.dropped[key] && !cw.schema.HasField(key) {
+			cw.dropped[key] = true
+			logger.Warn(fmt.Sprintf("field %s is not in the %s schema and will be dropped", key, cw.format))
+		}
+	}
+
+	for i, key := range cw.keys {
+		appendValue(cw.builder.Field(i), cw.kinds[i], model.Data[key])
+	}
+
+	cw.rows++
+	if cw.rows >= columnarBatchSize {
+		return cw.flush()
+	}
+	return nil
+}
+
+func (cw *columnarWriter) init(modeler types.Modeler, model types.Model) error {
+	fieldNames, fieldTypes := structFields(modeler)
+	verbose := modeler.Model(cw.chain, cw.format, true, cw.extra)
+
+	keys := make([]string, 0, len(model.Order)+len(fieldNames))
+	seen := make(map[string]bool, cap(keys))
+	for _, list := range [][]string{model.Order, verbose.Order, fieldNames} {
+		for _, key := range list {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	fields := make([]arrow.Field, 0, len(keys))
+	for _, key := range keys {
+		t, ok := fieldTypes[key]
+		if !ok && model.Data[key] != nil {
+			t = reflect.TypeOf(model.Data[key])
+		} else if !ok && verbose.Data[key] != nil {
+			t = reflect.TypeOf(verbose.Data[key])
+		}
+		kind := kindOf(t)
+		cw.keys = append(cw.keys, key)
+		cw.kinds = append(cw.kinds, kind)
+		fields = append(fields, arrow.Field{
+			Name:     key,
+			Type:     arrowType(kind),
+			Nullable: true,
+			Metadata: arrowMetadata(kind),
+		})
+	}
+
+	return cw.open(fields)
+}
+
+// open creates the file (or stream) writer for a schema with the given fields
+func (cw *columnarWriter) open(fields []arrow.Field) error {
+	cw.schema = arrow.NewSchema(fields, nil)
+	cw.builder = array.NewRecordBuilder(memory.DefaultAllocator, cw.schema)
+
+	var err error
+	switch cw.format {
+	case "parquet":
+		props := parquet.NewWriterProperties(
+			parquet.WithCompression(compress.Codecs.Snappy),
+			parquet.WithMaxRowGroupLength(columnarBatchSize),
+		)
+		cw.pw, err = pqarrow.NewFileWriter(cw.schema, cw.w, props, pqarrow.DefaultWriterProps())
+	case "arrow":
+		cw.iw = ipc.NewWriter(cw.w, ipc.WithSchema(cw.schema), ipc.WithAllocator(memory.DefaultAllocator))
+	default:
+		err = fmt.Errorf("unknown format %s", cw.format)
+	}
+	return err
+}
+
+// flush writes the buffered rows as a single row group (or record batch)
+func (cw *columnarWriter) flush() error {
+	if cw.rows == 0 {
+		return nil
+	}
+
+	rec := cw.builder.NewRecord()
+	defer rec.Release()
+	cw.rows = 0
+
+	if cw.pw != nil {
+		return cw.pw.Write(rec)
+	}
+	return cw.iw.Write(rec)
+}
+
+// close flushes any buffered rows and writes the Parquet footer (or the end of the Arrow stream).
+// If there were no models, the output has no columns, but is still a valid file (or stream).
+func (cw *columnarWriter) close() error {
+	if cw.schema == nil {
+		if err := cw.open([]arrow.Field{}); err != nil {
+			return err
+		}
+	}
+
+	err := cw.flush()
+	cw.builder.Release()
+	if cw.pw != nil {
+		if cerr := cw.pw.Close(); err == nil {
+			err = cerr
+		}
+	} else {
+		if cerr := cw.iw.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// structFields returns the json field names of the model's underlying struct, in order, and maps
+// each to its type
+func structFields(modeler types.Modeler) ([]string, map[string]reflect.Type) {
+	names := []string{}
+	fieldTypes := make(map[string]reflect.Type)
+	t := reflect.TypeOf(modeler)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return names, fieldTypes
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			names = append(names, name)
+			fieldTypes[name] = field.Type
+		}
+	}
+	return names, fieldTypes
+}
+
+func kindOf(t reflect.Type) columnKind {
+	if t == nil {
+		return colString
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t {
+	case addressType:
+		return colAddress
+	case hashType:
+		return colHash
+	case weiType:
+		return colWei
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return colBool
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return colInt
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return colUint
+	case reflect.Float32, reflect.Float64:
+		return colFloat
+	case reflect.String:
+		return colString
+	default:
+		return colJson
+	}
+}
+
+func arrowType(kind columnKind) arrow.DataType {
+	switch kind {
+	case colBool:
+		return arrow.FixedWidthTypes.Boolean
+	case colInt:
+		return arrow.PrimitiveTypes.Int64
+	case colUint:
+		return arrow.PrimitiveTypes.Uint64
+	case colFloat:
+		return arrow.PrimitiveTypes.Float64
+	case colAddress:
+		return &arrow.FixedSizeBinaryType{ByteWidth: 20}
+	case colHash:
+		return &arrow.FixedSizeBinaryType{ByteWidth: 32}
+	case colWei:
+		return &arrow.Decimal256Type{Precision: weiPrecision, Scale: 0}
+	default:
+		return arrow.BinaryTypes.String
+	}
+}
+
+// arrowMetadata tells readers what the untyped columns hold
+func arrowMetadata(kind columnKind) arrow.Metadata {
+	switch kind {
+	case colAddress:
+		return arrow.MetadataFrom(map[string]string{"trueblocks.type": "address"})
+	case colHash:
+		return arrow.MetadataFrom(map[string]string{"trueblocks.type": "hash"})
+	case colWei:
+		return arrow.MetadataFrom(map[string]string{"trueblocks.type": "wei"})
+	case colJson:
+		return arrow.MetadataFrom(map[string]string{"trueblocks.type": "json"})
+	default:
+		return arrow.Metadata{}
+	}
+}
+
+// appendValue appends v to the column, converting it from the forms models use (for example,
+// wei as decimal strings or addresses as hex strings). Missing or unconvertible values are null.
+func appendValue(b array.Builder, kind columnKind, v any) {
+	if v == nil {
+		b.AppendNull()
+		return
+	}
+
+	switch kind {
+	case colBool:
+		if val, ok := v.(bool); ok {
+			b.(*array.BooleanBuilder).Append(val)
+		} else if val, err := strconv.ParseBool(fmt.Sprint(v)); err == nil {
+			b.(*array.BooleanBuilder).Append(val)
+		} else {
+			b.AppendNull()
+		}
+
+	case colInt:
+		if val, ok := toInt64(v); ok {
+			b.(*array.Int64Builder).Append(val)
+		} else {
+			b.AppendNull()
+		}
+
+	case colUint:
+		if val, ok := toUint64(v); ok {
+			b.(*array.Uint64Builder).Append(val)
+		} else {
+			b.AppendNull()
+		}
+
+	case colFloat:
+		if val, ok := toFloat64(v); ok {
+			b.(*array.Float64Builder).Append(val)
+		} else {
+			b.AppendNull()
+		}
+
+	case colAddress:
+		var addr base.Address
+		switch val := v.(type) {
+		case base.Address:
+			addr = val
+		case *base.Address:
+			addr = *val
+		default:
+			if !base.IsValidAddress(fmt.Sprint(v)) {
+				b.AppendNull()
+				return
+			}
+			addr = base.HexToAddress(fmt.Sprint(v))
+		}
+		b.(*array.FixedSizeBinaryBuilder).Append(addr.Bytes())
+
+	case colHash:
+		var hash base.Hash
+		switch val := v.(type) {
+		case base.Hash:
+			hash = val
+		case *base.Hash:
+			hash = *val
+		default:
+			str := fmt.Sprint(v)
+			if !strings.HasPrefix(str, "0x") {
+				b.AppendNull()
+				return
+			}
+			hash = base.HexToHash(str)
+		}
+		b.(*array.FixedSizeBinaryBuilder).Append(hash.Bytes())
+
+	case colWei:
+		var i *big.Int
+		switch val := v.(type) {
+		case base.Wei:
+			i = val.BigInt()
+		case *base.Wei:
+			i = val.BigInt()
+		default:
+			var ok bool
+			if i, ok = new(big.Int).SetString(fmt.Sprint(v), 10); !ok {
+				b.AppendNull()
+				return
+			}
+		}
+		if new(big.Int).Abs(i).Cmp(maxWei) > 0 {
+			b.AppendNull()
+			return
+		}
+		b.(*array.Decimal256Builder).Append(decimal256.FromBigInt(i))
+
+	case colJson:
+		bytes, err := json.Marshal(v)
+		if err != nil {
+			b.AppendNull()
+			return
+		}
+		b.(*array.StringBuilder).Append(string(bytes))
+
+	default:
+		b.(*array.StringBuilder).Append(fmt.Sprint(v))
+	}
+}
+
+func toInt64(v any) (int64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return int64(rv.Float()), true
+	default:
+		val, err := strconv.ParseInt(fmt.Sprint(v), 10, 64)
+		return val, err == nil
+	}
+}
+
+func toUint64(v any) (uint64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return rv.Uint(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return uint64(rv.Int()), rv.Int() >= 0
+	case reflect.Float32, reflect.Float64:
+		return uint64(rv.Float()), rv.Float() >= 0
+	default:
+		val, err := strconv.ParseUint(fmt.Sprint(v), 10, 64)
+		return val, err == nil
+	}
+}
+
+func toFloat64(v any) (float64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	default:
+		val, err := strconv.ParseFloat(fmt.Sprint(v), 64)
+		return val, err == nil
+	}
+}
@@ -0,0 +1,168 @@
+package output
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/apache/arrow/go/v16/arrow"
+	"github.com/apache/arrow/go/v16/arrow/array"
+	"github.com/apache/arrow/go/v16/arrow/ipc"
+	"github.com/apache/arrow/go/v16/arrow/memory"
+	"github.com/apache/arrow/go/v16/parquet"
+	"github.com/apache/arrow/go/v16/parquet/pqarrow"
+)
+
+func streamColumnar(t *testing.T, format string, nRows int) *bytes.Buffer {
+	accountedFor := base.HexToAddress("0xf503017d7baf7fbc0fff7492b751025c6a78179b")
+	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
+		for i := 0; i < nRows; i++ {
+			s := types.Statement{
+				AccountedFor: accountedFor,
+				AssetAddr:    base.FAKE_ETH_ADDRESS,
+				AssetSymbol:  "WEI",
+				BlockNumber:  base.Blknum(i),
+				Decimals:     18,
+				SpotPrice:    2000,
+			}
+			s.AmountIn.SetString("123456789012345678901234567890", 10)
+			modelChan <- &s
+		}
+	}
+
+	buffer := new(bytes.Buffer)
+	if err := StreamMany(context.Background(), fetchData, OutputOptions{Format: format, Writer: buffer}); err != nil {
+		t.Fatal(format, err)
+	}
+	return buffer
+}
+
+func checkColumnarSchema(t *testing.T, format string, schema *arrow.Schema) {
+	want := map[string]arrow.Type{
+		"accountedFor": arrow.FIXED_SIZE_BINARY,
+		"amountIn":     arrow.DECIMAL256,
+		"blockNumber":  arrow.UINT64,
+		"spotPrice":    arrow.FLOAT64,
+		"reconciled":   arrow.BOOL,
+		"assetSymbol":  arrow.STRING,
+	}
+	for name, typ := range want {
+		fields, ok := schema.FieldsByName(name)
+		if !ok {
+			t.Errorf("%s: missing field %s", format, name)
+			continue
+		}
+		if fields[0].Type.ID() != typ {
+			t.Errorf("%s: field %s has type %s, want %s", format, name, fields[0].Type, typ)
+		}
+	}
+}
+
+func TestColumnarParquet(t *testing.T) {
+	nRows := columnarBatchSize + 5
+	buffer := streamColumnar(t, "parquet", nRows)
+
+	table, err := pqarrow.ReadTable(context.Background(), bytes.NewReader(buffer.Bytes()), parquet.NewReaderProperties(nil), pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer table.Release()
+
+	if table.NumRows() != int64(nRows) {
+		t.Errorf("expected %d rows, got %d", nRows, table.NumRows())
+	}
+	checkColumnarSchema(t, "parquet", table.Schema())
+
+	idx := table.Schema().FieldIndices("amountIn")[0]
+	amounts := table.Column(idx).Data().Chunk(0).(*array.Decimal256)
+	if got := amounts.Value(0).BigInt().String(); got != "123456789012345678901234567890" {
+		t.Errorf("expected amountIn to round trip, got %s", got)
+	}
+}
+
+func TestColumnarArrow(t *testing.T) {
+	buffer := streamColumnar(t, "arrow", 3)
+
+	reader, err := ipc.NewReader(buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Release()
+	checkColumnarSchema(t, "arrow", reader.Schema())
+
+	rows := int64(0)
+	for reader.Next() {
+		rec := reader.Record()
+		rows += rec.NumRows()
+		addrs := rec.Column(rec.Schema().FieldIndices("accountedFor")[0]).(*array.FixedSizeBinary)
+		if got := base.BytesToAddress(addrs.Value(0)); got.Hex() != "0xf503017d7baf7fbc0fff7492b751025c6a78179b" {
+			t.Errorf("expected accountedFor to round trip, got %s", got.Hex())
+		}
+	}
+	if rows != 3 {
+		t.Errorf("expected 3 rows, got %d", rows)
+	}
+}
+
+// sparseRow shows its note only when there is one
+type sparseRow struct {
+	Id   uint64 `json:"id"`
+	Note string `json:"note"`
+}
+
+func (s *sparseRow) Model(chain, format string, verbose bool, extraOpts map[string]any) types.Model {
+	model := types.Model{
+		Data:  map[string]any{"id": s.Id},
+		Order: []string{"id"},
+	}
+	if len(s.Note) > 0 {
+		model.Data["note"] = s.Note
+		model.Order = append(model.Order, "note")
+	}
+	return model
+}
+
+func TestColumnarLaterFields(t *testing.T) {
+	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
+		modelChan <- &sparseRow{Id: 1}
+		modelChan <- &sparseRow{Id: 2, Note: "shown"}
+	}
+
+	buffer := new(bytes.Buffer)
+	if err := StreamMany(context.Background(), fetchData, OutputOptions{Format: "arrow", Writer: buffer}); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := ipc.NewReader(buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Release()
+	if !reader.Next() {
+		t.Fatal("expected a record")
+	}
+	rec := reader.Record()
+	indices := rec.Schema().FieldIndices("note")
+	if len(indices) != 1 {
+		t.Fatal("expected a note column")
+	}
+	notes := rec.Column(indices[0]).(*array.String)
+	if !notes.IsNull(0) || notes.Value(1) != "shown" {
+		t.Errorf("expected a null and then shown, got %v", notes)
+	}
+}
+
+func TestColumnarEmpty(t *testing.T) {
+	buffer := streamColumnar(t, "parquet", 0)
+
+	table, err := pqarrow.ReadTable(context.Background(), bytes.NewReader(buffer.Bytes()), parquet.NewReaderProperties(nil), pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer table.Release()
+	if table.NumRows() != 0 {
+		t.Errorf("expected no rows, got %d", table.NumRows())
+	}
+}
@@ -94,6 +94,10 @@ func SetWriterForCommand(cmdName string, opts *globals.GlobalOptions) {
 
 // InitJsonWriterApi inits JsonWriter for API responses
 func InitJsonWriterApi(cmdName string, w io.Writer, opts *globals.GlobalOptions) {
+	if _, isModel := opts.Writer.(*output.ModelWriter); isModel {
+		// Streaming to the SDK, models are delivered unformatted
+		return
+	}
 	_, ok := opts.Writer.(*output.JsonWriter)
 	if opts.Format == "json" && !ok {
 		jw := output.NewDefaultJsonWriter(w, false)
@@ -109,7 +113,7 @@ func InitJsonWriterApi(cmdName string, w io.Writer, opts *globals.GlobalOptions)
 
 // CloseJsonWriterIfNeededApi will close JsonWriter if the format is json
 func CloseJsonWriterIfNeededApi(cmdName string, err error, opts *globals.GlobalOptions) {
-	if opts.Format == "json" && err == nil {
-		opts.Writer.(*output.JsonWriter).Close()
+	if jw, ok := opts.Writer.(*output.JsonWriter); ok && opts.Format == "json" && err == nil {
+		jw.Close()
 	}
 }
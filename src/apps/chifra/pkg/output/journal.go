@@ -0,0 +1,129 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// journalWriter writes models carrying postings (see types.Posting) as double-entry journal
+// transactions in one of types.JournalFormats. It remembers the accounts it has opened (which
+// beancount requires) and whether it has written the IIF header.
+type journalWriter struct {
+	w        io.Writer
+	format   string
+	noHeader bool
+	opened   map[string]bool
+}
+
+func newJournalWriter(w io.Writer, format string, noHeader bool) *journalWriter {
+	return &journalWriter{
+		w:        w,
+		format:   format,
+		noHeader: noHeader,
+		opened:   make(map[string]bool),
+	}
+}
+
+func (jw *journalWriter) write(model types.Model) error {
+	postings, ok := model.Data["postings"].([]types.Posting)
+	if !ok {
+		return fmt.Errorf("the %s format is only available with chifra export --statements", jw.format)
+	}
+	if len(postings) == 0 {
+		return nil
+	}
+
+	ts, _ := model.Data["timestamp"].(base.Timestamp)
+	date := time.Unix(int64(ts), 0).UTC()
+	payee := strings.NewReplacer("\"", "'", "\t", " ", "\n", " ").Replace(fmt.Sprint(model.Data["payee"]))
+	hash := fmt.Sprint(model.Data["transactionHash"])
+	id := fmt.Sprintf("%v.%v.%v", model.Data["blockNumber"], model.Data["transactionIndex"], model.Data["logIndex"])
+
+	var sb strings.Builder
+	switch jw.format {
+	case "ledger":
+		sb.WriteString(fmt.Sprintf("%s * %s\n", date.Format("2006/01/02"), payee))
+		sb.WriteString(fmt.Sprintf("    ; tx: %s\n    ; id: %s\n", hash, id))
+		for _, p := range postings {
+			sb.WriteString(fmt.Sprintf("    %s  %s %s", p.Account, p.Quantity, ledgerCommodity(p.Commodity)))
+			if p.Price != 0 {
+				sb.WriteString(" @ $" + formatPrice(p.Price))
+			}
+			sb.WriteString("\n")
+		}
+		sb.WriteString("\n")
+
+	case "beancount":
+		day := date.Format("2006-01-02")
+		for _, p := range postings {
+			if !jw.opened[p.Account] {
+				jw.opened[p.Account] = true
+				sb.WriteString(fmt.Sprintf("%s open %s\n", day, p.Account))
+			}
+		}
+		sb.WriteString(fmt.Sprintf("%s * \"%s\" \"%s\"\n", day, payee, hash))
+		sb.WriteString(fmt.Sprintf("  id: \"%s\"\n", id))
+		for _, p := range postings {
+			sb.WriteString(fmt.Sprintf("  %s  %s %s", p.Account, p.Quantity, p.Commodity))
+			if p.Price != 0 {
+				sb.WriteString(" @ " + formatPrice(p.Price) + " USD")
+			}
+			sb.WriteString("\n")
+		}
+		sb.WriteString("\n")
+
+	case "iif":
+		if !jw.noHeader && !jw.opened["!TRNS"] {
+			jw.opened["!TRNS"] = true
+			sb.WriteString("!TRNS\tTRNSID\tTRNSTYPE\tDATE\tACCNT\tNAME\tAMOUNT\tMEMO\n")
+			sb.WriteString("!SPL\tSPLID\tTRNSTYPE\tDATE\tACCNT\tNAME\tAMOUNT\tMEMO\n")
+			sb.WriteString("!ENDTRNS\n")
+		}
+		// QuickBooks is single-currency, so each posting is reported at its US dollar value
+		// rounded to cents. Any rounding difference is absorbed by the first posting so that
+		// the transaction balances.
+		cents := make([]int64, len(postings))
+		var sum int64
+		for i, p := range postings {
+			cents[i] = int64(math.Round(float64(p.Value) * 100))
+			sum += cents[i]
+		}
+		cents[0] -= sum
+		for i, p := range postings {
+			kind := "SPL"
+			if i == 0 {
+				kind = "TRNS"
+			}
+			memo := fmt.Sprintf("%s %s @ %s USD %s", p.Quantity, p.Commodity, formatPrice(p.Price), hash)
+			amount := strconv.FormatFloat(float64(cents[i])/100, 'f', 2, 64)
+			sb.WriteString(strings.Join([]string{kind, "", "GENERAL JOURNAL", date.Format("01/02/2006"), p.Account, payee, amount, memo}, "\t"))
+			sb.WriteString("\n")
+		}
+		sb.WriteString("ENDTRNS\n")
+
+	default:
+		return fmt.Errorf("unknown format %s", jw.format)
+	}
+
+	_, err := io.WriteString(jw.w, sb.String())
+	return err
+}
+
+// ledgerCommodity quotes commodities that ledger-cli would not otherwise accept (those with digits)
+func ledgerCommodity(commodity string) string {
+	if strings.IndexFunc(commodity, func(r rune) bool { return r < 'A' || r > 'Z' }) >= 0 {
+		return "\"" + commodity + "\""
+	}
+	return commodity
+}
+
+func formatPrice(price base.Float) string {
+	return strconv.FormatFloat(float64(price), 'f', -1, 64)
+}
@@ -0,0 +1,90 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+func TestJournalFormats(t *testing.T) {
+	owner := base.HexToAddress("0xf503017d7baf7fbc0fff7492b751025c6a78179b")
+	dai := base.HexToAddress("0x6b175474e89094c44da98b954eedeac495271d0f")
+	other := base.HexToAddress("0x054993ab0f2b1acc0fdc65405ee203b4271bebe6")
+
+	// 1.5 ETH paid to a named token contract at $2,000 with 0.01 ETH of gas
+	statement := types.Statement{
+		AccountedFor:    owner,
+		Sender:          owner,
+		Recipient:       dai,
+		AssetAddr:       base.FAKE_ETH_ADDRESS,
+		AssetSymbol:     "WEI",
+		Decimals:        18,
+		SpotPrice:       2000,
+		Timestamp:       1600000000,
+		BlockNumber:     10,
+		TransactionHash: base.HexToHash("0x01"),
+	}
+	statement.AmountOut.SetString("1500000000000000000", 10)
+	statement.GasOut.SetString("10000000000000000", 10)
+
+	namesMap := map[base.Address]types.Name{
+		dai: {Address: dai, Name: "Dai Stablecoin", Tags: "50-Tokens:ERC20"},
+	}
+	extraOpts := map[string]any{"namesMap": namesMap}
+
+	tests := []struct {
+		format string
+		want   []string
+	}{
+		{"ledger", []string{
+			"2020/09/13 * Dai Stablecoin",
+			"    Assets:Mainnet:0xf503017d7baf7fbc0fff7492b751025c6a78179b  -1.51 ETH @ $2000",
+			"    Expenses:Tokens:ERC20:DaiStablecoin  1.5 ETH @ $2000",
+			"    Expenses:Gas  0.01 ETH @ $2000",
+		}},
+		{"beancount", []string{
+			"2020-09-13 open Expenses:Gas",
+			"2020-09-13 * \"Dai Stablecoin\"",
+			"  Expenses:Tokens:ERC20:DaiStablecoin  1.5 ETH @ 2000 USD",
+		}},
+		{"iif", []string{
+			"!TRNS\tTRNSID\tTRNSTYPE\tDATE\tACCNT\tNAME\tAMOUNT\tMEMO",
+			"TRNS\t\tGENERAL JOURNAL\t09/13/2020\tAssets:Mainnet:0xf503017d7baf7fbc0fff7492b751025c6a78179b\tDai Stablecoin\t-3020.00",
+			"SPL\t\tGENERAL JOURNAL\t09/13/2020\tExpenses:Gas\tDai Stablecoin\t20.00",
+			"ENDTRNS",
+		}},
+	}
+
+	for _, tt := range tests {
+		buffer := new(bytes.Buffer)
+		model := statement.Model("mainnet", tt.format, false, extraOpts)
+		if err := StreamModel(buffer, model, OutputOptions{Format: tt.format}); err != nil {
+			t.Fatal(tt.format, err)
+		}
+		for _, line := range tt.want {
+			if !strings.Contains(buffer.String(), line) {
+				t.Errorf("%s: expected output to contain %q, got:\n%s", tt.format, line, buffer.String())
+			}
+		}
+	}
+
+	// A statement from someone unnamed is income from an unknown account
+	statement.Sender, statement.Recipient = other, owner
+	statement.AmountIn, statement.AmountOut, statement.GasOut = statement.AmountOut, base.Wei{}, base.Wei{}
+	buffer := new(bytes.Buffer)
+	if err := StreamModel(buffer, statement.Model("mainnet", "ledger", false, extraOpts), OutputOptions{Format: "ledger"}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buffer.String(), "Income:Unknown:0x054993ab0f2b1acc0fdc65405ee203b4271bebe6  -1.5 ETH") {
+		t.Errorf("expected income from an unknown account, got:\n%s", buffer.String())
+	}
+
+	// Other models cannot be written as journals
+	receipt := types.Receipt{}
+	if err := StreamModel(buffer, receipt.Model("mainnet", "ledger", false, nil), OutputOptions{Format: "ledger"}); err == nil {
+		t.Error("expected an error streaming a receipt as a journal")
+	}
+}
@@ -0,0 +1,47 @@
+package output
+
+import (
+	"context"
+	"io"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// ModelWriter is an io.Writer that, when used as the output writer for a command, receives each
+// model produced by StreamMany as-is (i.e. the underlying type) instead of its formatted text.
+// The SDK's streaming functions use it to avoid a round trip through JSON. Any other text the
+// command writes is discarded.
+type ModelWriter struct {
+	ctx     context.Context
+	onModel func(types.Modeler) error
+	onError func(error) error
+}
+
+// NewModelWriter returns a ModelWriter that calls onModel for each model and onError for each
+// non-fatal error the command reports. If either function returns an error, or if ctx is
+// cancelled, the command stops streaming.
+func NewModelWriter(ctx context.Context, onModel func(types.Modeler) error, onError func(error) error) *ModelWriter {
+	return &ModelWriter{
+		ctx:     ctx,
+		onModel: onModel,
+		onError: onError,
+	}
+}
+
+// Write implements io.Writer by discarding its input
+func (w *ModelWriter) Write(p []byte) (int, error) {
+	return len(p), nil
+}
+
+// Context returns the context that, when cancelled, stops the command
+func (w *ModelWriter) Context() context.Context {
+	return w.ctx
+}
+
+// ContextFor returns the ModelWriter's context if w is a ModelWriter, a background context otherwise
+func ContextFor(w io.Writer) context.Context {
+	if mw, ok := w.(*ModelWriter); ok && mw.ctx != nil {
+		return mw.ctx
+	}
+	return context.Background()
+}
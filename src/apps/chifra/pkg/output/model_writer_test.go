@@ -0,0 +1,84 @@
+package output
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+func TestStreamManyModelWriter(t *testing.T) {
+	received := []*types.Receipt{}
+	errs := []error{}
+	mw := NewModelWriter(context.Background(),
+		func(m types.Modeler) error {
+			received = append(received, m.(*types.Receipt))
+			return nil
+		},
+		func(err error) error {
+			errs = append(errs, err)
+			return nil
+		},
+	)
+
+	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
+		for i := 0; i < 3; i++ {
+			r := input
+			r.TransactionIndex = base.Txnum(i)
+			modelChan <- &r
+		}
+		errorChan <- errors.New("oops")
+	}
+
+	err := StreamMany(context.Background(), fetchData, OutputOptions{Writer: mw, Format: "json"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != 3 || received[2].TransactionIndex != 2 {
+		t.Error("expected three receipts in order, got", len(received))
+	}
+	if len(errs) != 1 || errs[0].Error() != "oops" {
+		t.Error("expected one error, got", errs)
+	}
+}
+
+func TestStreamManyModelWriterCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	count := 0
+	mw := NewModelWriter(ctx,
+		func(m types.Modeler) error {
+			count++
+			if count == 2 {
+				cancel()
+			}
+			return nil
+		},
+		func(err error) error { return nil },
+	)
+
+	done := make(chan bool)
+	fetchData := func(modelChan chan types.Modeler, errorChan chan error) {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			r := input
+			modelChan <- &r
+		}
+	}
+
+	err := StreamMany(context.Background(), fetchData, OutputOptions{Writer: mw, Format: "json"})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatal("expected context.Canceled, got", err)
+	}
+	if count > 3 {
+		t.Error("expected streaming to stop soon after cancel, got", count)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("fetcher was left blocked after cancel")
+	}
+}
@@ -63,6 +63,14 @@ func StreamModel(w io.Writer, model types.Model, options OutputOptions) error {
 		return nil
 	}
 
+	if types.IsJournalFormat(options.Format) {
+		return newJournalWriter(w, options.Format, options.NoHeader).write(model)
+	}
+
+	if IsColumnarFormat(options.Format) {
+		return fmt.Errorf("the %s format is only available when streaming", options.Format)
+	}
+
 	// Store map items as strings. All formats other than JSON need string data
 	strs := make([]string, 0, len(model.Order))
 	for _, key := range model.Order {
@@ -117,6 +125,12 @@ func StreamMany(ctx context.Context, fetchData fetchDataFunc, options OutputOpti
 		close(modelChan)
 		close(errorChan)
 	}()
+	// If we quit early, keep reading so the fetcher doesn't block forever on its sends
+	defer drain(modelChan, errorChan)
+
+	if mw, ok := options.Writer.(*ModelWriter); ok {
+		return streamToModelWriter(ctx, mw, modelChan, errorChan)
+	}
 
 	isJson := options.Format == "json"
 	var jw *JsonWriter
@@ -138,11 +152,26 @@ func StreamMany(ctx context.Context, fetchData fetchDataFunc, options OutputOpti
 		return err
 	}
 
+	// Journals carry state from one transaction to the next, so we use a single writer
+	var journal *journalWriter
+	if types.IsJournalFormat(options.Format) {
+		journal = newJournalWriter(options.Writer, options.Format, options.NoHeader)
+	}
+
+	// Parquet and Arrow buffer rows into batches and must be closed once the models run out
+	var columnar *columnarWriter
+	if IsColumnarFormat(options.Format) {
+		columnar = newColumnarWriter(options.Writer, options.Format, options.Chain, options.Extra)
+	}
+
 	errsMutex := sync.Mutex{}
 	for {
 		select {
 		case model, ok := <-modelChan:
 			if !ok {
+				if columnar != nil {
+					return columnar.close()
+				}
 				return nil
 			}
 
@@ -151,6 +180,10 @@ func StreamMany(ctx context.Context, fetchData fetchDataFunc, options OutputOpti
 			modelValue := model.Model(options.Chain, options.Format, options.Verbose, options.Extra)
 			if customFormat {
 				err = StreamWithTemplate(options.Writer, modelValue, tmpl)
+			} else if journal != nil {
+				err = journal.write(modelValue)
+			} else if columnar != nil {
+				err = columnar.write(model, modelValue)
 			} else {
 				err = StreamModel(options.Writer, modelValue, OutputOptions{
 					NoHeader:   !first || options.NoHeader,
@@ -178,9 +211,70 @@ func StreamMany(ctx context.Context, fetchData fetchDataFunc, options OutputOpti
 		case <-ctx.Done():
 			err = ctx.Err()
 			if err == context.Canceled {
+				if columnar != nil {
+					return columnar.close()
+				}
 				return nil
 			}
 			return err
 		}
 	}
 }
+
+// streamToModelWriter hands each model (and each error) to the ModelWriter unformatted.
+func streamToModelWriter(ctx context.Context, mw *ModelWriter, modelChan chan types.Modeler, errorChan chan error) error {
+	if mw.ctx != nil {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		stop := context.AfterFunc(mw.ctx, cancel)
+		defer stop()
+	}
+
+	for {
+		select {
+		case model, ok := <-modelChan:
+			if !ok {
+				return nil
+			}
+			if err := mw.onModel(model); err != nil {
+				return err
+			}
+
+		case err, ok := <-errorChan:
+			if !ok {
+				continue
+			}
+			if err := mw.onError(err); err != nil {
+				return err
+			}
+
+		case <-ctx.Done():
+			if mw.ctx != nil && mw.ctx.Err() != nil {
+				return mw.ctx.Err()
+			}
+			if ctx.Err() == context.Canceled {
+				return nil
+			}
+			return ctx.Err()
+		}
+	}
+}
+
+// drain reads (and discards) from both channels until the fetcher closes them
+func drain(modelChan chan types.Modeler, errorChan chan error) {
+	go func() {
+		for modelChan != nil || errorChan != nil {
+			select {
+			case _, ok := <-modelChan:
+				if !ok {
+					modelChan = nil
+				}
+			case _, ok := <-errorChan:
+				if !ok {
+					errorChan = nil
+				}
+			}
+		}
+	}()
+}
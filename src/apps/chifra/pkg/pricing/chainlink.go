@@ -0,0 +1,71 @@
+package pricing
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+const chainlinkLatestRoundData = "0xfeaf968c" // latestRoundData()
+
+// chainlinkSource prices assets from the Chainlink USD aggregator configured for the asset in the
+// chain's chainlinkFeeds. The aggregator is queried at the statement's block, so the price is the
+// latest round as of that block.
+type chainlinkSource struct{}
+
+func (chainlinkSource) PriceUsd(conn *rpc.Connection, statement *types.Statement) (price base.Float, source string, err error) {
+	feeds := config.GetPricingSettings(conn.Chain).ChainlinkFeeds
+	feedStr, ok := feeds[strings.ToLower(statement.AssetAddr.Hex())]
+	if !ok {
+		return 0.0, "not-priced", nil
+	}
+	feed := base.HexToAddress(feedStr)
+
+	// (uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+	result, err := ethCall(conn, feed, chainlinkLatestRoundData, statement.BlockNumber)
+	if err != nil {
+		return 0.0, "not-priced", err
+	}
+	if len(result) < 32*4 {
+		// the feed was not yet deployed at this block
+		return 0.0, "not-priced", nil
+	}
+	answer := signedWord(result[32:64])
+	updatedAt := new(big.Int).SetBytes(result[96:128])
+	if answer.Sign() <= 0 || updatedAt.Sign() == 0 {
+		return 0.0, "not-priced", nil
+	}
+
+	decimals, err := tokenDecimals(conn, feed)
+	if err != nil {
+		return 0.0, "not-priced", err
+	}
+
+	value, _ := new(big.Float).SetInt(answer).Float64()
+	price = base.Float(value / math.Pow(10, float64(decimals)))
+	source = "chainlink"
+
+	r := priceDebugger{
+		address:     statement.AssetAddr,
+		symbol:      statement.AssetSymbol,
+		blockNumber: statement.BlockNumber,
+		source1:     feed,
+		theCall1:    "latestRoundData()",
+		source2:     base.ZeroAddr,
+		theCall2:    fmt.Sprintf("answer: %s updatedAt: %s", answer, updatedAt),
+		first:       base.ZeroAddr,
+		second:      base.ZeroAddr,
+		int0:        (*base.Wei)(answer),
+		price:       price,
+		source:      source,
+	}
+	r.report("using Chainlink")
+
+	return price, source, nil
+}
@@ -1,2 +1,3 @@
-// Package pricing calculates US dollar prices from Uniswap pairs or falls back onto Maker
+// Package pricing calculates US dollar prices from a configurable list of sources (Uniswap V2 pairs
+// with a fallback onto Maker, Uniswap V3 TWAPs, Chainlink aggregators, and a user-supplied price file)
 package pricing
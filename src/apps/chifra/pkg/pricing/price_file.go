@@ -0,0 +1,120 @@
+package pricing
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/file"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// priceFileSource prices assets from a user-supplied CSV file (the chain's priceFile) whose rows
+// are date,asset,price. The date is YYYY-MM-DD (UTC), the asset is either an address or a symbol
+// (case insensitive) and the price is in USD. An asset is priced only if the file has a row for
+// the date of the statement. Lines starting with # and a header line are ignored.
+type priceFileSource struct{}
+
+func (priceFileSource) PriceUsd(conn *rpc.Connection, statement *types.Statement) (price base.Float, source string, err error) {
+	path := config.GetPricingSettings(conn.Chain).PriceFile
+	if !file.FileExists(path) {
+		return 0.0, "not-priced", nil
+	}
+
+	prices, err := loadPriceFile(path)
+	if err != nil {
+		return 0.0, "not-priced", err
+	}
+
+	date := time.Unix(int64(statement.Timestamp), 0).UTC().Format("2006-01-02")
+	for _, asset := range []string{statement.AssetAddr.Hex(), statement.AssetSymbol} {
+		if p, ok := prices[priceKey(date, asset)]; ok {
+			r := priceDebugger{
+				address:     statement.AssetAddr,
+				symbol:      statement.AssetSymbol,
+				blockNumber: statement.BlockNumber,
+				source1:     base.ZeroAddr,
+				theCall1:    path,
+				source2:     base.ZeroAddr,
+				theCall2:    date + "," + asset,
+				first:       base.ZeroAddr,
+				second:      base.ZeroAddr,
+				price:       p,
+				source:      "file",
+			}
+			r.report("using price file")
+			return p, "file", nil
+		}
+	}
+
+	return 0.0, "not-priced", nil
+}
+
+func priceKey(date, asset string) string {
+	return date + "_" + strings.ToLower(strings.TrimSpace(asset))
+}
+
+var priceFileMutex sync.Mutex
+var priceFiles = map[string]map[string]base.Float{}
+
+// loadPriceFile reads (once per run) the price file at path into a map keyed by date and asset.
+func loadPriceFile(path string) (map[string]base.Float, error) {
+	priceFileMutex.Lock()
+	defer priceFileMutex.Unlock()
+	if prices, ok := priceFiles[path]; ok {
+		return prices, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	prices, err := readPrices(f)
+	if err != nil {
+		return nil, fmt.Errorf("reading price file %s: %w", path, err)
+	}
+	priceFiles[path] = prices
+	return prices, nil
+}
+
+func readPrices(r io.Reader) (map[string]base.Float, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	prices := map[string]base.Float{}
+	for n := 1; ; n++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		price, err := strconv.ParseFloat(strings.TrimSpace(record[2]), 64)
+		if err != nil {
+			if n == 1 {
+				continue // a header
+			}
+			return nil, fmt.Errorf("record %d: invalid price %s", n, record[2])
+		}
+		date := strings.TrimSpace(record[0])
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return nil, fmt.Errorf("record %d: invalid date %s (expected YYYY-MM-DD)", n, date)
+		}
+		prices[priceKey(date, record[1])] = base.Float(price)
+	}
+	return prices, nil
+}
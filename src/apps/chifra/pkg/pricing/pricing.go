@@ -2,16 +2,42 @@ package pricing
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc/query"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/walk"
 )
 
 // TODO: Much of this reporting could be removed as it's only used for debugging
 
-// PriceUsd returns the price of the asset in USD
+// Source is a way of pricing an asset in USD at a given block. A source that cannot price the
+// statement's asset returns a zero price and a source string describing why (for example,
+// "not-priced"). The source string of a successful price is recorded in Statement.PriceSource.
+type Source interface {
+	PriceUsd(conn *rpc.Connection, statement *types.Statement) (price base.Float, source string, err error)
+}
+
+var sources = map[string]Source{
+	"uniswap":   uniswapV2Source{},
+	"uniswapv3": uniswapV3Source{},
+	"chainlink": chainlinkSource{},
+	"file":      priceFileSource{},
+}
+
+// RegisterSource makes a price source available under the given name so that it may be
+// listed in a chain's [pricing] sources.
+func RegisterSource(name string, source Source) {
+	sources[strings.ToLower(name)] = source
+}
+
+// PriceUsd returns the price of the asset in USD. Stable coins are always priced at one dollar.
+// Otherwise, the price is read from the binary cache or, failing that, the chain's price sources
+// are tried in order until one of them prices the asset. Prices at final blocks are cached.
 func PriceUsd(conn *rpc.Connection, statement *types.Statement) (price base.Float, source string, err error) {
 	if statement.IsStableCoin() {
 		r := priceDebugger{
@@ -22,15 +48,85 @@ func PriceUsd(conn *rpc.Connection, statement *types.Statement) (price base.Floa
 		return 1.0, "stable-coin", nil
 	}
 
-	if statement.BlockNumber <= uniswapFactoryV2_deployed {
-		if statement.IsEth() {
-			return priceUsdMaker(conn, statement)
-		} else {
-			msg := fmt.Sprintf("Block %d is prior to deployment (%d) of Uniswap V2. No other source for tokens prior to UniSwap", statement.BlockNumber, uniswapFactoryV2_deployed)
-			logger.TestLog(true, msg)
-			return 0.0, "token-not-priced-pre-uni", nil
+	// walk.Cache_Prices
+	item := &types.PriceItem{
+		Asset:       statement.AssetAddr,
+		BlockNumber: statement.BlockNumber,
+	}
+	if conn.StoreReadable() && conn.Store.Read(item, nil) == nil {
+		return item.Price, item.Source, nil
+	}
+
+	price, source, err = priceFromSources(conn, statement)
+	if err == nil {
+		item.Price = price
+		item.Source = source
+		cachePrice(conn, statement, item)
+	}
+
+	return price, source, err
+}
+
+// cachePrice writes the price to the binary cache if the statement's block is final. Assets that
+// could not be priced are not cached so that they are priced if a source is added later.
+func cachePrice(conn *rpc.Connection, statement *types.Statement, item *types.PriceItem) {
+	if item.Price == 0 {
+		return
+	}
+	isFinal := base.IsFinal(conn.LatestBlockTimestamp, statement.Timestamp)
+	if isFinal && conn.StoreWritable() && conn.EnabledMap[walk.Cache_Prices] {
+		_ = conn.Store.Write(item, nil)
+	}
+}
+
+// priceFromSources tries each of the chain's price sources in order until one of them prices the asset.
+func priceFromSources(conn *rpc.Connection, statement *types.Statement) (price base.Float, source string, err error) {
+	source = "not-priced"
+	for _, name := range config.GetPricingSettings(conn.Chain).Sources {
+		s, ok := sources[name]
+		if !ok {
+			logger.Warn(fmt.Sprintf("unknown price source %s for chain %s", name, conn.Chain))
+			continue
+		}
+
+		p, src, e := s.PriceUsd(conn, statement)
+		if e == nil && p != 0 {
+			return p, src, nil
+		}
+
+		// Report the reason given by the first source that declined (unless a later source succeeds)
+		if source == "not-priced" && src != "" {
+			source = src
+		}
+		if e != nil {
+			err = e
 		}
 	}
 
-	return priceUsdUniswap(conn, statement)
+	return 0.0, source, err
+}
+
+// ethCall sends an eth_call with the given data to the address at the block and returns the
+// returned bytes, which are empty if there is no contract at the address. If bn is NOPOSN, the
+// call is made at the latest block.
+func ethCall(conn *rpc.Connection, address base.Address, data string, bn base.Blknum) ([]byte, error) {
+	blockNumber := "latest"
+	if bn != base.NOPOSN {
+		blockNumber = fmt.Sprintf("0x%x", bn)
+	}
+
+	method := "eth_call"
+	params := query.Params{
+		map[string]any{
+			"to":   address.Hex(),
+			"data": data,
+		},
+		blockNumber,
+	}
+
+	result, err := query.Query[string](conn.Chain, method, params)
+	if err != nil {
+		return nil, err
+	}
+	return base.Hex2Bytes(strings.TrimPrefix(*result, "0x")), nil
 }
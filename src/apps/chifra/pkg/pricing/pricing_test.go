@@ -0,0 +1,93 @@
+package pricing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/cache"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/walk"
+)
+
+func TestReadPrices(t *testing.T) {
+	csv := `date,asset,price
+# a comment
+2021-01-01, 0xC02aaa39b223FE8D0A0e5C4F27eAD9083C756Cc2, 730.5
+2021-01-01,UNI,4.75
+`
+	prices, err := readPrices(strings.NewReader(csv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := prices[priceKey("2021-01-01", "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2")]; p != 730.5 {
+		t.Error("expected WETH to be priced at 730.5, got", p)
+	}
+	if p := prices[priceKey("2021-01-01", "uni")]; p != 4.75 {
+		t.Error("expected UNI to be priced at 4.75, got", p)
+	}
+
+	if _, err := readPrices(strings.NewReader("2021-01-01,UNI,4.75\n01/02/2021,UNI,5\n")); err == nil {
+		t.Error("expected an error for a badly formatted date")
+	}
+}
+
+func TestSignedWord(t *testing.T) {
+	minusOne := base.Hex2Bytes(strings.Repeat("ff", 32))
+	if v := signedWord(minusOne); v.Int64() != -1 {
+		t.Error("expected -1, got", v)
+	}
+	two := base.Hex2Bytes(strings.Repeat("00", 31) + "02")
+	if v := signedWord(two); v.Int64() != 2 {
+		t.Error("expected 2, got", v)
+	}
+}
+
+func TestCachePrice(t *testing.T) {
+	store, err := cache.NewStore(&cache.StoreOptions{Location: cache.FsCache, RootDir: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn := &rpc.Connection{
+		Store:                store,
+		LatestBlockTimestamp: 1700000000,
+		EnabledMap:           map[walk.CacheType]bool{walk.Cache_Prices: true},
+	}
+	uni := base.HexToAddress("0x1f9840a85d5af5bf1d1762f925bdaddc4201f984")
+
+	tests := []struct {
+		name      string
+		timestamp base.Timestamp
+		price     base.Float
+		cached    bool
+	}{
+		{"final block", conn.LatestBlockTimestamp - 3600, 4.75, true},
+		{"non-final block", conn.LatestBlockTimestamp - 60, 4.80, false},
+		{"not priced", conn.LatestBlockTimestamp - 7200, 0, false},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statement := &types.Statement{
+				AssetAddr:   uni,
+				BlockNumber: base.Blknum(100 + i),
+				Timestamp:   tt.timestamp,
+			}
+			cachePrice(conn, statement, &types.PriceItem{
+				Asset:       statement.AssetAddr,
+				BlockNumber: statement.BlockNumber,
+				Price:       tt.price,
+				Source:      "uniswapv3",
+			})
+
+			item := &types.PriceItem{Asset: statement.AssetAddr, BlockNumber: statement.BlockNumber}
+			err := conn.Store.Read(item, nil)
+			if cached := err == nil; cached != tt.cached {
+				t.Fatalf("expected cached to be %t, got %t", tt.cached, cached)
+			}
+			if tt.cached && item.Price != tt.price {
+				t.Errorf("expected price %f, got %f", tt.price, item.Price)
+			}
+		})
+	}
+}
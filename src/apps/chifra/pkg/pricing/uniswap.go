@@ -6,6 +6,7 @@ import (
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/articulate"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/call"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
 )
@@ -17,6 +18,28 @@ var (
 	uniswapFactoryV2_deployed = base.Blknum(10000835) // why query for this immutable value each time we need it?
 )
 
+// uniswapV2Source prices assets from Uniswap V2 pairs (through WETH for tokens) on mainnet,
+// falling back to Maker for ETH prior to the deployment of Uniswap V2.
+type uniswapV2Source struct{}
+
+func (uniswapV2Source) PriceUsd(conn *rpc.Connection, statement *types.Statement) (price base.Float, source string, err error) {
+	if conn.Chain != "mainnet" {
+		return 0.0, "not-priced", nil
+	}
+
+	if statement.BlockNumber <= uniswapFactoryV2_deployed {
+		if statement.IsEth() {
+			return priceUsdMaker(conn, statement)
+		} else {
+			msg := fmt.Sprintf("Block %d is prior to deployment (%d) of Uniswap V2. No other source for tokens prior to UniSwap", statement.BlockNumber, uniswapFactoryV2_deployed)
+			logger.TestLog(true, msg)
+			return 0.0, "token-not-priced-pre-uni", nil
+		}
+	}
+
+	return priceUsdUniswap(conn, statement)
+}
+
 // priceUsdUniswap returns the price of the given asset in USD as of the given block number.
 func priceUsdUniswap(conn *rpc.Connection, statement *types.Statement) (price base.Float, source string, err error) {
 	multiplier := base.Float(1.0)
@@ -0,0 +1,250 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+const (
+	v3GetPool   = "0x1698ee82" // getPool(address,address,uint24)
+	v3Liquidity = "0x1a686502" // liquidity()
+	v3Observe   = "0x883bdbfd" // observe(uint32[])
+	v3Slot0     = "0x3850c7bd" // slot0()
+	erc20Decs   = "0x313ce567" // decimals()
+)
+
+// uniswapV3Fees are the fee tiers of Uniswap V3 pools. The pool with the most liquidity is used.
+var uniswapV3Fees = []uint64{100, 500, 3000, 10000}
+
+// uniswapV3Source prices assets using the time weighted average price (over the chain's
+// twapSeconds) of a Uniswap V3 pool pairing the asset with the chain's usdToken or, failing
+// that, with its wethToken (in which case ETH is priced the same way). If the pool is too
+// young to report a TWAP, its current (spot) price is used.
+type uniswapV3Source struct{}
+
+func (uniswapV3Source) PriceUsd(conn *rpc.Connection, statement *types.Statement) (price base.Float, source string, err error) {
+	settings := config.GetPricingSettings(conn.Chain)
+	if len(settings.UniswapV3Factory) == 0 || len(settings.UsdToken) == 0 {
+		return 0.0, "not-priced", nil
+	}
+
+	v3 := uniswapV3{
+		conn:     conn,
+		factory:  base.HexToAddress(settings.UniswapV3Factory),
+		window:   settings.TwapSeconds,
+		bn:       statement.BlockNumber,
+		usdToken: base.HexToAddress(settings.UsdToken),
+	}
+	weth := base.HexToAddress(settings.WethToken)
+
+	if statement.IsEth() {
+		if weth.IsZero() {
+			return 0.0, "not-priced", nil
+		}
+		price, source, err = v3.price(weth, 18, v3.usdToken)
+
+	} else {
+		decimals := uint64(statement.Decimals)
+		if decimals == 0 {
+			if decimals, err = tokenDecimals(conn, statement.AssetAddr); err != nil {
+				return 0.0, "not-priced", err
+			}
+		}
+		if price, source, err = v3.price(statement.AssetAddr, decimals, v3.usdToken); (err != nil || price == 0) && !weth.IsZero() {
+			var ethPrice, tokenPrice base.Float
+			if ethPrice, _, err = v3.price(weth, 18, v3.usdToken); err == nil && ethPrice != 0 {
+				tokenPrice, source, err = v3.price(statement.AssetAddr, decimals, weth)
+				price = tokenPrice * ethPrice
+			}
+		}
+	}
+
+	if err != nil || price == 0 {
+		return 0.0, "not-priced", err
+	}
+
+	r := priceDebugger{
+		address:     statement.AssetAddr,
+		symbol:      statement.AssetSymbol,
+		blockNumber: statement.BlockNumber,
+		source1:     v3.factory,
+		theCall1:    "getPool",
+		source2:     base.ZeroAddr,
+		theCall2:    source,
+		first:       base.ZeroAddr,
+		second:      base.ZeroAddr,
+		price:       price,
+		source:      source,
+	}
+	r.report("using Uniswap V3")
+
+	return price, source, nil
+}
+
+type uniswapV3 struct {
+	conn     *rpc.Connection
+	factory  base.Address
+	window   uint64
+	bn       base.Blknum
+	usdToken base.Address
+}
+
+var errNoPool = errors.New("no uniswap v3 pool")
+
+// price returns the price of asset (which has the given decimals) in units of quote.
+func (v3 *uniswapV3) price(asset base.Address, assetDecimals uint64, quote base.Address) (base.Float, string, error) {
+	pool, err := v3.findPool(asset, quote)
+	if err != nil {
+		return 0.0, "", err
+	}
+
+	quoteDecimals, err := tokenDecimals(v3.conn, quote)
+	if err != nil {
+		return 0.0, "", err
+	}
+
+	// the pool prices token0 in units of token1 where token0 is the lesser address
+	token0, dec0, dec1 := asset, assetDecimals, quoteDecimals
+	if strings.ToLower(asset.Hex()) > strings.ToLower(quote.Hex()) {
+		token0, dec0, dec1 = quote, quoteDecimals, assetDecimals
+	}
+
+	source := "uniswap-v3-twap"
+	ratio, err := v3.twapRatio(pool)
+	if err != nil {
+		msg := fmt.Sprintf("no TWAP for pool %s at block %d (%s). Using spot price.", pool.Hex(), v3.bn, err)
+		logger.TestLog(true, msg)
+		source = "uniswap-v3-spot"
+		if ratio, err = v3.spotRatio(pool); err != nil {
+			return 0.0, "", err
+		}
+	}
+
+	price := ratio * math.Pow(10, float64(dec0)-float64(dec1))
+	if token0 != asset {
+		if price == 0 {
+			return 0.0, "", nil
+		}
+		price = 1 / price
+	}
+	return base.Float(price), source, nil
+}
+
+// findPool returns the pool for the pair with the most liquidity at the block.
+func (v3 *uniswapV3) findPool(a, b base.Address) (base.Address, error) {
+	best := base.ZeroAddr
+	bestLiquidity := new(big.Int)
+	for _, fee := range uniswapV3Fees {
+		data := v3GetPool + a.Pad32() + b.Pad32() + fmt.Sprintf("%064x", fee)
+		result, err := ethCall(v3.conn, v3.factory, data, v3.bn)
+		if err != nil {
+			return base.ZeroAddr, err
+		}
+		if len(result) < 32 {
+			return base.ZeroAddr, errNoPool // factory not yet deployed
+		}
+		pool := base.BytesToAddress(result[12:32])
+		if pool.IsZero() {
+			continue
+		}
+		result, err = ethCall(v3.conn, pool, v3Liquidity, v3.bn)
+		if err != nil || len(result) < 32 {
+			continue
+		}
+		if liquidity := new(big.Int).SetBytes(result[:32]); liquidity.Cmp(bestLiquidity) > 0 {
+			best, bestLiquidity = pool, liquidity
+		}
+	}
+
+	if best.IsZero() {
+		return base.ZeroAddr, fmt.Errorf("%w for %s and %s", errNoPool, a.Hex(), b.Hex())
+	}
+	return best, nil
+}
+
+// twapRatio returns 1.0001^tick (the raw price of token0 in token1) for the average tick over
+// the window ending at the block.
+func (v3 *uniswapV3) twapRatio(pool base.Address) (float64, error) {
+	data := v3Observe +
+		fmt.Sprintf("%064x", 32) + // offset of the array
+		fmt.Sprintf("%064x", 2) + // its length
+		fmt.Sprintf("%064x", v3.window) +
+		fmt.Sprintf("%064x", 0)
+	result, err := ethCall(v3.conn, pool, data, v3.bn)
+	if err != nil {
+		return 0, err
+	}
+
+	// the first return value is int56[] tickCumulatives
+	if len(result) < 32 {
+		return 0, errors.New("empty observe result")
+	}
+	offset := new(big.Int).SetBytes(result[:32]).Uint64()
+	if uint64(len(result)) < offset+32*3 {
+		return 0, errors.New("short observe result")
+	}
+	past := signedWord(result[offset+32 : offset+64])
+	now := signedWord(result[offset+64 : offset+96])
+
+	delta := new(big.Int).Sub(now, past)
+	tick, _ := new(big.Float).Quo(new(big.Float).SetInt(delta), big.NewFloat(float64(v3.window))).Float64()
+	return math.Pow(1.0001, tick), nil
+}
+
+// spotRatio returns (sqrtPriceX96 / 2^96)^2 (the raw price of token0 in token1) from the pool's slot0.
+func (v3 *uniswapV3) spotRatio(pool base.Address) (float64, error) {
+	result, err := ethCall(v3.conn, pool, v3Slot0, v3.bn)
+	if err != nil {
+		return 0, err
+	}
+	if len(result) < 32 {
+		return 0, errors.New("empty slot0 result")
+	}
+	sqrtPrice := new(big.Float).SetInt(new(big.Int).SetBytes(result[:32]))
+	sqrtPrice.Quo(sqrtPrice, new(big.Float).SetInt(new(big.Int).Lsh(big.NewInt(1), 96)))
+	ratio, _ := new(big.Float).Mul(sqrtPrice, sqrtPrice).Float64()
+	return ratio, nil
+}
+
+// signedWord interprets a 32-byte ABI word as a two's complement signed integer.
+func signedWord(word []byte) *big.Int {
+	ret := new(big.Int).SetBytes(word)
+	if len(word) > 0 && word[0]&0x80 != 0 {
+		ret.Sub(ret, new(big.Int).Lsh(big.NewInt(1), uint(len(word)*8)))
+	}
+	return ret
+}
+
+var decimalsMutex sync.Mutex
+var decimalsCache = map[string]uint64{}
+
+// tokenDecimals returns the number of decimals of an ERC-20 token (which never changes).
+func tokenDecimals(conn *rpc.Connection, token base.Address) (uint64, error) {
+	key := conn.Chain + "_" + token.Hex()
+	decimalsMutex.Lock()
+	defer decimalsMutex.Unlock()
+	if decimals, ok := decimalsCache[key]; ok {
+		return decimals, nil
+	}
+
+	result, err := ethCall(conn, token, erc20Decs, base.NOPOSN)
+	if err != nil {
+		return 0, err
+	}
+	if len(result) < 32 {
+		return 0, fmt.Errorf("could not read decimals of %s", token.Hex())
+	}
+	decimals := new(big.Int).SetBytes(result[:32]).Uint64()
+	decimalsCache[key] = decimals
+	return decimals, nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc/query"
 	"github.com/ethereum/go-ethereum/ethclient"
+	gethRpc "github.com/ethereum/go-ethereum/rpc"
 )
 
 // GetClientVersion returns the version of the client
@@ -93,12 +94,13 @@ func (conn *Connection) getClient() (*ethclient.Client, error) {
 	defer clientMutex.Unlock()
 
 	if perProviderClientMap[provider] == nil {
-		ec, err := ethclient.Dial(provider)
-		if err != nil || ec == nil {
+		// The http client spreads calls across every provider configured for the chain
+		rc, err := gethRpc.DialOptions(context.Background(), provider, gethRpc.WithHTTPClient(query.HttpClient(conn.Chain)))
+		if err != nil || rc == nil {
 			logger.Error("Missdial("+provider+"):", err)
 			logger.Fatal("")
 		}
-		perProviderClientMap[provider] = ec
+		perProviderClientMap[provider] = ethclient.NewClient(rc)
 	}
 	return perProviderClientMap[provider], nil
 }
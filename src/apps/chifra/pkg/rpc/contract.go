@@ -3,6 +3,7 @@ package rpc
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sort"
 	"sync"
 
@@ -47,19 +48,47 @@ func (conn *Connection) GetContractCodeAt(addr base.Address, bn base.Blknum) ([]
 	}
 }
 
+// ProxyKind is the way in which a proxy contract finds its implementation
+type ProxyKind string
+
+const (
+	ProxyNone    ProxyKind = ""
+	ProxyEip1967 ProxyKind = "EIP-1967"
+	ProxyEip1822 ProxyKind = "EIP-1822"
+	ProxyBeacon  ProxyKind = "Beacon"
+	ProxyOther   ProxyKind = "Other"
+)
+
 // We check a bunch of different locations for the proxy
-var locations = []string{
-	"0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc", // EIP1967
-	"0x7050c9e0f4ca769c69bd3a8ef740bc37934f8e2c036e5a723fd8ee048ed3f8c3", // EIP1967ZOS
-	"0xc5f16f0fcc639fa48a6947836d9850f504798523bf8c9a3a87d5876cf622bcf7", // EIP1822
-	"0x5f3b5dfeb7b28cdbd7faba78963ee202a494e2a2cc8c9978d5e30d2aebb8c197", // EIP1822ZOS};
-	"0x",
+var locations = []struct {
+	slot string
+	kind ProxyKind
+}{
+	{"0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc", ProxyEip1967}, // EIP1967
+	{"0x7050c9e0f4ca769c69bd3a8ef740bc37934f8e2c036e5a723fd8ee048ed3f8c3", ProxyEip1967}, // EIP1967ZOS
+	{"0xc5f16f0fcc639fa48a6947836d9850f504798523bf8c9a3a87d5876cf622bcf7", ProxyEip1822}, // EIP1822
+	{"0x5f3b5dfeb7b28cdbd7faba78963ee202a494e2a2cc8c9978d5e30d2aebb8c197", ProxyEip1822}, // EIP1822ZOS
+	{"0xa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50", ProxyBeacon},  // EIP1967 beacon
+	{"0x", ProxyOther},
 }
 
 // GetContractProxyAt returns the proxy address for a contract if any
 func (conn *Connection) GetContractProxyAt(address base.Address, blockNumber base.Blknum) (base.Address, error) {
+	proxy, _, err := conn.getContractProxyAt(address, blockNumber, false)
+	return proxy, err
+}
+
+// GetContractProxyKindAt returns the proxy address for a contract if any and the kind of proxy it is. Finding
+// the kind may take more queries than finding the proxy alone.
+func (conn *Connection) GetContractProxyKindAt(address base.Address, blockNumber base.Blknum) (base.Address, ProxyKind, error) {
+	return conn.getContractProxyAt(address, blockNumber, true)
+}
+
+// getContractProxyAt returns the proxy address for a contract if any. Unless wantKind is true, a contract that
+// reports its implementation is said to be ProxyOther without looking for where it keeps it.
+func (conn *Connection) getContractProxyAt(address base.Address, blockNumber base.Blknum, wantKind bool) (base.Address, ProxyKind, error) {
 	if ec, err := conn.getClient(); err != nil {
-		return base.Address{}, err
+		return base.Address{}, ProxyNone, err
 	} else {
 		defer ec.Close()
 
@@ -79,7 +108,18 @@ func (conn *Connection) GetContractProxyAt(address base.Address, blockNumber bas
 			proxy = base.HexToAddress(*proxyAddr)
 		}
 		if err == nil && !proxy.IsZero() && proxy.Hex() != address.Hex() {
-			return proxy, err
+			// The contract tells us where it points, but not how, so we look for it in the usual places
+			kind := ProxyOther
+			if wantKind {
+				for _, location := range locations {
+					value, err := ec.StorageAt(context.Background(), address.Address, common.HexToHash(location.slot), base.BiFromBn(blockNumber))
+					if err == nil && base.BytesToAddress(value) == proxy {
+						kind = location.kind
+						break
+					}
+				}
+			}
+			return proxy, kind, nil
 		}
 
 		for _, location := range locations {
@@ -87,26 +127,50 @@ func (conn *Connection) GetContractProxyAt(address base.Address, blockNumber bas
 			value, err = ec.StorageAt(
 				context.Background(),
 				address.Address,
-				common.HexToHash(location),
+				common.HexToHash(location.slot),
 				base.BiFromBn(blockNumber),
 			)
 			if err != nil {
-				return proxy, err
+				return proxy, ProxyNone, err
 			}
 			proxy = base.BytesToAddress(value)
+			if location.kind == ProxyBeacon && !proxy.IsZero() {
+				// The slot holds the beacon, which holds the implementation
+				proxy = conn.getBeaconImplementation(proxy, blockNumber)
+			}
 			if !proxy.IsZero() && proxy.Hex() != address.Hex() {
 				err = conn.IsContractAt(proxy, blockNumber)
 				if errors.Is(err, ErrNotAContract) {
 					// Not a proxy
-					return base.Address{}, nil
+					return base.Address{}, ProxyNone, nil
 				}
-				return proxy, err
+				return proxy, location.kind, err
 			}
 			proxy = base.Address{}
 		}
 
-		return proxy, err
+		return proxy, ProxyNone, err
+	}
+}
+
+// getBeaconImplementation returns the implementation a beacon points to or a zero address if it does not
+func (conn *Connection) getBeaconImplementation(beacon base.Address, blockNumber base.Blknum) base.Address {
+	block := "latest"
+	if blockNumber != base.NOPOSN {
+		block = fmt.Sprintf("0x%x", blockNumber)
+	}
+	params := query.Params{
+		map[string]any{
+			"to": beacon,
+			// implementation()
+			"data": "0x5c60da1b",
+		},
+		block,
+	}
+	if result, err := query.Query[string](conn.Chain, "eth_call", params); err == nil && result != nil {
+		return base.HexToAddress(*result)
 	}
+	return base.Address{}
 }
 
 // TODO: We could use a SyncMap here
@@ -74,7 +74,8 @@ func (conn *Connection) GetState(fieldBits types.StatePart, address base.Address
 		})
 	}
 
-	queryResults, err := query.QueryBatch[string](conn.Chain, rpcPayload)
+	// If any part failed we return an error rather than a (possibly cached) zero value
+	queryResults, err := query.QueryBatchWithErrors[string](conn.Chain, map[string]string{}, rpcPayload)
 	if err != nil {
 		return nil, err
 	}
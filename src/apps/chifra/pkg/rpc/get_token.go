@@ -26,6 +26,8 @@ const tokenStateDecimals tokenStateSelector = "0x313ce567"
 const tokenStateSymbol tokenStateSelector = "0x95d89b41"
 const tokenStateName tokenStateSelector = "0x06fdde03"
 const tokenStateBalanceOf tokenStateSelector = "0x70a08231"
+const tokenStateOwnerOf tokenStateSelector = "0x6352211e"     // ERC-721 ownerOf(uint256)
+const tokenStateBalanceOfId tokenStateSelector = "0x00fdd58e" // ERC-1155 balanceOf(address,uint256)
 
 // GetTokenState returns token state for given block. `hexBlockNo` can be "latest" or "" for the latest
 // block or decimal number or hex number with 0x prefix. (search: FromRpc)
@@ -176,3 +178,80 @@ func (conn *Connection) GetBalanceAtToken(token, holder base.Address, hexBlockNo
 
 	return base.HexToWei(*output["balance"]), nil
 }
+
+// GetBalancesAtToken returns the token balance of each of the holders at the given block, aggregating the
+// calls through Multicall3 where it is available. As with GetBalanceAtToken, a failed call is reported as
+// a zero balance.
+func (conn *Connection) GetBalancesAtToken(token base.Address, holders []base.Address, bn base.Blknum) ([]*base.Wei, error) {
+	calls := make([]CallRequest, 0, len(holders))
+	for _, holder := range holders {
+		calls = append(calls, CallRequest{
+			To:   token,
+			Data: tokenStateBalanceOf + holder.Pad32(),
+		})
+	}
+
+	responses, err := conn.CallMany(calls, bn)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]*base.Wei, 0, len(responses))
+	for _, response := range responses {
+		if response.Err != nil {
+			balances = append(balances, base.NewWei(0))
+		} else {
+			balances = append(balances, base.HexToWei(response.Data))
+		}
+	}
+	return balances, nil
+}
+
+// GetBalanceAtNft returns the number of tokens with the given id that holder owns at the given block. For an
+// ERC-721 (isErc1155 false) this is one if holder is the token's owner and zero otherwise (including if the
+// token does not exist). For an ERC-1155, it is the holder's balance of the id. `hexBlockNo` is as above.
+func (conn *Connection) GetBalanceAtNft(token, holder base.Address, tokenId *base.Wei, isErc1155 bool, hexBlockNo string) (*base.Wei, error) {
+	if hexBlockNo != "" && hexBlockNo != "latest" && !strings.HasPrefix(hexBlockNo, "0x") {
+		hexBlockNo = fmt.Sprintf("0x%x", base.MustParseUint64(hexBlockNo))
+	}
+
+	data := tokenStateOwnerOf + fmt.Sprintf("%064x", tokenId.BigInt())
+	if isErc1155 {
+		data = tokenStateBalanceOfId + holder.Pad32() + fmt.Sprintf("%064x", tokenId.BigInt())
+	}
+
+	payloads := []query.BatchPayload{{
+		Key: "balance",
+		Payload: &query.Payload{
+			Method: "eth_call",
+			Params: query.Params{
+				map[string]any{
+					"to":   token.Hex(),
+					"data": data,
+				},
+				hexBlockNo,
+			},
+		},
+	}}
+
+	output, err := query.QueryBatch[string](conn.Chain, payloads)
+	if err != nil {
+		return nil, err
+	}
+
+	if output["balance"] == nil {
+		return base.NewWei(0), nil
+	}
+
+	if isErc1155 {
+		return base.HexToWei(*output["balance"]), nil
+	}
+
+	// ownerOf reverts for tokens that have not been minted or were burned, so anything
+	// other than the holder's address means the holder does not own it
+	result := strings.TrimPrefix(*output["balance"], "0x")
+	if len(result) >= 64 && base.HexToAddress("0x"+result[24:64]) == holder {
+		return base.NewWei(1), nil
+	}
+	return base.NewWei(0), nil
+}
@@ -29,10 +29,7 @@ func (conn *Connection) GetTracesByBlockNumber(bn base.Blknum) ([]types.Trace, e
 	curTs := conn.GetBlockTimestamp(bn) // same for every trace
 	isFinal := base.IsFinal(conn.LatestBlockTimestamp, curTs)
 
-	method := "trace_block"
-	params := query.Params{fmt.Sprintf("0x%x", bn)}
-
-	if traces, err := query.Query[[]types.Trace](conn.Chain, method, params); err != nil {
+	if traces, err := conn.queryTracesByBlockNumber(bn); err != nil {
 		return []types.Trace{{
 			Action: &types.TraceAction{},
 			Result: &types.TraceResult{},
@@ -89,10 +86,7 @@ func (conn *Connection) GetTracesByTransactionHash(txHash string, transaction *t
 		}
 	}
 
-	method := "trace_transaction"
-	params := query.Params{txHash}
-
-	if traces, err := query.Query[[]types.Trace](conn.Chain, method, params); err != nil {
+	if traces, err := conn.queryTracesByTransactionHash(txHash, transaction); err != nil {
 		return []types.Trace{{
 			Action: &types.TraceAction{},
 			Result: &types.TraceResult{},
@@ -142,3 +136,25 @@ func (conn *Connection) GetTracesByTransactionHash(txHash string, transaction *t
 		return *traces, nil
 	}
 }
+
+// queryTracesByBlockNumber returns the block's traces from the node's trace backend
+func (conn *Connection) queryTracesByBlockNumber(bn base.Blknum) (*[]types.Trace, error) {
+	if conn.traceBackend() == debugBackend {
+		return conn.getDebugTracesByBlockNumber(bn)
+	}
+
+	method := "trace_block"
+	params := query.Params{fmt.Sprintf("0x%x", bn)}
+	return query.Query[[]types.Trace](conn.Chain, method, params)
+}
+
+// queryTracesByTransactionHash returns the transaction's traces from the node's trace backend
+func (conn *Connection) queryTracesByTransactionHash(txHash string, transaction *types.Transaction) (*[]types.Trace, error) {
+	if conn.traceBackend() == debugBackend {
+		return conn.getDebugTracesByTransactionHash(txHash, transaction)
+	}
+
+	method := "trace_transaction"
+	params := query.Params{txHash}
+	return query.Query[[]types.Trace](conn.Chain, method, params)
+}
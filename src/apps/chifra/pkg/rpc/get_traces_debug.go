@@ -0,0 +1,236 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package rpc
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc/query"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+// traceBackend is the family of RPC methods a node uses to deliver traces
+type traceBackend int
+
+const (
+	// parityBackend uses trace_block and trace_transaction (Erigon, Nethermind, Reth)
+	parityBackend traceBackend = iota
+	// debugBackend uses debug_traceBlockByNumber and debug_traceTransaction with geth's
+	// built-in callTracer, flattening the call frames into parity-style traces
+	debugBackend
+)
+
+// traceBackends caches, per chain, the backend found by detectTraceBackend
+var traceBackends sync.Map
+
+var callTracer = map[string]string{"tracer": "callTracer"}
+
+// traceBackend returns the backend the chain's node supports, detecting it the first time through
+func (conn *Connection) traceBackend() traceBackend {
+	if backend, ok := traceBackends.Load(conn.Chain); ok {
+		return backend.(traceBackend)
+	}
+	if backend, ok := detectTraceBackend(conn.Chain); ok {
+		traceBackends.Store(conn.Chain, backend)
+		return backend
+	}
+	// Neither worked. Let the parity methods report the error.
+	return parityBackend
+}
+
+// detectTraceBackend prefers the parity methods, falling back to the debug methods if only they work
+func detectTraceBackend(chain string) (traceBackend, bool) {
+	params := query.Params{fmt.Sprintf("0x%x", firstTraceBlock(chain))}
+	if traces, err := query.Query[[]types.Trace](chain, "trace_block", params); err == nil && traces != nil {
+		return parityBackend, true
+	}
+	if frames, err := query.Query[[]debugTraceResult](chain, "debug_traceBlockByNumber", append(params, callTracer)); err == nil && frames != nil {
+		return debugBackend, true
+	}
+	return parityBackend, false
+}
+
+// callFrame is a single frame of geth's callTracer output
+type callFrame struct {
+	Type    string       `json:"type"`
+	From    base.Address `json:"from"`
+	To      base.Address `json:"to"`
+	Value   base.Wei     `json:"value"`
+	Gas     base.Gas     `json:"gas"`
+	GasUsed base.Gas     `json:"gasUsed"`
+	Input   string       `json:"input"`
+	Output  string       `json:"output"`
+	Error   string       `json:"error"`
+	Calls   []callFrame  `json:"calls"`
+}
+
+// debugTraceResult is one transaction's result from debug_traceBlockByNumber. Older versions of
+// geth do not report the transaction's hash.
+type debugTraceResult struct {
+	TxHash base.Hash `json:"txHash"`
+	Result callFrame `json:"result"`
+	Error  string    `json:"error"`
+}
+
+// getDebugTracesByBlockNumber returns the block's traces from debug_traceBlockByNumber. Because
+// the callTracer does not report rewards, block and uncle reward traces are added as the parity
+// methods would.
+func (conn *Connection) getDebugTracesByBlockNumber(bn base.Blknum) (*[]types.Trace, error) {
+	params := query.Params{fmt.Sprintf("0x%x", bn), callTracer}
+	results, err := query.Query[[]debugTraceResult](conn.Chain, "debug_traceBlockByNumber", params)
+	if err != nil || results == nil {
+		return nil, err
+	}
+
+	header, err := conn.GetBlockHeaderByNumber(bn)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := make([]types.Trace, 0, len(*results))
+	for i, res := range *results {
+		if res.Error != "" {
+			return nil, fmt.Errorf("debug_traceBlockByNumber failed for transaction %d.%d: %s", bn, i, res.Error)
+		}
+		txHash := res.TxHash
+		if txHash.IsZero() && i < len(header.Transactions) {
+			txHash = base.HexToHash(header.Transactions[i])
+		}
+		tmpl := types.Trace{
+			BlockHash:           header.Hash,
+			BlockNumber:         bn,
+			TransactionHash:     txHash,
+			TransactionPosition: base.Txnum(i),
+		}
+		flattenCallFrame(&res.Result, []uint64{}, &tmpl, &traces)
+	}
+
+	if reward := conn.getBlockReward(bn); !reward.IsZero() {
+		uncles, err := conn.GetUncleBodiesByNumber(bn)
+		if err != nil {
+			return nil, err
+		}
+
+		// The miner also earns 1/32 of the block reward for each uncle it includes
+		nephew := new(base.Wei).Quo(reward, base.NewWei(32))
+		minerReward := new(base.Wei).Add(reward, new(base.Wei).Mul(nephew, base.NewWei(int64(len(uncles)))))
+		traces = append(traces, rewardTrace(header.Hash, bn, header.Miner, "block", minerReward))
+
+		for _, uncle := range uncles {
+			// An uncle's miner earns (8 - depth) / 8 of the block reward
+			depth := int64(bn) - int64(uncle.BlockNumber)
+			uncleReward := new(base.Wei).Quo(new(base.Wei).Mul(reward, base.NewWei(8-depth)), base.NewWei(8))
+			traces = append(traces, rewardTrace(header.Hash, bn, uncle.Miner, "uncle", uncleReward))
+		}
+	}
+
+	return &traces, nil
+}
+
+// getDebugTracesByTransactionHash returns the transaction's traces from debug_traceTransaction.
+// If the transaction is not provided, its location is looked up.
+func (conn *Connection) getDebugTracesByTransactionHash(txHash string, transaction *types.Transaction) (*[]types.Trace, error) {
+	params := query.Params{txHash, callTracer}
+	frame, err := query.Query[callFrame](conn.Chain, "debug_traceTransaction", params)
+	if err != nil || frame == nil {
+		return nil, err
+	}
+
+	tmpl := types.Trace{
+		TransactionHash: base.HexToHash(txHash),
+	}
+	if transaction != nil {
+		tmpl.BlockHash = transaction.BlockHash
+		tmpl.BlockNumber = transaction.BlockNumber
+		tmpl.TransactionPosition = transaction.TransactionIndex
+	} else if app, err := conn.GetTransactionAppByHash(txHash); err != nil {
+		return nil, err
+	} else {
+		tmpl.BlockNumber = base.Blknum(app.BlockNumber)
+		tmpl.TransactionPosition = base.Txnum(app.TransactionIndex)
+		if header, err := conn.GetBlockHeaderByNumber(tmpl.BlockNumber); err == nil {
+			tmpl.BlockHash = header.Hash
+		}
+	}
+
+	traces := make([]types.Trace, 0, 1)
+	flattenCallFrame(frame, []uint64{}, &tmpl, &traces)
+	return &traces, nil
+}
+
+// flattenCallFrame appends the frame and (depth first) all of its sub-calls to traces in the
+// shape of the parity trace methods, numbering each with its traceAddress
+func flattenCallFrame(frame *callFrame, traceAddress []uint64, tmpl *types.Trace, traces *[]types.Trace) {
+	trace := *tmpl
+	trace.TraceAddress = traceAddress
+	trace.Subtraces = uint64(len(frame.Calls))
+	trace.Error = frame.Error
+
+	switch strings.ToUpper(frame.Type) {
+	case "CREATE", "CREATE2":
+		trace.TraceType = "create"
+		trace.Action = &types.TraceAction{
+			From:  frame.From,
+			Gas:   frame.Gas,
+			Init:  frame.Input,
+			Value: frame.Value,
+		}
+		trace.Result = &types.TraceResult{
+			Address: frame.To,
+			Code:    frame.Output,
+			GasUsed: frame.GasUsed,
+		}
+	case "SELFDESTRUCT":
+		trace.TraceType = "suicide"
+		trace.Action = &types.TraceAction{
+			Address:       frame.From,
+			RefundAddress: frame.To,
+			Balance:       frame.Value,
+		}
+	default:
+		trace.TraceType = "call"
+		trace.Action = &types.TraceAction{
+			CallType: strings.ToLower(frame.Type),
+			From:     frame.From,
+			To:       frame.To,
+			Gas:      frame.Gas,
+			Input:    frame.Input,
+			Value:    frame.Value,
+		}
+		trace.Result = &types.TraceResult{
+			GasUsed: frame.GasUsed,
+			Output:  frame.Output,
+		}
+	}
+
+	// Like the parity methods, failed frames carry no result
+	if frame.Error != "" {
+		trace.Result = nil
+	}
+
+	*traces = append(*traces, trace)
+	for i := range frame.Calls {
+		child := make([]uint64, len(traceAddress), len(traceAddress)+1)
+		copy(child, traceAddress)
+		flattenCallFrame(&frame.Calls[i], append(child, uint64(i)), tmpl, traces)
+	}
+}
+
+func rewardTrace(blockHash base.Hash, bn base.Blknum, author base.Address, rewardType string, value *base.Wei) types.Trace {
+	return types.Trace{
+		BlockHash:    blockHash,
+		BlockNumber:  bn,
+		TraceType:    "reward",
+		TraceAddress: []uint64{},
+		Action: &types.TraceAction{
+			Author:     author,
+			RewardType: rewardType,
+			Value:      *value,
+		},
+	}
+}
@@ -0,0 +1,89 @@
+package rpc
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
+)
+
+func TestFlattenCallFrame(t *testing.T) {
+	callTracerOutput := `{
+		"type": "CALL", "from": "0x000000000000000000000000000000000000000a", "to": "0x000000000000000000000000000000000000000b",
+		"value": "0x10", "gas": "0x5208", "gasUsed": "0x100", "input": "0x12345678", "output": "0x",
+		"calls": [
+			{
+				"type": "DELEGATECALL", "from": "0x000000000000000000000000000000000000000b", "to": "0x000000000000000000000000000000000000000c",
+				"gas": "0x100", "gasUsed": "0x10", "input": "0x", "output": "0x",
+				"calls": [
+					{"type": "SELFDESTRUCT", "from": "0x000000000000000000000000000000000000000b", "to": "0x000000000000000000000000000000000000000a", "value": "0x5"}
+				]
+			},
+			{
+				"type": "CREATE2", "from": "0x000000000000000000000000000000000000000b", "to": "0x000000000000000000000000000000000000000d",
+				"value": "0x0", "gas": "0x100", "gasUsed": "0x20", "input": "0x6080", "output": "0x6060"
+			},
+			{
+				"type": "STATICCALL", "from": "0x000000000000000000000000000000000000000b", "to": "0x000000000000000000000000000000000000000e",
+				"gas": "0x100", "gasUsed": "0x100", "input": "0x", "error": "out of gas"
+			}
+		]
+	}`
+
+	var frame callFrame
+	if err := json.Unmarshal([]byte(callTracerOutput), &frame); err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := types.Trace{BlockNumber: 100, TransactionPosition: 3}
+	traces := []types.Trace{}
+	flattenCallFrame(&frame, []uint64{}, &tmpl, &traces)
+
+	expected := []struct {
+		traceType    string
+		callType     string
+		traceAddress []uint64
+		subtraces    uint64
+	}{
+		{"call", "call", []uint64{}, 3},
+		{"call", "delegatecall", []uint64{0}, 1},
+		{"suicide", "", []uint64{0, 0}, 0},
+		{"create", "", []uint64{1}, 0},
+		{"call", "staticcall", []uint64{2}, 0},
+	}
+
+	if len(traces) != len(expected) {
+		t.Fatalf("expected %d traces, got %d", len(expected), len(traces))
+	}
+
+	for i, want := range expected {
+		trace := traces[i]
+		if trace.TraceType != want.traceType || trace.Action.CallType != want.callType {
+			t.Errorf("trace %d: expected %s/%s, got %s/%s", i, want.traceType, want.callType, trace.TraceType, trace.Action.CallType)
+		}
+		if !reflect.DeepEqual(trace.TraceAddress, want.traceAddress) {
+			t.Errorf("trace %d: expected traceAddress %v, got %v", i, want.traceAddress, trace.TraceAddress)
+		}
+		if trace.Subtraces != want.subtraces {
+			t.Errorf("trace %d: expected %d subtraces, got %d", i, want.subtraces, trace.Subtraces)
+		}
+		if trace.BlockNumber != 100 || trace.TransactionPosition != 3 {
+			t.Errorf("trace %d: expected the template's location, got %d.%d", i, trace.BlockNumber, trace.TransactionPosition)
+		}
+	}
+
+	if traces[0].Action.Value.Uint64() != 16 || traces[0].Action.Gas != 0x5208 || traces[0].Result.GasUsed != 0x100 {
+		t.Errorf("top level call not decoded: %v", traces[0])
+	}
+	if suicide := traces[2].Action; suicide.Address != base.HexToAddress("0xb") || suicide.RefundAddress != base.HexToAddress("0xa") || suicide.Balance.Uint64() != 5 {
+		t.Errorf("selfdestruct not decoded: %v", suicide)
+	}
+	if create := traces[3]; create.Result.Address != base.HexToAddress("0xd") || create.Action.Init != "0x6080" || create.Result.Code != "0x6060" {
+		t.Errorf("create not decoded: %v", create)
+	}
+	if failed := traces[4]; failed.Error != "out of gas" || failed.Result != nil {
+		t.Errorf("failed call should carry its error and no result: %v", failed)
+	}
+}
@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -8,6 +9,8 @@ import (
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/prefunds"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc/query"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/types"
 )
 
 // TODO: Some of this code may be chain-specific - for example,
@@ -20,8 +23,7 @@ import (
 // chain does not have a pre-allocation, this function will return
 // false, when in fact the node may be an archive node.
 func (conn *Connection) IsNodeArchive() bool {
-	thePath := filepath.Join(config.MustGetPathToChainConfig(conn.Chain), "allocs.csv")
-	largest, err := prefunds.GetLargestPrefund(conn.Chain, thePath)
+	largest, err := largestPrefund(conn.Chain)
 	if err != nil {
 		return false
 	}
@@ -34,15 +36,58 @@ func (conn *Connection) IsNodeArchive() bool {
 	return bal.Cmp(&largest.Balance) == 0
 }
 
-// IsNodeTracing returns true if the node exposes the `block_trace` RPC endpoint.
-// It queries block 1 or a user supplied block (which we presume exists). The function
-// returns false if block_trace returns an error or doesn't exist.
+// IsNodeTracing returns true if the node exposes either the `trace_block` RPC endpoint or
+// geth's `debug_traceBlockByNumber` (with the callTracer), whichever the node supports being
+// detected the first time traces are requested. It queries block 1 or a user supplied block
+// (which we presume exists). The function returns false if neither works.
 func (conn *Connection) IsNodeTracing() (error, bool) {
-	firstTrace := base.Max(1, base.KnownBlock(conn.Chain, base.FirstTrace))
-	varName := "TB_" + strings.ToUpper(conn.Chain) + "_FIRSTTRACE"
+	_, err := conn.GetTracesByBlockNumber(firstTraceBlock(conn.Chain))
+	return err, err == nil
+}
+
+func largestPrefund(chain string) (prefunds.Allocation, error) {
+	thePath := filepath.Join(config.MustGetPathToChainConfig(chain), "allocs.csv")
+	return prefunds.GetLargestPrefund(chain, thePath)
+}
+
+func firstTraceBlock(chain string) base.Blknum {
+	firstTrace := base.Max(1, base.KnownBlock(chain, base.FirstTrace))
+	varName := "TB_" + strings.ToUpper(chain) + "_FIRSTTRACE"
 	if len(os.Getenv(varName)) > 0 {
 		firstTrace = base.Max(firstTrace, base.MustParseValue(os.Getenv(varName)))
 	}
-	_, err := conn.GetTracesByBlockNumber(firstTrace)
-	return err, err == nil
+	return firstTrace
+}
+
+// probeProvider runs the same checks as IsNodeArchive and IsNodeTracing directly against a
+// single provider (bypassing the chain's provider pool and the cache). It is used to decide
+// where to route calls when a chain has more than one rpcProvider.
+func probeProvider(chain, url string) (query.Capabilities, error) {
+	caps := query.Capabilities{}
+	if _, err := query.QueryUrl[string](url, "eth_blockNumber", query.Params{}); err != nil {
+		return caps, err
+	}
+
+	if largest, err := largestPrefund(chain); err == nil {
+		params := query.Params{largest.Address.Hex(), "0x0"}
+		if bal, err := query.QueryUrl[string](url, "eth_getBalance", params); err == nil {
+			if wei, ok := base.NewWei(0).SetString(*bal, 0); ok {
+				caps.Archive = wei.Cmp(&largest.Balance) == 0
+			}
+		}
+	}
+
+	params := query.Params{fmt.Sprintf("0x%x", firstTraceBlock(chain))}
+	if traces, err := query.QueryUrl[[]types.Trace](url, "trace_block", params); err == nil {
+		caps.Tracing = traces != nil
+	}
+	if results, err := query.QueryUrl[[]debugTraceResult](url, "debug_traceBlockByNumber", append(params, callTracer)); err == nil {
+		caps.DebugTracing = results != nil
+	}
+
+	return caps, nil
+}
+
+func init() {
+	query.SetProviderProbe(probeProvider)
 }
@@ -0,0 +1,253 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/rpc/query"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Multicall3 is the address at which the Multicall3 contract (github.com/mds1/multicall) is
+// deployed on nearly every chain
+var Multicall3 = base.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// maxMulticallBytes limits the calldata sent in a single aggregate3 call so that it stays well
+// under the request size and gas limits most nodes place on eth_call
+const maxMulticallBytes = 64 * 1024
+
+const multicallAbiJson = `[{
+	"name": "aggregate3",
+	"type": "function",
+	"stateMutability": "payable",
+	"inputs": [{
+		"name": "calls",
+		"type": "tuple[]",
+		"components": [
+			{ "name": "target", "type": "address" },
+			{ "name": "allowFailure", "type": "bool" },
+			{ "name": "callData", "type": "bytes" }
+		]
+	}],
+	"outputs": [{
+		"name": "returnData",
+		"type": "tuple[]",
+		"components": [
+			{ "name": "success", "type": "bool" },
+			{ "name": "returnData", "type": "bytes" }
+		]
+	}]
+}]`
+
+var multicallAbi abi.ABI
+
+func init() {
+	var err error
+	if multicallAbi, err = abi.JSON(strings.NewReader(multicallAbiJson)); err != nil {
+		panic(err)
+	}
+}
+
+// ErrCallFailed is reported for calls that Multicall3 reports as having failed (usually reverted)
+var ErrCallFailed = errors.New("the call failed")
+
+// CallRequest is a single eth_call to be made by CallMany
+type CallRequest struct {
+	To   base.Address
+	Data string
+}
+
+// CallResponse is the result of a CallRequest. If the call failed, Err is non-nil and Data is
+// whatever the call returned (for a revert, the reason).
+type CallResponse struct {
+	Data string
+	Err  error
+}
+
+// multicallMissing holds, per chain, the latest block at which Multicall3 was found not to be deployed
+var multicallMissing sync.Map
+
+// CallMany makes the given calls at block bn, returning their results in the same order. Where
+// Multicall3 is deployed, the calls are aggregated (with allowFailure) into as few eth_calls as the
+// calldata size allows and those are sent in a single batch. Otherwise, or if an aggregate call
+// itself fails, the calls are sent one-by-one (again in a single batch). An individual call's failure
+// is reported in its CallResponse. An error is returned only if the node could not be queried.
+func (conn *Connection) CallMany(calls []CallRequest, bn base.Blknum) ([]CallResponse, error) {
+	responses := make([]CallResponse, len(calls))
+	if len(calls) == 0 {
+		return responses, nil
+	}
+
+	if len(calls) == 1 || !conn.isMulticallAvailable(bn) {
+		return responses, conn.callEach(calls, responses, bn)
+	}
+
+	chunks := chunkCalls(calls)
+	payloads := make([]query.BatchPayload, 0, len(chunks))
+	for i, chunk := range chunks {
+		data, err := packAggregate3(calls[chunk[0]:chunk[1]])
+		if err != nil {
+			return nil, err
+		}
+		payloads = append(payloads, query.BatchPayload{
+			Key: fmt.Sprint(i),
+			Payload: &query.Payload{
+				Method: "eth_call",
+				Params: query.Params{
+					map[string]any{
+						"to":   Multicall3.Hex(),
+						"data": data,
+					},
+					fmt.Sprintf("0x%x", bn),
+				},
+			},
+		})
+	}
+
+	output, err := query.QueryBatchWithErrors[string](conn.Chain, map[string]string{}, payloads)
+	if _, ok := err.(query.BatchError); err != nil && !ok {
+		return nil, err
+	}
+
+	for i, chunk := range chunks {
+		start, end := chunk[0], chunk[1]
+		result := output[fmt.Sprint(i)]
+		if result != nil && (*result == "" || *result == "0x") {
+			conn.setMulticallMissing(bn)
+		}
+		if result == nil || !unpackAggregate3(*result, responses[start:end]) {
+			if err := conn.callEach(calls[start:end], responses[start:end], bn); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return responses, nil
+}
+
+// callEach makes each call separately, filling in the corresponding responses. Even a single call is
+// sent as a batch so that the node's answer to the call (for example, a revert) may be told apart from
+// a failure to query the node.
+func (conn *Connection) callEach(calls []CallRequest, responses []CallResponse, bn base.Blknum) error {
+	payloads := make([]query.BatchPayload, 0, len(calls))
+	for i, call := range calls {
+		payloads = append(payloads, query.BatchPayload{
+			Key: fmt.Sprint(i),
+			Payload: &query.Payload{
+				Method: "eth_call",
+				Params: query.Params{
+					map[string]any{
+						"to":   call.To.Hex(),
+						"data": call.Data,
+					},
+					fmt.Sprintf("0x%x", bn),
+				},
+			},
+		})
+	}
+
+	output, err := query.QueryBatchWithErrors[string](conn.Chain, map[string]string{}, payloads)
+	batchErr, ok := err.(query.BatchError)
+	if err != nil && !ok {
+		return err
+	}
+
+	for i := range calls {
+		key := fmt.Sprint(i)
+		if result := output[key]; result != nil {
+			responses[i] = CallResponse{Data: *result}
+		} else if batchErr[key] != nil {
+			responses[i] = CallResponse{Err: batchErr[key]}
+		} else {
+			responses[i] = CallResponse{Err: ErrCallFailed}
+		}
+	}
+	return nil
+}
+
+// isMulticallAvailable returns false if Multicall3 is known not to be deployed at block bn
+func (conn *Connection) isMulticallAvailable(bn base.Blknum) bool {
+	missing, ok := multicallMissing.Load(conn.Chain)
+	return !ok || bn > missing.(base.Blknum)
+}
+
+// setMulticallMissing records that Multicall3 is not deployed at block bn. The first time it is found
+// missing, the latest block is checked so that chains without it are only tried once.
+func (conn *Connection) setMulticallMissing(bn base.Blknum) {
+	if _, ok := multicallMissing.Load(conn.Chain); !ok {
+		if err := conn.IsContractAtLatest(Multicall3); errors.Is(err, ErrNotAContract) {
+			bn = base.NOPOSN
+		}
+	}
+	if missing, ok := multicallMissing.Load(conn.Chain); !ok || bn > missing.(base.Blknum) {
+		multicallMissing.Store(conn.Chain, bn)
+	}
+}
+
+// chunkCalls splits the calls into [start, end) ranges whose encoded calldata fits in maxMulticallBytes
+func chunkCalls(calls []CallRequest) [][2]int {
+	chunks := [][2]int{}
+	start, size := 0, 0
+	for i, call := range calls {
+		// each call is encoded as its data (padded) plus an offset and four words
+		callSize := 5*32 + (len(strings.TrimPrefix(call.Data, "0x"))/2+31)/32*32
+		if i > start && size+callSize > maxMulticallBytes {
+			chunks = append(chunks, [2]int{start, i})
+			start, size = i, 0
+		}
+		size += callSize
+	}
+	return append(chunks, [2]int{start, len(calls)})
+}
+
+type multicallCall struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type multicallResult struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// packAggregate3 returns the hex encoded calldata for an aggregate3 call making the given calls
+func packAggregate3(calls []CallRequest) (string, error) {
+	mcCalls := make([]multicallCall, 0, len(calls))
+	for _, call := range calls {
+		mcCalls = append(mcCalls, multicallCall{
+			Target:       call.To.Common(),
+			AllowFailure: true,
+			CallData:     base.Hex2Bytes(strings.TrimPrefix(call.Data, "0x")),
+		})
+	}
+	packed, err := multicallAbi.Pack("aggregate3", mcCalls)
+	if err != nil {
+		return "", err
+	}
+	return "0x" + base.Bytes2Hex(packed), nil
+}
+
+// unpackAggregate3 decodes the result of an aggregate3 call into responses, returning false if the
+// result could not be decoded or does not hold one result per response
+func unpackAggregate3(result string, responses []CallResponse) bool {
+	values, err := multicallAbi.Unpack("aggregate3", base.Hex2Bytes(strings.TrimPrefix(result, "0x")))
+	if err != nil || len(values) != 1 {
+		return false
+	}
+	results := *abi.ConvertType(values[0], new([]multicallResult)).(*[]multicallResult)
+	if len(results) != len(responses) {
+		return false
+	}
+	for i, r := range results {
+		responses[i] = CallResponse{Data: "0x" + base.Bytes2Hex(r.ReturnData)}
+		if !r.Success {
+			responses[i].Err = ErrCallFailed
+		}
+	}
+	return true
+}
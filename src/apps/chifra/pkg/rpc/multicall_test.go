@@ -0,0 +1,79 @@
+package rpc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+)
+
+func TestChunkCalls(t *testing.T) {
+	holder := base.HexToAddress("0xf503017d7baf7fbc0fff7492b751025c6a78179b")
+	calls := make([]CallRequest, 1000)
+	for i := range calls {
+		calls[i] = CallRequest{To: Multicall3, Data: tokenStateBalanceOf + holder.Pad32()}
+	}
+
+	// each balanceOf call is 36 bytes of data (two words padded) plus five words
+	chunks := chunkCalls(calls)
+	perChunk := maxMulticallBytes / (7 * 32)
+	if len(chunks) != (len(calls)+perChunk-1)/perChunk {
+		t.Fatal("wrong number of chunks", len(chunks))
+	}
+	next := 0
+	for _, chunk := range chunks {
+		if chunk[0] != next || chunk[1] <= chunk[0] || chunk[1]-chunk[0] > perChunk {
+			t.Error("bad chunk", chunk)
+		}
+		next = chunk[1]
+	}
+	if next != len(calls) {
+		t.Error("chunks do not cover the calls", next)
+	}
+
+	// a single call larger than the limit is still made
+	huge := []CallRequest{{To: Multicall3, Data: "0x" + strings.Repeat("00", maxMulticallBytes)}, calls[0]}
+	if chunks := chunkCalls(huge); len(chunks) != 2 || chunks[0] != [2]int{0, 1} {
+		t.Error("huge call not chunked alone", chunks)
+	}
+}
+
+func TestAggregate3(t *testing.T) {
+	calls := []CallRequest{
+		{To: base.HexToAddress("0x1"), Data: "0x18160ddd"},
+		{To: base.HexToAddress("0x2"), Data: "0x"},
+	}
+	packed, err := packAggregate3(calls)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(packed, "0x82ad56cb") {
+		t.Error("wrong selector", packed[:10])
+	}
+
+	returned, err := multicallAbi.Methods["aggregate3"].Outputs.Pack([]multicallResult{
+		{Success: true, ReturnData: base.Hex2Bytes("00000000000000000000000000000000000000000000000000000000000003e8")},
+		{Success: false, ReturnData: []byte{}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	responses := make([]CallResponse, 2)
+	if !unpackAggregate3("0x"+base.Bytes2Hex(returned), responses) {
+		t.Fatal("could not unpack")
+	}
+	if responses[0].Err != nil || base.HexToWei(responses[0].Data).Uint64() != 1000 {
+		t.Error("wrong first response", responses[0])
+	}
+	if responses[1].Err != ErrCallFailed {
+		t.Error("expected the second call to fail", responses[1])
+	}
+
+	if unpackAggregate3("0x"+base.Bytes2Hex(returned), make([]CallResponse, 3)) {
+		t.Error("unpacked into the wrong number of responses")
+	}
+	if unpackAggregate3("0x", responses) {
+		t.Error("unpacked an empty result")
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/metrics"
+)
+
+// rpcCall is the part of a JSON-RPC request (or of one item in a batch) that the transport
+// needs in order to route the request and to report on it.
+type rpcCall struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+}
+
+// callsFromBody returns the call or calls in a single or batched JSON-RPC request body.
+func callsFromBody(body []byte) []rpcCall {
+	calls := []rpcCall{}
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		_ = json.Unmarshal(trimmed, &calls)
+	} else {
+		var c rpcCall
+		if json.Unmarshal(trimmed, &c) == nil {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// failedMethods returns the methods of the calls that the node answered with a JSON-RPC
+// error. Batched responses are matched to their calls by id.
+func failedMethods(calls []rpcCall, response []byte) []string {
+	if !bytes.Contains(response, []byte(`"error"`)) {
+		return nil
+	}
+
+	type result struct {
+		ID    json.RawMessage `json:"id"`
+		Error json.RawMessage `json:"error"`
+	}
+	isError := func(r result) bool {
+		return len(r.Error) > 0 && string(r.Error) != "null"
+	}
+
+	ret := []string{}
+	trimmed := bytes.TrimSpace(response)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		byId := make(map[string]string, len(calls))
+		for _, c := range calls {
+			byId[string(c.ID)] = c.Method
+		}
+		results := []result{}
+		_ = json.Unmarshal(trimmed, &results)
+		for _, r := range results {
+			if isError(r) {
+				if method, ok := byId[string(r.ID)]; ok {
+					ret = append(ret, method)
+				}
+			}
+		}
+
+	} else {
+		var r result
+		if json.Unmarshal(trimmed, &r) == nil && isError(r) {
+			// a single error object in answer to a batch means the whole batch failed
+			for _, c := range calls {
+				ret = append(ret, c.Method)
+			}
+		}
+	}
+	return ret
+}
+
+// recordCalls reports a request to the node to the metrics package. If err is not nil,
+// every call in the request is counted as failed.
+func recordCalls(chain string, calls []rpcCall, response []byte, err error, start time.Time) {
+	methods := make([]string, 0, len(calls))
+	for _, c := range calls {
+		methods = append(methods, c.Method)
+	}
+
+	failed := methods
+	if err == nil {
+		failed = failedMethods(calls, response)
+	}
+	metrics.RpcCall(chain, methods, failed, time.Since(start))
+}
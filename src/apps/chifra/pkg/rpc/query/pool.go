@@ -0,0 +1,345 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package query
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"golang.org/x/time/rate"
+)
+
+// Capabilities describes what an RPC endpoint can do as reported by the health probe.
+type Capabilities struct {
+	Archive      bool
+	Tracing      bool
+	DebugTracing bool
+}
+
+// ProbeFunc checks a single endpoint for a chain. It returns an error if the endpoint
+// could not be reached at all.
+type ProbeFunc func(chain, url string) (Capabilities, error)
+
+var providerProbe ProbeFunc
+
+// SetProviderProbe installs the health check used when a chain has more than one RPC
+// endpoint configured. The rpc package installs its IsNodeArchive/IsNodeTracing probes
+// here (this package cannot import it directly).
+func SetProviderProbe(probe ProbeFunc) {
+	providerProbe = probe
+}
+
+// failureCooldown is the amount of time an endpoint is skipped after it fails.
+var failureCooldown = 30 * time.Second
+
+// endpoint is a single RPC provider in a chain's pool.
+type endpoint struct {
+	url     string
+	caps    Capabilities
+	probed  bool
+	mutex   sync.Mutex
+	downAt  time.Time
+	failing bool
+}
+
+func (e *endpoint) isAvailable(now time.Time) bool {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return !e.failing || now.Sub(e.downAt) > failureCooldown
+}
+
+func (e *endpoint) markDown(err error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if !e.failing {
+		logger.Warn("RPC provider", e.url, "failed, will retry elsewhere:", err)
+	}
+	e.failing = true
+	e.downAt = time.Now()
+}
+
+func (e *endpoint) markUp() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.failing = false
+}
+
+// providerPool round-robins requests for a single chain across all of its configured RPC
+// endpoints, skips endpoints that failed recently, and sends methods that need special node
+// capabilities (tracing, archive state) only to endpoints that have them.
+type providerPool struct {
+	chain     string
+	endpoints []*endpoint
+	next      uint32
+	probeOnce sync.Once
+}
+
+func newProviderPool(chain string, urls []string) *providerPool {
+	pool := &providerPool{chain: chain}
+	for _, u := range urls {
+		pool.endpoints = append(pool.endpoints, &endpoint{url: u})
+	}
+	return pool
+}
+
+var poolMutex sync.Mutex
+var perChainPools = map[string]*providerPool{}
+
+func getPool(chain string) *providerPool {
+	poolMutex.Lock()
+	defer poolMutex.Unlock()
+	if perChainPools[chain] == nil {
+		perChainPools[chain] = newProviderPool(chain, config.GetRpcProviders(chain))
+	}
+	return perChainPools[chain]
+}
+
+// probe runs the health check against every endpoint (concurrently) the first time the pool
+// is used. Single-endpoint pools are never probed -- there is nothing to route around.
+func (p *providerPool) probe() {
+	p.probeOnce.Do(func() {
+		if len(p.endpoints) < 2 || providerProbe == nil {
+			return
+		}
+		var wg sync.WaitGroup
+		for _, ep := range p.endpoints {
+			wg.Add(1)
+			go func(ep *endpoint) {
+				defer wg.Done()
+				caps, err := providerProbe(p.chain, ep.url)
+				if err != nil {
+					ep.markDown(err)
+					return
+				}
+				ep.caps = caps
+				ep.probed = true
+			}(ep)
+		}
+		wg.Wait()
+	})
+}
+
+// pick returns the next endpoint (round-robin) that satisfies needs and has not already been
+// tried. If no probed endpoint has a required capability, the requirement is dropped so the node
+// itself can report the error. Endpoints in their failure cooldown are used only as a last resort.
+func (p *providerPool) pick(needs Capabilities, tried map[*endpoint]bool) *endpoint {
+	p.probe()
+
+	eligible := func(ep *endpoint, needs Capabilities) bool {
+		return !tried[ep] &&
+			(!needs.Tracing || ep.caps.Tracing) &&
+			(!needs.DebugTracing || ep.caps.DebugTracing) &&
+			(!needs.Archive || ep.caps.Archive)
+	}
+
+	anyTracing, anyDebugTracing, anyArchive := false, false, false
+	for _, ep := range p.endpoints {
+		anyTracing = anyTracing || ep.caps.Tracing
+		anyDebugTracing = anyDebugTracing || ep.caps.DebugTracing
+		anyArchive = anyArchive || ep.caps.Archive
+	}
+	needs.Tracing = needs.Tracing && anyTracing
+	needs.DebugTracing = needs.DebugTracing && anyDebugTracing
+	needs.Archive = needs.Archive && anyArchive
+
+	now := time.Now()
+	start := atomic.AddUint32(&p.next, 1)
+	var fallback *endpoint
+	for i := 0; i < len(p.endpoints); i++ {
+		ep := p.endpoints[(int(start)+i)%len(p.endpoints)]
+		if !eligible(ep, needs) {
+			continue
+		}
+		if ep.isAvailable(now) {
+			return ep
+		}
+		if fallback == nil {
+			fallback = ep
+		}
+	}
+	return fallback
+}
+
+// archiveMethods are the methods whose answer at an old block requires an archive node
+var archiveMethods = map[string]bool{
+	"eth_getBalance":          true,
+	"eth_getCode":             true,
+	"eth_getStorageAt":        true,
+	"eth_getTransactionCount": true,
+	"eth_call":                true,
+}
+
+// needsFromBody inspects a single or batched JSON-RPC request body and returns the
+// capabilities an endpoint must have to serve every method in it.
+func needsFromBody(body []byte) Capabilities {
+	return needsOfCalls(callsFromBody(body))
+}
+
+func needsOfCalls(calls []rpcCall) Capabilities {
+	ret := Capabilities{}
+	for _, c := range calls {
+		if strings.HasPrefix(c.Method, "trace_") {
+			ret.Tracing = true
+		}
+		if strings.HasPrefix(c.Method, "debug_trace") {
+			ret.DebugTracing = true
+		}
+		if archiveMethods[c.Method] {
+			ret.Archive = true
+		}
+	}
+	return ret
+}
+
+var errNoProvider = errors.New("no rpc provider available")
+
+// poolTransport is an http.RoundTripper that ignores the request's url and instead sends the
+// request to an endpoint chosen from the pool, failing over to the next endpoint if the request
+// fails to connect or the server returns a 429 or 5xx status. Once every endpoint has been tried,
+// the whole attempt is repeated with exponential backoff per the chain's RpcSettings. If the chain
+// is rate limited, each attempt first waits on the limiter.
+type poolTransport struct {
+	pool     *providerPool
+	base     http.RoundTripper
+	settings config.RpcSettings
+	limiter  *rate.Limiter
+}
+
+func newPoolTransport(pool *providerPool, settings config.RpcSettings) *poolTransport {
+	base := http.DefaultTransport.(*http.Transport).Clone()
+	base.ResponseHeaderTimeout = time.Duration(settings.Timeout) * time.Second
+	return &poolTransport{
+		pool:     pool,
+		base:     base,
+		settings: settings,
+		limiter:  newLimiter(settings),
+	}
+}
+
+func (t *poolTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+	}
+
+	start := time.Now()
+	calls := callsFromBody(body)
+	methods := make([]string, 0, len(calls))
+	for _, call := range calls {
+		methods = append(methods, call.Method)
+	}
+	settings, once := withoutRetries(t.settings, methods...)
+
+	var response *http.Response
+	err := withRetry(req.Context(), settings, func() (err error) {
+		if t.limiter != nil {
+			if err = t.limiter.Wait(req.Context()); err != nil {
+				return err
+			}
+		}
+		response, err = t.tryEndpoints(req, calls, body, once)
+		return err
+	})
+	if err != nil {
+		recordCalls(t.pool.chain, calls, nil, err, start)
+		return nil, &retriedError{err}
+	}
+
+	// We read the response here (callers read all of it anyway) so that we can count the
+	// calls that the node answered with an error.
+	respBody, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	recordCalls(t.pool.chain, calls, respBody, err, start)
+	if err != nil {
+		return nil, err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(respBody))
+	return response, nil
+}
+
+// tryEndpoints sends the request to each eligible endpoint in turn until one of them answers
+// with something other than a retryable error. If once is true, only one endpoint is tried.
+func (t *poolTransport) tryEndpoints(req *http.Request, calls []rpcCall, body []byte, once bool) (*http.Response, error) {
+	needs := needsOfCalls(calls)
+	tried := map[*endpoint]bool{}
+	var lastErr error
+	for ep := t.pool.pick(needs, tried); ep != nil && !(once && len(tried) > 0); ep = t.pool.pick(needs, tried) {
+		tried[ep] = true
+
+		target, err := url.Parse(ep.url)
+		if err != nil {
+			ep.markDown(err)
+			lastErr = err
+			continue
+		}
+
+		r := req.Clone(req.Context())
+		r.URL = target
+		r.Host = target.Host
+		if target.User != nil {
+			password, _ := target.User.Password()
+			r.SetBasicAuth(target.User.Username(), password)
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		r.ContentLength = int64(len(body))
+
+		response, err := t.base.RoundTrip(r)
+		if err != nil {
+			ep.markDown(err)
+			lastErr = err
+			continue
+		}
+		if isRetryableStatus(response.StatusCode) {
+			_, _ = io.Copy(io.Discard, response.Body)
+			response.Body.Close()
+			lastErr = newStatusError(response)
+			if response.StatusCode != http.StatusTooManyRequests {
+				// a throttled endpoint is healthy, it's just busy
+				ep.markDown(lastErr)
+			}
+			continue
+		}
+
+		ep.markUp()
+		return response, nil
+	}
+
+	if lastErr == nil {
+		lastErr = fmt.Errorf("%w for chain %s", errNoProvider, t.pool.chain)
+	}
+	return nil, lastErr
+}
+
+var clientMutex sync.Mutex
+var perChainClients = map[string]*http.Client{}
+
+// HttpClient returns an http.Client for the chain whose requests are spread across (and fail
+// over between) every RPC endpoint configured for that chain, and which honors the chain's
+// timeout, retry and rate limit settings. Callers may post to any url (typically the primary
+// rpcProvider) -- the transport chooses the actual endpoint.
+func HttpClient(chain string) *http.Client {
+	clientMutex.Lock()
+	defer clientMutex.Unlock()
+	if perChainClients[chain] == nil {
+		perChainClients[chain] = &http.Client{
+			Transport: newPoolTransport(getPool(chain), config.GetRpcSettings(chain)),
+		}
+	}
+	return perChainClients[chain]
+}
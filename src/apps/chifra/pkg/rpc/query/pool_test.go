@@ -0,0 +1,163 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package query
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+)
+
+func newTestServer(status int, hits *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(hits, 1)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	}))
+}
+
+func newTestClient(pool *providerPool) *http.Client {
+	settings := config.DefaultRpcSettings
+	settings.BackoffMs = 1
+	settings.MaxBackoffMs = 2
+	return &http.Client{Transport: newPoolTransport(pool, settings)}
+}
+
+func TestPoolFailover(t *testing.T) {
+	var badHits, goodHits int32
+	bad := newTestServer(http.StatusBadGateway, &badHits)
+	defer bad.Close()
+	good := newTestServer(http.StatusOK, &goodHits)
+	defer good.Close()
+
+	pool := newProviderPool("test", []string{bad.URL, good.URL})
+	pool.probeOnce.Do(func() {}) // no probing in tests
+	client := newTestClient(pool)
+
+	for i := 0; i < 10; i++ {
+		result, err := queryWithClient[string](client, config.DefaultRpcSettings, bad.URL, map[string]string{}, "eth_blockNumber", Params{})
+		if err != nil {
+			t.Fatal("expected failover to succeed, got", err)
+		}
+		if *result != "0x1" {
+			t.Fatal("unexpected result", *result)
+		}
+	}
+
+	if goodHits != 10 {
+		t.Error("expected every call to land on the healthy server, got", goodHits)
+	}
+	if badHits != 1 {
+		t.Error("expected the failing server to be skipped after its first failure, got", badHits)
+	}
+}
+
+func TestPoolSendsTransactionsOnce(t *testing.T) {
+	var hits1, hits2 int32
+	s1 := newTestServer(http.StatusBadGateway, &hits1)
+	defer s1.Close()
+	s2 := newTestServer(http.StatusBadGateway, &hits2)
+	defer s2.Close()
+
+	pool := newProviderPool("test", []string{s1.URL, s2.URL})
+	pool.probeOnce.Do(func() {})
+	client := newTestClient(pool)
+
+	// The node may have accepted the transaction before failing to answer, so it is not sent again
+	if _, err := queryWithClient[string](client, config.DefaultRpcSettings, s1.URL, map[string]string{}, "eth_sendRawTransaction", Params{"0x01"}); err == nil {
+		t.Fatal("expected the failure to be reported")
+	}
+	if hits1+hits2 != 1 {
+		t.Error("expected a single attempt, got", hits1+hits2)
+	}
+}
+
+func TestPoolRoundRobin(t *testing.T) {
+	var hits1, hits2 int32
+	s1 := newTestServer(http.StatusOK, &hits1)
+	defer s1.Close()
+	s2 := newTestServer(http.StatusOK, &hits2)
+	defer s2.Close()
+
+	pool := newProviderPool("test", []string{s1.URL, s2.URL})
+	pool.probeOnce.Do(func() {})
+	client := newTestClient(pool)
+
+	for i := 0; i < 10; i++ {
+		if _, err := queryWithClient[string](client, config.DefaultRpcSettings, s1.URL, map[string]string{}, "eth_blockNumber", Params{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if hits1 != 5 || hits2 != 5 {
+		t.Error("expected calls to be evenly spread, got", hits1, hits2)
+	}
+}
+
+func TestPoolTraceRouting(t *testing.T) {
+	var plainHits, tracingHits int32
+	plain := newTestServer(http.StatusOK, &plainHits)
+	defer plain.Close()
+	tracing := newTestServer(http.StatusOK, &tracingHits)
+	defer tracing.Close()
+
+	pool := newProviderPool("test", []string{plain.URL, tracing.URL})
+	pool.probeOnce.Do(func() {})
+	pool.endpoints[1].caps = Capabilities{Tracing: true, Archive: true}
+	client := newTestClient(pool)
+
+	for i := 0; i < 4; i++ {
+		if _, err := queryWithClient[string](client, config.DefaultRpcSettings, plain.URL, map[string]string{}, "trace_block", Params{"0x1"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if plainHits != 0 || tracingHits != 4 {
+		t.Error("expected trace_ calls to go only to the tracing node, got", plainHits, tracingHits)
+	}
+}
+
+func TestNeedsFromBody(t *testing.T) {
+	tests := []struct {
+		body string
+		want Capabilities
+	}{
+		{`{"method":"eth_blockNumber"}`, Capabilities{}},
+		{`{"method":"trace_block"}`, Capabilities{Tracing: true}},
+		{`{"method":"eth_getBalance"}`, Capabilities{Archive: true}},
+		{`[{"method":"eth_getBlockByNumber"},{"method":"trace_transaction"}]`, Capabilities{Tracing: true}},
+		{`not json`, Capabilities{}},
+	}
+	for _, tt := range tests {
+		if got := needsFromBody([]byte(tt.body)); got != tt.want {
+			t.Errorf("needsFromBody(%s) = %v, want %v", tt.body, got, tt.want)
+		}
+	}
+}
+
+func TestFailedMethods(t *testing.T) {
+	batch := callsFromBody([]byte(`[{"id":1,"method":"eth_getBalance"},{"id":2,"method":"eth_call"}]`))
+	single := callsFromBody([]byte(`{"id":1,"method":"eth_blockNumber"}`))
+	tests := []struct {
+		calls    []rpcCall
+		response string
+		want     string
+	}{
+		{single, `{"id":1,"result":"0x1"}`, ""},
+		{single, `{"id":1,"error":{"code":-32000,"message":"oops"}}`, "eth_blockNumber"},
+		{batch, `[{"id":2,"result":"0x"},{"id":1,"result":"0x0"}]`, ""},
+		{batch, `[{"id":2,"error":{"code":3,"message":"reverted"}},{"id":1,"result":"0x0"}]`, "eth_call"},
+		{batch, `{"id":null,"error":{"code":-32005,"message":"limit exceeded"}}`, "eth_getBalance,eth_call"},
+	}
+	for _, tt := range tests {
+		if got := strings.Join(failedMethods(tt.calls, []byte(tt.response)), ","); got != tt.want {
+			t.Errorf("failedMethods(%s) = %s, want %s", tt.response, got, tt.want)
+		}
+	}
+}
@@ -2,13 +2,16 @@ package query
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"runtime"
+	"sort"
 	"strings"
 	"sync/atomic"
+	"time"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/debug"
@@ -25,6 +28,7 @@ type Payload struct {
 }
 
 type rpcResponse[T any] struct {
+	ID     int           `json:"id"`
 	Result T             `json:"result"`
 	Error  *eip1474Error `json:"error"`
 }
@@ -53,7 +57,7 @@ type BatchPayload struct {
 // Query returns a single result for given method and params.
 func Query[T any](chain string, method string, params Params) (*T, error) {
 	url := config.GetChain(chain).RpcProvider
-	return QueryUrl[T](url, method, params)
+	return queryWithClient[T](HttpClient(chain), config.GetRpcSettings(chain), url, map[string]string{}, method, params)
 }
 
 // QueryUrl is just like Query, but it does not resolve chain to RPC provider URL
@@ -61,8 +65,18 @@ func QueryUrl[T any](url string, method string, params Params) (*T, error) {
 	return QueryWithHeaders[T](url, map[string]string{}, method, params)
 }
 
+// urlClient is shared by all calls made directly to a url (as opposed to a chain)
+var urlClient = &http.Client{
+	Timeout: time.Duration(config.DefaultRpcSettings.Timeout) * time.Second,
+}
+
 // QueryWithHeaders returns a single result for a given method and params.
 func QueryWithHeaders[T any](url string, headers map[string]string, method string, params Params) (*T, error) {
+	return queryWithClient[T](urlClient, config.DefaultRpcSettings, url, headers, method, params)
+}
+
+func queryWithClient[T any](client *http.Client, settings config.RpcSettings, url string, headers map[string]string, method string, params Params) (*T, error) {
+	settings, _ = withoutRetries(settings, method)
 	payloadToSend := rpcPayload{
 		Jsonrpc: "2.0",
 		Method:  method,
@@ -72,100 +86,199 @@ func QueryWithHeaders[T any](url string, headers map[string]string, method strin
 
 	debug.DebugCurl(rpcDebug{url: url, payload: payloadToSend, headers: headers})
 
-	if plBytes, err := json.Marshal(payloadToSend); err != nil {
+	plBytes, err := json.Marshal(payloadToSend)
+	if err != nil {
 		return nil, err
-	} else {
-		body := bytes.NewReader(plBytes)
-		if request, err := http.NewRequest("POST", url, body); err != nil {
-			return nil, err
-		} else {
-			request.Header.Set("Content-Type", "application/json")
-			for key, value := range headers {
-				request.Header.Set(key, value)
-			}
+	}
 
-			client := &http.Client{}
-			if response, err := client.Do(request); err != nil {
-				return nil, err
-			} else if response.StatusCode != 200 {
-				return nil, fmt.Errorf("%s: %d", response.Status, response.StatusCode)
-			} else {
-				defer response.Body.Close()
-
-				if theBytes, err := io.ReadAll(response.Body); err != nil {
-					return nil, err
-				} else {
-					var result rpcResponse[T]
-					if err = json.Unmarshal(theBytes, &result); err != nil {
-						return nil, err
-					} else {
-						if result.Error != nil {
-							return nil, fmt.Errorf("%d: %s", result.Error.Code, result.Error.Message)
-						}
-						return &result.Result, nil
-					}
-				}
-			}
+	var result rpcResponse[T]
+	err = withRetry(context.Background(), settings, func() error {
+		theBytes, err := post(client, url, headers, plBytes)
+		if err != nil {
+			return err
 		}
+		result = rpcResponse[T]{}
+		if err = json.Unmarshal(theBytes, &result); err != nil {
+			return err
+		}
+		if result.Error != nil {
+			return result.Error
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	return &result.Result, nil
+}
+
+// post sends the body to the url and returns the response body. Any status other
+// than 200 is returned as a *StatusError.
+func post(client *http.Client, url string, headers map[string]string, plBytes []byte) ([]byte, error) {
+	request, err := http.NewRequest("POST", url, bytes.NewReader(plBytes))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		request.Header.Set(key, value)
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, response.Body)
+		return nil, newStatusError(response)
+	}
+	return io.ReadAll(response.Body)
+}
+
+// BatchError reports the individual items of a batch that the node answered with
+// an error. It maps the item's Key to the error.
+type BatchError map[string]error
+
+func (e BatchError) Error() string {
+	keys := make([]string, 0, len(e))
+	for key := range e {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	msgs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		msgs = append(msgs, fmt.Sprintf("%s: %v", key, e[key]))
+	}
+	return "batch items failed: " + strings.Join(msgs, ", ")
 }
 
 // QueryBatch batches requests to the node. Returned values are stored in map, with the same keys as defined
-// in `batchPayload` (this way we don't have to operate on array indices)
+// in `batchPayload` (this way we don't have to operate on array indices). Items that the node answers with an
+// error carry the zero value of T. Use QueryBatchWithErrors to see those errors.
 func QueryBatch[T any](chain string, batchPayload []BatchPayload) (map[string]*T, error) {
 	return QueryBatchWithHeaders[T](chain, map[string]string{}, batchPayload)
 }
 
+// QueryBatchWithHeaders is just like QueryBatch, but sends the given headers with the request.
 func QueryBatchWithHeaders[T any](chain string, headers map[string]string, batchPayload []BatchPayload) (map[string]*T, error) {
-	keys := make([]string, 0, len(batchPayload))
-	payloads := make([]Payload, 0, len(batchPayload))
-	for _, bpl := range batchPayload {
-		keys = append(keys, bpl.Key)
-		payloads = append(payloads, *bpl.Payload)
+	results, err := QueryBatchWithErrors[T](chain, headers, batchPayload)
+	if _, ok := err.(BatchError); ok {
+		for key, value := range results {
+			if value == nil {
+				results[key] = new(T)
+			}
+		}
+		return results, nil
 	}
+	return results, err
+}
 
+// QueryBatchWithErrors batches requests to the node. The results map holds an entry for every key in
+// `batchPayload`. If some items failed, their entry is nil and the returned error is a BatchError
+// describing each failure. Items that fail because the node is rate limiting us are retried (alone)
+// with backoff. Any other error means the batch as a whole failed.
+func QueryBatchWithErrors[T any](chain string, headers map[string]string, batchPayload []BatchPayload) (map[string]*T, error) {
 	url := config.GetChain(chain).RpcProvider
-	payloadToSend := make([]rpcPayload, 0, len(payloads))
-
-	for _, payload := range payloads {
-		theLoad := rpcPayload{
-			Jsonrpc: "2.0",
-			Method:  payload.Method,
-			Params:  payload.Params,
-			ID:      int(atomic.AddUint32(&rpcCounter, 1)),
-		}
-		debug.DebugCurl(rpcDebug{
-			url:     url,
-			payload: theLoad,
-			headers: headers,
-		})
-		payloadToSend = append(payloadToSend, theLoad)
-	}
+	return queryBatchWithClient[T](HttpClient(chain), config.GetRpcSettings(chain), url, headers, batchPayload)
+}
 
-	plBytes, err := json.Marshal(payloadToSend)
-	if err != nil {
-		return nil, err
+func queryBatchWithClient[T any](client *http.Client, settings config.RpcSettings, url string, headers map[string]string, batchPayload []BatchPayload) (map[string]*T, error) {
+	methods := make([]string, 0, len(batchPayload))
+	for _, bpl := range batchPayload {
+		methods = append(methods, bpl.Method)
 	}
+	settings, _ = withoutRetries(settings, methods...)
 
-	var result []rpcResponse[T]
-	body := bytes.NewReader(plBytes)
-	if response, err := http.Post(url, "application/json", body); err != nil {
-		return nil, err
-	} else {
-		defer response.Body.Close()
-		if theBytes, err := io.ReadAll(response.Body); err != nil {
-			return nil, err
-		} else {
-			if err = json.Unmarshal(theBytes, &result); err != nil {
-				return nil, err
+	results := make(map[string]*T, len(batchPayload))
+	itemErrors := BatchError{}
+	pending := batchPayload
+
+	err := withRetry(context.Background(), settings, func() error {
+		keyById := make(map[int]string, len(pending))
+		payloadToSend := make([]rpcPayload, 0, len(pending))
+		for _, bpl := range pending {
+			theLoad := rpcPayload{
+				Jsonrpc: "2.0",
+				Method:  bpl.Method,
+				Params:  bpl.Params,
+				ID:      int(atomic.AddUint32(&rpcCounter, 1)),
+			}
+			debug.DebugCurl(rpcDebug{
+				url:     url,
+				payload: theLoad,
+				headers: headers,
+			})
+			keyById[theLoad.ID] = bpl.Key
+			payloadToSend = append(payloadToSend, theLoad)
+		}
+
+		plBytes, err := json.Marshal(payloadToSend)
+		if err != nil {
+			return err
+		}
+
+		theBytes, err := post(client, url, headers, plBytes)
+		if err != nil {
+			return err
+		}
+
+		var result []rpcResponse[T]
+		if err = json.Unmarshal(theBytes, &result); err != nil {
+			// Some nodes reject an entire batch with a single (non-array) error
+			var single rpcResponse[T]
+			if json.Unmarshal(theBytes, &single) == nil && single.Error != nil {
+				return single.Error
+			}
+			return err
+		}
+
+		// The spec does not require the node to answer in order, so we match on id
+		retry := make(map[string]bool)
+		for i := range result {
+			key, ok := keyById[result[i].ID]
+			if !ok {
+				continue
 			}
-			results := make(map[string]*T, len(batchPayload))
-			for index, key := range keys {
-				results[key] = &result[index].Result
+			delete(keyById, result[i].ID)
+			if result[i].Error != nil {
+				itemErrors[key] = result[i].Error
+				retry[key] = result[i].Error.IsRateLimited()
+				continue
+			}
+			delete(itemErrors, key)
+			results[key] = &result[i].Result
+		}
+		for _, key := range keyById {
+			itemErrors[key] = fmt.Errorf("no response for item %s", key)
+		}
+
+		pending = pending[:0:0]
+		for _, bpl := range batchPayload {
+			if retry[bpl.Key] {
+				pending = append(pending, bpl)
 			}
-			return results, err
 		}
+		if len(pending) > 0 {
+			return itemErrors[pending[0].Key]
+		}
+		return nil
+	})
+
+	if err != nil && len(itemErrors) == 0 {
+		return nil, err
+	}
+
+	for _, bpl := range batchPayload {
+		if _, ok := results[bpl.Key]; !ok {
+			results[bpl.Key] = nil
+		}
+	}
+	if len(itemErrors) > 0 {
+		return results, itemErrors
 	}
+	return results, nil
 }
 
 func init() {
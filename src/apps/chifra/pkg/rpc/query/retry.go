@@ -0,0 +1,185 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+	"golang.org/x/time/rate"
+)
+
+// StatusError is returned when an RPC endpoint answers with a non-200 http status.
+type StatusError struct {
+	Status     string
+	StatusCode int
+	RetryAfter time.Duration
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s: %d", e.Status, e.StatusCode)
+}
+
+func newStatusError(response *http.Response) *StatusError {
+	ret := &StatusError{
+		Status:     response.Status,
+		StatusCode: response.StatusCode,
+	}
+	if secs, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil && secs > 0 {
+		ret.RetryAfter = time.Duration(secs) * time.Second
+	}
+	return ret
+}
+
+// isRetryableStatus returns true for http statuses that indicate throttling or a
+// (presumably transient) server-side problem.
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= 500
+}
+
+// rateLimitCodes are JSON-RPC error codes that various node providers use to signal
+// that the caller is being throttled.
+var rateLimitCodes = map[int]bool{
+	-32005: true, // EIP-1474 limit exceeded (Infura, Alchemy, Erigon)
+	-32090: true, // Ankr, QuickNode
+	-32029: true, // Chainstack
+	429:    true, // some providers simply echo the http status
+}
+
+// IsRateLimited returns true if the JSON-RPC error signals that the caller is being throttled.
+func (e *eip1474Error) IsRateLimited() bool {
+	if e == nil {
+		return false
+	}
+	if rateLimitCodes[e.Code] {
+		return true
+	}
+	msg := strings.ToLower(e.Message)
+	return strings.Contains(msg, "rate limit") || strings.Contains(msg, "too many requests")
+}
+
+func (e *eip1474Error) Error() string {
+	return fmt.Sprintf("%d: %s", e.Code, e.Message)
+}
+
+// retriedError wraps an error that has already been retried (by the chain's transport)
+// so that callers further up do not retry it again.
+type retriedError struct {
+	err error
+}
+
+func (e *retriedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *retriedError) Unwrap() error {
+	return e.err
+}
+
+// isRetryable returns true if a failed attempt is worth repeating after a backoff.
+func isRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var retried *retriedError
+	if errors.As(err, &retried) {
+		return false
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return isRetryableStatus(statusErr.StatusCode)
+	}
+
+	var rpcErr *eip1474Error
+	if errors.As(err, &rpcErr) {
+		return rpcErr.IsRateLimited()
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	return errors.Is(err, errNoProvider)
+}
+
+// backoff returns the time to wait before the given (zero-based) retry attempt. The delay
+// doubles with each attempt up to MaxBackoffMs, with jitter so that concurrent callers
+// do not retry in lock step. A server-supplied Retry-After takes precedence if it is longer.
+func backoff(settings config.RpcSettings, attempt int, err error) time.Duration {
+	delay := float64(settings.BackoffMs) * math.Pow(2, float64(attempt))
+	delay = math.Min(delay, float64(settings.MaxBackoffMs))
+	wait := time.Duration(delay/2+rand.Float64()*delay/2) * time.Millisecond
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) && statusErr.RetryAfter > wait {
+		wait = statusErr.RetryAfter
+	}
+	return wait
+}
+
+// nonIdempotent lists the methods that change the node's state. A call to one of them that may have
+// reached the node is neither repeated nor sent to another endpoint. Had the node accepted the call,
+// the repeat would fail (for example, with "already known" or "nonce too low") and hide its success.
+var nonIdempotent = map[string]bool{
+	"eth_sendRawTransaction": true,
+	"eth_sendTransaction":    true,
+}
+
+// withoutRetries returns the settings with retries turned off if any of the methods is not idempotent
+func withoutRetries(settings config.RpcSettings, methods ...string) (config.RpcSettings, bool) {
+	for _, method := range methods {
+		if nonIdempotent[method] {
+			settings.MaxRetries = 0
+			return settings, true
+		}
+	}
+	return settings, false
+}
+
+// withRetry calls fn until it succeeds, returns a non-retryable error, or the retries
+// configured in settings are exhausted.
+func withRetry(ctx context.Context, settings config.RpcSettings, fn func() error) error {
+	for attempt := 0; ; attempt++ {
+		err := fn()
+		if !isRetryable(err) || uint64(attempt) >= settings.MaxRetries {
+			return err
+		}
+		if err := sleep(ctx, backoff(settings, attempt, err)); err != nil {
+			return err
+		}
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// newLimiter returns a token bucket limiter for the settings or nil if the chain is
+// not rate limited. The bucket holds one second's worth of requests.
+func newLimiter(settings config.RpcSettings) *rate.Limiter {
+	if settings.MaxPerSecond <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(settings.MaxPerSecond), int(math.Max(1, math.Ceil(settings.MaxPerSecond))))
+}
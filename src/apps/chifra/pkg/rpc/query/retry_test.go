@@ -0,0 +1,136 @@
+// Copyright 2021 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/config"
+)
+
+func fastSettings() config.RpcSettings {
+	settings := config.DefaultRpcSettings
+	settings.BackoffMs = 1
+	settings.MaxBackoffMs = 2
+	return settings
+}
+
+func TestRetryOnRateLimitCode(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) < 3 {
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32005,"message":"limit exceeded"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x2"}`))
+	}))
+	defer server.Close()
+
+	result, err := queryWithClient[string](urlClient, fastSettings(), server.URL, map[string]string{}, "eth_blockNumber", Params{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *result != "0x2" || hits != 3 {
+		t.Error("expected success on the third attempt, got", *result, hits)
+	}
+}
+
+func TestNoRetryOnOtherErrors(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"execution reverted"}}`))
+	}))
+	defer server.Close()
+
+	_, err := queryWithClient[string](urlClient, fastSettings(), server.URL, map[string]string{}, "eth_call", Params{})
+	if err == nil || err.Error() != "-32000: execution reverted" {
+		t.Fatal("expected the node's error, got", err)
+	}
+	if hits != 1 {
+		t.Error("expected a single attempt, got", hits)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	var hits int32
+	server := newTestServer(http.StatusTooManyRequests, &hits)
+	defer server.Close()
+
+	settings := fastSettings()
+	settings.MaxRetries = 2
+	pool := newProviderPool("test", []string{server.URL})
+	client := &http.Client{Transport: newPoolTransport(pool, settings)}
+	_, err := queryWithClient[string](client, settings, server.URL, map[string]string{}, "eth_blockNumber", Params{})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if hits != 3 {
+		t.Error("expected one attempt plus two retries, got", hits)
+	}
+}
+
+func TestBatchItemErrors(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := atomic.AddInt32(&hits, 1)
+		var payloads []rpcPayload
+		_ = json.NewDecoder(r.Body).Decode(&payloads)
+		responses := []string{}
+		// answer in reverse order to make sure we match on id
+		for i := len(payloads) - 1; i >= 0; i-- {
+			p := payloads[i]
+			switch {
+			case p.Method == "eth_getCode":
+				responses = append(responses, fmt.Sprintf(`{"id":%d,"error":{"code":-32000,"message":"missing trie node"}}`, p.ID))
+			case p.Method == "eth_getBalance" && call == 1:
+				responses = append(responses, fmt.Sprintf(`{"id":%d,"error":{"code":429,"message":"Too Many Requests"}}`, p.ID))
+			default:
+				responses = append(responses, fmt.Sprintf(`{"id":%d,"result":"%s"}`, p.ID, p.Method))
+			}
+		}
+		_, _ = w.Write([]byte("["))
+		for i, resp := range responses {
+			if i > 0 {
+				_, _ = w.Write([]byte(","))
+			}
+			_, _ = w.Write([]byte(resp))
+		}
+		_, _ = w.Write([]byte("]"))
+	}))
+	defer server.Close()
+
+	payloads := []BatchPayload{
+		{Key: "nonce", Payload: &Payload{Method: "eth_getTransactionCount"}},
+		{Key: "balance", Payload: &Payload{Method: "eth_getBalance"}},
+		{Key: "code", Payload: &Payload{Method: "eth_getCode"}},
+	}
+	results, err := queryBatchWithClient[string](urlClient, fastSettings(), server.URL, map[string]string{}, payloads)
+
+	batchErr, ok := err.(BatchError)
+	if !ok {
+		t.Fatal("expected a BatchError, got", err)
+	}
+	if len(batchErr) != 1 || batchErr["code"] == nil {
+		t.Error("expected only the code item to fail, got", batchErr)
+	}
+	if results["code"] != nil {
+		t.Error("expected no result for the failed item")
+	}
+	if results["nonce"] == nil || *results["nonce"] != "eth_getTransactionCount" {
+		t.Error("results were not matched by id", results["nonce"])
+	}
+	if results["balance"] == nil || *results["balance"] != "eth_getBalance" {
+		t.Error("rate limited item was not retried", results["balance"])
+	}
+	if hits != 2 {
+		t.Error("expected the rate limited item to be retried once, got", hits)
+	}
+}
@@ -0,0 +1,164 @@
+package types
+
+import (
+	"math/big"
+	"strings"
+	"unicode"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+)
+
+// JournalFormats are the double-entry bookkeeping formats available to chifra export --statements
+var JournalFormats = []string{"ledger", "beancount", "iif"}
+
+// IsJournalFormat returns true if format is one of the JournalFormats
+func IsJournalFormat(format string) bool {
+	for _, f := range JournalFormats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// Posting is a single line of a double-entry journal transaction. The postings of a transaction
+// sum to zero in both Quantity and Value.
+type Posting struct {
+	Account   string     `json:"account"`
+	Quantity  string     `json:"quantity"`
+	Commodity string     `json:"commodity"`
+	Price     base.Float `json:"price"`
+	Value     base.Float `json:"value"`
+}
+
+// journalModel returns the statement as a journal transaction with the accountedFor address's
+// asset account on one side and the counterparties' income and expense accounts (including gas)
+// on the other. Named counterparties are given accounts derived from their tags in the names
+// database. Statements that do not move any value produce no postings.
+func (s *Statement) journalModel(chain string, extraOpts map[string]any) Model {
+	namesMap, _ := extraOpts["namesMap"].(map[base.Address]Name)
+
+	commodity := journalCommodity(s.AssetSymbol)
+	if s.IsEth() {
+		// Ledgers report the native currency as WEI, but the quantities here are whole units
+		commodity = "ETH"
+	}
+	newPosting := func(account string, amount *base.Wei) Posting {
+		units, _ := new(big.Float).SetString(amount.ToUnitsStr(int(s.Decimals)))
+		value, _ := units.Mul(units, big.NewFloat(float64(s.SpotPrice))).Float64()
+		return Posting{
+			Account:   account,
+			Quantity:  amount.ToUnitsStr(int(s.Decimals)),
+			Commodity: commodity,
+			Price:     s.SpotPrice,
+			Value:     base.Float(value),
+		}
+	}
+
+	counterparty := s.Recipient
+	postings := make([]Posting, 0, 4)
+	if net := s.AmountNet(); !net.IsZero() {
+		postings = append(postings, newPosting(s.assetAccount(chain, namesMap), net))
+	}
+	if in := s.TotalIn(); !in.IsZero() {
+		counterparty = s.Sender
+		account := "Income:" + journalAccount(namesMap, s.Sender)
+		postings = append(postings, newPosting(account, new(base.Wei).Sub(base.NewWei(0), in)))
+	}
+	if out := s.TotalOutLessGas(); !out.IsZero() {
+		account := "Expenses:" + journalAccount(namesMap, s.Recipient)
+		postings = append(postings, newPosting(account, out))
+	}
+	if !s.GasOut.IsZero() {
+		postings = append(postings, newPosting("Expenses:Gas", &s.GasOut))
+	}
+
+	payee := counterparty.Hex()
+	if name, ok := namesMap[counterparty]; ok && len(name.Name) > 0 {
+		payee = name.Name
+	}
+
+	return Model{
+		Data: map[string]any{
+			"timestamp":        s.Timestamp,
+			"payee":            payee,
+			"transactionHash":  s.TransactionHash,
+			"blockNumber":      s.BlockNumber,
+			"transactionIndex": s.TransactionIndex,
+			"logIndex":         s.LogIndex,
+			"postings":         postings,
+		},
+		Order: []string{"timestamp", "payee", "transactionHash", "blockNumber", "transactionIndex", "logIndex", "postings"},
+	}
+}
+
+// assetAccount returns the account holding the accountedFor address's assets on the given chain
+func (s *Statement) assetAccount(chain string, namesMap map[base.Address]Name) string {
+	owner := s.AccountedFor.Hex()
+	if name, ok := namesMap[s.AccountedFor]; ok && len(name.Name) > 0 {
+		owner = journalComponent(name.Name)
+	}
+	return "Assets:" + journalComponent(chain) + ":" + owner
+}
+
+// journalAccount returns the account path (below Income or Expenses) for a counterparty. Named
+// addresses are placed under their tags (with the sort prefix removed, so 50-Tokens:ERC20 becomes
+// Tokens:ERC20) followed by their name. Unnamed addresses are placed under Unknown.
+func journalAccount(namesMap map[base.Address]Name, addr base.Address) string {
+	name, ok := namesMap[addr]
+	if !ok || len(name.Name) == 0 {
+		return "Unknown:" + addr.Hex()
+	}
+
+	parts := []string{}
+	for _, tag := range strings.Split(name.Tags, ":") {
+		if i := strings.Index(tag, "-"); i > 0 && strings.Trim(tag[:i], "0123456789") == "" {
+			tag = tag[i+1:]
+		}
+		if c := journalComponent(tag); len(c) > 0 {
+			parts = append(parts, c)
+		}
+	}
+	if len(parts) == 0 {
+		parts = append(parts, "Named")
+	}
+	if c := journalComponent(name.Name); len(c) > 0 {
+		parts = append(parts, c)
+	} else {
+		parts = append(parts, addr.Hex())
+	}
+	return strings.Join(parts, ":")
+}
+
+// journalComponent returns str as a single account name component acceptable to each of the
+// JournalFormats: its words capitalized and joined, with anything but letters and digits removed.
+func journalComponent(str string) string {
+	var sb strings.Builder
+	for _, word := range strings.FieldsFunc(str, func(r rune) bool {
+		return r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r))
+	}) {
+		sb.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return sb.String()
+}
+
+// journalCommodity returns the asset's symbol in a form acceptable to each of the JournalFormats
+func journalCommodity(symbol string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToUpper(symbol) {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+		}
+	}
+	ret := sb.String()
+	if len(ret) == 0 {
+		return "UNKNOWN"
+	}
+	if ret[0] < 'A' || ret[0] > 'Z' {
+		ret = "X" + ret
+	}
+	if len(ret) > 24 {
+		ret = ret[:24]
+	}
+	return ret
+}
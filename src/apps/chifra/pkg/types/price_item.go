@@ -0,0 +1,90 @@
+package types
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/cache"
+)
+
+// PriceItem is the binary cache's record of the USD price of an asset at a block and of the
+// source that produced it (walk.Cache_Prices).
+type PriceItem struct {
+	Asset       base.Address
+	BlockNumber base.Blknum
+	Price       base.Float
+	Source      string
+}
+
+func (s *PriceItem) CacheName() string {
+	return "Price"
+}
+
+func (s *PriceItem) CacheId() string {
+	return fmt.Sprintf("%s-%09d", s.Asset.Hex()[2:], s.BlockNumber)
+}
+
+func (s *PriceItem) CacheLocation() (directory string, extension string) {
+	paddedId := s.CacheId()
+	parts := make([]string, 3)
+	parts[0] = paddedId[:2]
+	parts[1] = paddedId[2:4]
+	parts[2] = paddedId[4:6]
+
+	subFolder := strings.ToLower(s.CacheName()) + "s"
+	directory = filepath.Join(subFolder, filepath.Join(parts...))
+	extension = "bin"
+
+	return
+}
+
+func (s *PriceItem) MarshalCache(writer io.Writer) (err error) {
+	// Asset
+	if err = cache.WriteValue(writer, s.Asset); err != nil {
+		return err
+	}
+
+	// BlockNumber
+	if err = cache.WriteValue(writer, s.BlockNumber); err != nil {
+		return err
+	}
+
+	// Price
+	if err = cache.WriteValue(writer, s.Price); err != nil {
+		return err
+	}
+
+	// Source
+	if err = cache.WriteValue(writer, s.Source); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *PriceItem) UnmarshalCache(vers uint64, reader io.Reader) (err error) {
+	// Asset
+	if err = cache.ReadValue(reader, &s.Asset, vers); err != nil {
+		return err
+	}
+
+	// BlockNumber
+	if err = cache.ReadValue(reader, &s.BlockNumber, vers); err != nil {
+		return err
+	}
+
+	// Price
+	if err = cache.ReadValue(reader, &s.Price, vers); err != nil {
+		return err
+	}
+
+	// Source
+	if err = cache.ReadValue(reader, &s.Source, vers); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/cache"
+)
+
+func TestPriceItemCache(t *testing.T) {
+	expected := &PriceItem{
+		Asset:       base.HexToAddress("0x1f9840a85d5af5bf1d1762f925bdaddc4201f984"),
+		BlockNumber: 17432262,
+		Price:       4.75,
+		Source:      "uniswapv3",
+	}
+
+	store, err := cache.NewStore(&cache.StoreOptions{Location: cache.FsCache, RootDir: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Write(expected, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Read
+	readBack := &PriceItem{
+		Asset:       expected.Asset,
+		BlockNumber: expected.BlockNumber,
+	}
+	if err := store.Read(readBack, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(expected, readBack) {
+		t.Fatalf("value mismatch: got %+v want %+v\n", readBack, expected)
+	}
+
+	// A different block is a different item
+	other := &PriceItem{
+		Asset:       expected.Asset,
+		BlockNumber: expected.BlockNumber + 1,
+	}
+	if err := store.Read(other, nil); err == nil {
+		t.Fatal("expected no price at the next block")
+	}
+}
@@ -18,11 +18,13 @@ import (
 // EXISTING_CODE
 
 type ChunkPin struct {
-	Chain         string        `json:"chain"`
-	ManifestHash  base.IpfsHash `json:"manifestHash"`
-	SpecHash      base.IpfsHash `json:"specHash"`
-	TimestampHash base.IpfsHash `json:"timestampHash"`
-	Version       string        `json:"version"`
+	Chain           string        `json:"chain"`
+	ManifestHash    base.IpfsHash `json:"manifestHash"`
+	Publisher       base.Address  `json:"publisher,omitempty"`
+	SpecHash        base.IpfsHash `json:"specHash"`
+	TimestampHash   base.IpfsHash `json:"timestampHash"`
+	TransactionHash base.Hash     `json:"transactionHash,omitempty"`
+	Version         string        `json:"version"`
 	// EXISTING_CODE
 	// EXISTING_CODE
 }
@@ -51,6 +53,11 @@ func (s *ChunkPin) Model(chain, format string, verbose bool, extraOpts map[strin
 		"timestampHash",
 		"specHash",
 	}
+	if !s.Publisher.IsZero() {
+		model["publisher"] = s.Publisher
+		model["transactionHash"] = s.TransactionHash
+		order = append(order, []string{"publisher", "transactionHash"}...)
+	}
 	// EXISTING_CODE
 
 	return Model{
@@ -0,0 +1,128 @@
+// Copyright 2016, 2024 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+/*
+ * Parts of this file were auto generated. Edit only those parts of
+ * the code inside of 'EXISTING_CODE' tags.
+ */
+
+package types
+
+// EXISTING_CODE
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+)
+
+// EXISTING_CODE
+
+type Disposal struct {
+	AccountedFor    base.Address   `json:"accountedFor"`
+	AcquiredBlock   base.Blknum    `json:"acquiredBlock"`
+	AcquiredTs      base.Timestamp `json:"acquiredTs"`
+	Amount          base.Wei       `json:"amount"`
+	AssetAddr       base.Address   `json:"assetAddr"`
+	AssetSymbol     string         `json:"assetSymbol"`
+	CostBasis       base.Float     `json:"costBasis"`
+	Decimals        base.Value     `json:"decimals"`
+	DisposedBlock   base.Blknum    `json:"disposedBlock"`
+	DisposedTs      base.Timestamp `json:"disposedTs"`
+	Method          string         `json:"method"`
+	Proceeds        base.Float     `json:"proceeds"`
+	TransactionHash base.Hash      `json:"transactionHash"`
+	Unrealized      bool           `json:"unrealized"`
+	// EXISTING_CODE
+	// EXISTING_CODE
+}
+
+func (s Disposal) String() string {
+	bytes, _ := json.Marshal(s)
+	return string(bytes)
+}
+
+func (s *Disposal) Model(chain, format string, verbose bool, extraOpts map[string]any) Model {
+	var model = map[string]any{}
+	var order = []string{}
+
+	// EXISTING_CODE
+	model = map[string]any{
+		"accountedFor":    s.AccountedFor,
+		"assetAddr":       s.AssetAddr,
+		"assetSymbol":     s.AssetSymbol,
+		"decimals":        s.Decimals,
+		"method":          s.Method,
+		"amount":          s.Amount.Text(10),
+		"acquiredBlock":   s.AcquiredBlock,
+		"acquiredTs":      s.AcquiredTs,
+		"acquiredDate":    s.AcquiredDate(),
+		"disposedBlock":   s.DisposedBlock,
+		"disposedTs":      s.DisposedTs,
+		"disposedDate":    s.DisposedDate(),
+		"transactionHash": s.TransactionHash,
+		"costBasis":       s.CostBasis,
+		"proceeds":        s.Proceeds,
+		"gain":            s.Gain(),
+		"holdingDays":     s.HoldingDays(),
+		"longTerm":        s.IsLongTerm(),
+		"unrealized":      s.Unrealized,
+	}
+	order = []string{
+		"accountedFor", "assetAddr", "assetSymbol", "decimals", "method", "amount",
+		"acquiredBlock", "acquiredTs", "acquiredDate", "disposedBlock", "disposedTs", "disposedDate",
+		"transactionHash", "costBasis", "proceeds", "gain", "holdingDays", "longTerm", "unrealized",
+	}
+
+	if extraOpts["ether"] == true {
+		model["amountEth"] = s.Amount.ToEtherStr(int(s.Decimals))
+		order = append(order, "amountEth")
+	}
+	// EXISTING_CODE
+
+	return Model{
+		Data:  model,
+		Order: order,
+	}
+}
+
+// FinishUnmarshal is used by the cache. It may be unused depending on auto-code-gen
+func (s *Disposal) FinishUnmarshal() {
+	// EXISTING_CODE
+	// EXISTING_CODE
+}
+
+// EXISTING_CODE
+func (s *Disposal) AcquiredDate() string {
+	return base.FormattedDate(s.AcquiredTs)
+}
+
+func (s *Disposal) DisposedDate() string {
+	return base.FormattedDate(s.DisposedTs)
+}
+
+// Gain returns the realized (or, if the lot is still held, unrealized) gain in US dollars
+func (s *Disposal) Gain() base.Float {
+	return s.Proceeds - s.CostBasis
+}
+
+// HoldingDays returns the number of whole days between acquisition and disposal. Disposals that
+// exceeded all known lots have no acquisition date and report zero.
+func (s *Disposal) HoldingDays() uint64 {
+	if s.AcquiredTs == 0 || s.DisposedTs <= s.AcquiredTs {
+		return 0
+	}
+	return uint64(s.DisposedTs-s.AcquiredTs) / 86400
+}
+
+// IsLongTerm returns true if the lot was held for more than one year
+func (s *Disposal) IsLongTerm() bool {
+	if s.AcquiredTs == 0 {
+		return false
+	}
+	acquired := time.Unix(int64(s.AcquiredTs), 0).UTC()
+	disposed := time.Unix(int64(s.DisposedTs), 0).UTC()
+	return disposed.After(acquired.AddDate(1, 0, 0))
+}
+
+// EXISTING_CODE
@@ -19,6 +19,7 @@ import (
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/cache"
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/version"
 )
 
 // EXISTING_CODE
@@ -53,6 +54,7 @@ type Statement struct {
 	Sender              base.Address   `json:"sender"`
 	SpotPrice           base.Float     `json:"spotPrice"`
 	Timestamp           base.Timestamp `json:"timestamp"`
+	TokenId             base.Wei       `json:"tokenId,omitempty"`
 	TransactionHash     base.Hash      `json:"transactionHash"`
 	TransactionIndex    base.Txnum     `json:"transactionIndex"`
 	// EXISTING_CODE
@@ -71,6 +73,10 @@ func (s *Statement) Model(chain, format string, verbose bool, extraOpts map[stri
 	var order = []string{}
 
 	// EXISTING_CODE
+	if IsJournalFormat(format) {
+		return s.journalModel(chain, extraOpts)
+	}
+
 	model = map[string]any{
 		"blockNumber":        s.BlockNumber,
 		"transactionIndex":   s.TransactionIndex,
@@ -132,6 +138,11 @@ func (s *Statement) Model(chain, format string, verbose bool, extraOpts map[stri
 		"endBalDiff", "endBalCalc", "correctingReason",
 	}
 
+	if s.IsNft() {
+		model["tokenId"] = s.TokenId.Text(10)
+		order = append(order, "tokenId")
+	}
+
 	asEther := extraOpts["ether"] == true
 	if asEther {
 		model["begBalEth"] = s.BegBal.ToEtherStr(decimals)
@@ -365,6 +376,11 @@ func (s *Statement) MarshalCache(writer io.Writer) (err error) {
 		return err
 	}
 
+	// TokenId
+	if err = cache.WriteValue(writer, &s.TokenId); err != nil {
+		return err
+	}
+
 	// TransactionHash
 	if err = cache.WriteValue(writer, &s.TransactionHash); err != nil {
 		return err
@@ -528,6 +544,15 @@ func (s *Statement) UnmarshalCache(vers uint64, reader io.Reader) (err error) {
 		return err
 	}
 
+	// Added after version 3.0.0
+	vTokenId := version.NewVersion("3.0.0")
+	if vers > vTokenId.Uint64() {
+		// TokenId
+		if err = cache.ReadValue(reader, &s.TokenId, vers); err != nil {
+			return err
+		}
+	}
+
 	// TransactionHash
 	if err = cache.ReadValue(reader, &s.TransactionHash, vers); err != nil {
 		return err
@@ -632,6 +657,12 @@ func (s *Statement) IsEth() bool {
 	return s.AssetAddr == base.FAKE_ETH_ADDRESS
 }
 
+// IsNft returns true if the statement reconciles a single ERC-721 or ERC-1155 token (identified
+// by TokenId) rather than a fungible asset.
+func (s *Statement) IsNft() bool {
+	return s.AssetType == "erc721" || s.AssetType == "erc1155"
+}
+
 var (
 	sai  = base.HexToAddress("0x89d24a6b4ccb1b6faa2625fe562bdd9a23260359")
 	dai  = base.HexToAddress("0x6b175474e89094c44da98b954eedeac495271d0f")
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/base"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/cache"
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/version"
+)
+
+func TestStatementCacheUpgrade(t *testing.T) {
+	marshal := func(s *Statement) []byte {
+		var buf bytes.Buffer
+		if err := s.MarshalCache(&buf); err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+
+	s := &Statement{
+		AccountedFor:     base.HexToAddress("0xf503017d7baf7fbc0fff7492b751025c6a78179b"),
+		AssetAddr:        base.HexToAddress("0x1f9840a85d5af5bf1d1762f925bdaddc4201f984"),
+		AssetSymbol:      "UNI",
+		BlockNumber:      17432262,
+		Timestamp:        1686186779,
+		TokenId:          *base.NewWei(1),
+		TransactionHash:  base.HexToHash("0x7b0dd622b0de6448937d564be16e08fb885895383391b890448cd284ce33f993"),
+		TransactionIndex: 44,
+	}
+	current := marshal(s)
+
+	// Caches written by version 3.0.0 have no TokenId. Find it (the only difference between two
+	// statements whose token ids differ in their last byte) and remove it.
+	var tokenId bytes.Buffer
+	if err := cache.WriteValue(&tokenId, &s.TokenId); err != nil {
+		t.Fatal(err)
+	}
+	other := *s
+	other.TokenId = *base.NewWei(2)
+	otherBytes := marshal(&other)
+	diff := 0
+	for current[diff] == otherBytes[diff] {
+		diff++
+	}
+	start := diff - tokenId.Len() + 1
+	old := append(append([]byte{}, current[:start]...), current[start+tokenId.Len():]...)
+
+	tests := []struct {
+		name    string
+		version string
+		data    []byte
+		tokenId int64
+	}{
+		{"current", version.LibraryVersion, current, 1},
+		{"before token ids", "3.0.0", old, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Statement
+			vers := version.NewVersion(tt.version)
+			if err := got.UnmarshalCache(vers.Uint64(), bytes.NewReader(tt.data)); err != nil {
+				t.Fatal(err)
+			}
+			if got.TokenId.Cmp(base.NewWei(tt.tokenId)) != 0 {
+				t.Errorf("expected token id %d, got %s", tt.tokenId, got.TokenId.String())
+			}
+			if got.TransactionHash != s.TransactionHash || got.TransactionIndex != s.TransactionIndex || got.AssetSymbol != s.AssetSymbol {
+				t.Errorf("fields misread: got %+v", got)
+			}
+		})
+	}
+}
@@ -47,6 +47,9 @@ const (
 
 	Config
 	Regular
+
+	// Appended so the values above do not change
+	Cache_Prices
 )
 
 var cacheTypeToName = map[CacheType]string{
@@ -57,6 +60,7 @@ var cacheTypeToName = map[CacheType]string{
 	Cache_Tmp:          "tmp",
 	Cache_Blocks:       "blocks",
 	Cache_Logs:         "logs",
+	Cache_Prices:       "prices",
 	Cache_Receipts:     "receipts",
 	Cache_Results:      "results",
 	Cache_Slurps:       "slurps",
@@ -85,6 +89,7 @@ var CacheTypeToFolder = map[CacheType]string{
 	Cache_Tmp:          "tmp",
 	Cache_Blocks:       "blocks",
 	Cache_Logs:         "logs",
+	Cache_Prices:       "prices",
 	Cache_Receipts:     "receipts",
 	Cache_Results:      "results",
 	Cache_Slurps:       "slurps",
@@ -112,6 +117,7 @@ var cacheTypeToExt = map[CacheType]string{
 	Cache_Tmp:          "",
 	Cache_Blocks:       "bin",
 	Cache_Logs:         "bin",
+	Cache_Prices:       "bin",
 	Cache_Receipts:     "bin",
 	Cache_Results:      "bin",
 	Cache_Slurps:       "bin",
@@ -160,6 +166,8 @@ func GetRootPathFromCacheType(chain string, cacheType CacheType) string {
 		fallthrough
 	case Cache_Logs:
 		fallthrough
+	case Cache_Prices:
+		fallthrough
 	case Cache_Receipts:
 		fallthrough
 	case Cache_Results:
@@ -270,6 +278,8 @@ func CacheTypesFromStringSlice(strs []string) []CacheType {
 				types = append(types, Cache_Blocks)
 			case "logs":
 				types = append(types, Cache_Logs)
+			case "prices":
+				types = append(types, Cache_Prices)
 			case "receipts":
 				types = append(types, Cache_Receipts)
 			case "results":
@@ -319,6 +329,7 @@ func CacheTypesFromStringSlice(strs []string) []CacheType {
 				types = append(types, Cache_Names)
 				types = append(types, Cache_Blocks)
 				types = append(types, Cache_Logs)
+				types = append(types, Cache_Prices)
 				types = append(types, Cache_Receipts)
 				types = append(types, Cache_Results)
 				types = append(types, Cache_Slurps)
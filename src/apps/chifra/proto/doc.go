@@ -1,2 +1,6 @@
-// Package proto is used by some of the tools (currently only chifra names) to provide a gRPC server when needed.
+// Package proto is used by some of the tools to provide a gRPC server when needed. It carries the
+// Names service (chifra.proto) and the streaming services of the chain data tools (services.proto,
+// which is generated by goMaker from the same definitions as the data models).
 package proto
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative chifra.proto services.proto
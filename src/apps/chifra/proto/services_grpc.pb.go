@@ -0,0 +1,2879 @@
+// Copyright 2016, 2024 The TrueBlocks Authors. All rights reserved.
+// Use of this source code is governed by a license that can
+// be found in the LICENSE file.
+
+//
+// This file was auto generated. Do not edit.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.23.4
+// source: services.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ListService_List_FullMethodName   = "/ListService/List"
+	ListService_Count_FullMethodName  = "/ListService/Count"
+	ListService_Bounds_FullMethodName = "/ListService/Bounds"
+)
+
+// ListServiceClient is the client API for ListService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ListServiceClient interface {
+	// chifra list
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (ListService_ListClient, error)
+	// chifra list --count
+	Count(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (ListService_CountClient, error)
+	// chifra list --bounds
+	Bounds(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (ListService_BoundsClient, error)
+}
+
+type listServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewListServiceClient(cc grpc.ClientConnInterface) ListServiceClient {
+	return &listServiceClient{cc}
+}
+
+func (c *listServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (ListService_ListClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ListService_ServiceDesc.Streams[0], ListService_List_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &listServiceListClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ListService_ListClient interface {
+	Recv() (*Appearance, error)
+	grpc.ClientStream
+}
+
+type listServiceListClient struct {
+	grpc.ClientStream
+}
+
+func (x *listServiceListClient) Recv() (*Appearance, error) {
+	m := new(Appearance)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *listServiceClient) Count(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (ListService_CountClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ListService_ServiceDesc.Streams[1], ListService_Count_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &listServiceCountClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ListService_CountClient interface {
+	Recv() (*Monitor, error)
+	grpc.ClientStream
+}
+
+type listServiceCountClient struct {
+	grpc.ClientStream
+}
+
+func (x *listServiceCountClient) Recv() (*Monitor, error) {
+	m := new(Monitor)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *listServiceClient) Bounds(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (ListService_BoundsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ListService_ServiceDesc.Streams[2], ListService_Bounds_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &listServiceBoundsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ListService_BoundsClient interface {
+	Recv() (*Bounds, error)
+	grpc.ClientStream
+}
+
+type listServiceBoundsClient struct {
+	grpc.ClientStream
+}
+
+func (x *listServiceBoundsClient) Recv() (*Bounds, error) {
+	m := new(Bounds)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ListServiceServer is the server API for ListService service.
+// All implementations must embed UnimplementedListServiceServer
+// for forward compatibility
+type ListServiceServer interface {
+	// chifra list
+	List(*ListRequest, ListService_ListServer) error
+	// chifra list --count
+	Count(*ListRequest, ListService_CountServer) error
+	// chifra list --bounds
+	Bounds(*ListRequest, ListService_BoundsServer) error
+	mustEmbedUnimplementedListServiceServer()
+}
+
+// UnimplementedListServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedListServiceServer struct {
+}
+
+func (UnimplementedListServiceServer) List(*ListRequest, ListService_ListServer) error {
+	return status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedListServiceServer) Count(*ListRequest, ListService_CountServer) error {
+	return status.Errorf(codes.Unimplemented, "method Count not implemented")
+}
+func (UnimplementedListServiceServer) Bounds(*ListRequest, ListService_BoundsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Bounds not implemented")
+}
+func (UnimplementedListServiceServer) mustEmbedUnimplementedListServiceServer() {}
+
+// UnsafeListServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ListServiceServer will
+// result in compilation errors.
+type UnsafeListServiceServer interface {
+	mustEmbedUnimplementedListServiceServer()
+}
+
+func RegisterListServiceServer(s grpc.ServiceRegistrar, srv ListServiceServer) {
+	s.RegisterService(&ListService_ServiceDesc, srv)
+}
+
+func _ListService_List_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ListServiceServer).List(m, &listServiceListServer{stream})
+}
+
+type ListService_ListServer interface {
+	Send(*Appearance) error
+	grpc.ServerStream
+}
+
+type listServiceListServer struct {
+	grpc.ServerStream
+}
+
+func (x *listServiceListServer) Send(m *Appearance) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ListService_Count_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ListServiceServer).Count(m, &listServiceCountServer{stream})
+}
+
+type ListService_CountServer interface {
+	Send(*Monitor) error
+	grpc.ServerStream
+}
+
+type listServiceCountServer struct {
+	grpc.ServerStream
+}
+
+func (x *listServiceCountServer) Send(m *Monitor) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ListService_Bounds_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ListServiceServer).Bounds(m, &listServiceBoundsServer{stream})
+}
+
+type ListService_BoundsServer interface {
+	Send(*Bounds) error
+	grpc.ServerStream
+}
+
+type listServiceBoundsServer struct {
+	grpc.ServerStream
+}
+
+func (x *listServiceBoundsServer) Send(m *Bounds) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// ListService_ServiceDesc is the grpc.ServiceDesc for ListService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ListService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ListService",
+	HandlerType: (*ListServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "List",
+			Handler:       _ListService_List_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Count",
+			Handler:       _ListService_Count_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Bounds",
+			Handler:       _ListService_Bounds_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "services.proto",
+}
+
+const (
+	ExportService_Export_FullMethodName      = "/ExportService/Export"
+	ExportService_Appearances_FullMethodName = "/ExportService/Appearances"
+	ExportService_Receipts_FullMethodName    = "/ExportService/Receipts"
+	ExportService_Logs_FullMethodName        = "/ExportService/Logs"
+	ExportService_Traces_FullMethodName      = "/ExportService/Traces"
+	ExportService_Neighbors_FullMethodName   = "/ExportService/Neighbors"
+	ExportService_Statements_FullMethodName  = "/ExportService/Statements"
+	ExportService_Lots_FullMethodName        = "/ExportService/Lots"
+	ExportService_Balances_FullMethodName    = "/ExportService/Balances"
+	ExportService_Withdrawals_FullMethodName = "/ExportService/Withdrawals"
+	ExportService_Count_FullMethodName       = "/ExportService/Count"
+)
+
+// ExportServiceClient is the client API for ExportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExportServiceClient interface {
+	// chifra export
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_ExportClient, error)
+	// chifra export --appearances
+	Appearances(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_AppearancesClient, error)
+	// chifra export --receipts
+	Receipts(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_ReceiptsClient, error)
+	// chifra export --logs
+	Logs(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_LogsClient, error)
+	// chifra export --traces
+	Traces(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_TracesClient, error)
+	// chifra export --neighbors
+	Neighbors(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_NeighborsClient, error)
+	// chifra export --statements
+	Statements(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_StatementsClient, error)
+	// chifra export --lots
+	Lots(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_LotsClient, error)
+	// chifra export --balances
+	Balances(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_BalancesClient, error)
+	// chifra export --withdrawals
+	Withdrawals(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_WithdrawalsClient, error)
+	// chifra export --count
+	Count(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_CountClient, error)
+}
+
+type exportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExportServiceClient(cc grpc.ClientConnInterface) ExportServiceClient {
+	return &exportServiceClient{cc}
+}
+
+func (c *exportServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExportService_ServiceDesc.Streams[0], ExportService_Export_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exportServiceExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExportService_ExportClient interface {
+	Recv() (*Transaction, error)
+	grpc.ClientStream
+}
+
+type exportServiceExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *exportServiceExportClient) Recv() (*Transaction, error) {
+	m := new(Transaction)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *exportServiceClient) Appearances(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_AppearancesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExportService_ServiceDesc.Streams[1], ExportService_Appearances_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exportServiceAppearancesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExportService_AppearancesClient interface {
+	Recv() (*Appearance, error)
+	grpc.ClientStream
+}
+
+type exportServiceAppearancesClient struct {
+	grpc.ClientStream
+}
+
+func (x *exportServiceAppearancesClient) Recv() (*Appearance, error) {
+	m := new(Appearance)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *exportServiceClient) Receipts(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_ReceiptsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExportService_ServiceDesc.Streams[2], ExportService_Receipts_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exportServiceReceiptsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExportService_ReceiptsClient interface {
+	Recv() (*Receipt, error)
+	grpc.ClientStream
+}
+
+type exportServiceReceiptsClient struct {
+	grpc.ClientStream
+}
+
+func (x *exportServiceReceiptsClient) Recv() (*Receipt, error) {
+	m := new(Receipt)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *exportServiceClient) Logs(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_LogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExportService_ServiceDesc.Streams[3], ExportService_Logs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exportServiceLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExportService_LogsClient interface {
+	Recv() (*Log, error)
+	grpc.ClientStream
+}
+
+type exportServiceLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *exportServiceLogsClient) Recv() (*Log, error) {
+	m := new(Log)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *exportServiceClient) Traces(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_TracesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExportService_ServiceDesc.Streams[4], ExportService_Traces_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exportServiceTracesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExportService_TracesClient interface {
+	Recv() (*Trace, error)
+	grpc.ClientStream
+}
+
+type exportServiceTracesClient struct {
+	grpc.ClientStream
+}
+
+func (x *exportServiceTracesClient) Recv() (*Trace, error) {
+	m := new(Trace)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *exportServiceClient) Neighbors(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_NeighborsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExportService_ServiceDesc.Streams[5], ExportService_Neighbors_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exportServiceNeighborsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExportService_NeighborsClient interface {
+	Recv() (*Message, error)
+	grpc.ClientStream
+}
+
+type exportServiceNeighborsClient struct {
+	grpc.ClientStream
+}
+
+func (x *exportServiceNeighborsClient) Recv() (*Message, error) {
+	m := new(Message)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *exportServiceClient) Statements(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_StatementsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExportService_ServiceDesc.Streams[6], ExportService_Statements_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exportServiceStatementsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExportService_StatementsClient interface {
+	Recv() (*Statement, error)
+	grpc.ClientStream
+}
+
+type exportServiceStatementsClient struct {
+	grpc.ClientStream
+}
+
+func (x *exportServiceStatementsClient) Recv() (*Statement, error) {
+	m := new(Statement)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *exportServiceClient) Lots(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_LotsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExportService_ServiceDesc.Streams[7], ExportService_Lots_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exportServiceLotsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExportService_LotsClient interface {
+	Recv() (*Disposal, error)
+	grpc.ClientStream
+}
+
+type exportServiceLotsClient struct {
+	grpc.ClientStream
+}
+
+func (x *exportServiceLotsClient) Recv() (*Disposal, error) {
+	m := new(Disposal)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *exportServiceClient) Balances(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_BalancesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExportService_ServiceDesc.Streams[8], ExportService_Balances_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exportServiceBalancesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExportService_BalancesClient interface {
+	Recv() (*State, error)
+	grpc.ClientStream
+}
+
+type exportServiceBalancesClient struct {
+	grpc.ClientStream
+}
+
+func (x *exportServiceBalancesClient) Recv() (*State, error) {
+	m := new(State)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *exportServiceClient) Withdrawals(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_WithdrawalsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExportService_ServiceDesc.Streams[9], ExportService_Withdrawals_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exportServiceWithdrawalsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExportService_WithdrawalsClient interface {
+	Recv() (*Withdrawal, error)
+	grpc.ClientStream
+}
+
+type exportServiceWithdrawalsClient struct {
+	grpc.ClientStream
+}
+
+func (x *exportServiceWithdrawalsClient) Recv() (*Withdrawal, error) {
+	m := new(Withdrawal)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *exportServiceClient) Count(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (ExportService_CountClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ExportService_ServiceDesc.Streams[10], ExportService_Count_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &exportServiceCountClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExportService_CountClient interface {
+	Recv() (*Monitor, error)
+	grpc.ClientStream
+}
+
+type exportServiceCountClient struct {
+	grpc.ClientStream
+}
+
+func (x *exportServiceCountClient) Recv() (*Monitor, error) {
+	m := new(Monitor)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ExportServiceServer is the server API for ExportService service.
+// All implementations must embed UnimplementedExportServiceServer
+// for forward compatibility
+type ExportServiceServer interface {
+	// chifra export
+	Export(*ExportRequest, ExportService_ExportServer) error
+	// chifra export --appearances
+	Appearances(*ExportRequest, ExportService_AppearancesServer) error
+	// chifra export --receipts
+	Receipts(*ExportRequest, ExportService_ReceiptsServer) error
+	// chifra export --logs
+	Logs(*ExportRequest, ExportService_LogsServer) error
+	// chifra export --traces
+	Traces(*ExportRequest, ExportService_TracesServer) error
+	// chifra export --neighbors
+	Neighbors(*ExportRequest, ExportService_NeighborsServer) error
+	// chifra export --statements
+	Statements(*ExportRequest, ExportService_StatementsServer) error
+	// chifra export --lots
+	Lots(*ExportRequest, ExportService_LotsServer) error
+	// chifra export --balances
+	Balances(*ExportRequest, ExportService_BalancesServer) error
+	// chifra export --withdrawals
+	Withdrawals(*ExportRequest, ExportService_WithdrawalsServer) error
+	// chifra export --count
+	Count(*ExportRequest, ExportService_CountServer) error
+	mustEmbedUnimplementedExportServiceServer()
+}
+
+// UnimplementedExportServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedExportServiceServer struct {
+}
+
+func (UnimplementedExportServiceServer) Export(*ExportRequest, ExportService_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedExportServiceServer) Appearances(*ExportRequest, ExportService_AppearancesServer) error {
+	return status.Errorf(codes.Unimplemented, "method Appearances not implemented")
+}
+func (UnimplementedExportServiceServer) Receipts(*ExportRequest, ExportService_ReceiptsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Receipts not implemented")
+}
+func (UnimplementedExportServiceServer) Logs(*ExportRequest, ExportService_LogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Logs not implemented")
+}
+func (UnimplementedExportServiceServer) Traces(*ExportRequest, ExportService_TracesServer) error {
+	return status.Errorf(codes.Unimplemented, "method Traces not implemented")
+}
+func (UnimplementedExportServiceServer) Neighbors(*ExportRequest, ExportService_NeighborsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Neighbors not implemented")
+}
+func (UnimplementedExportServiceServer) Statements(*ExportRequest, ExportService_StatementsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Statements not implemented")
+}
+func (UnimplementedExportServiceServer) Lots(*ExportRequest, ExportService_LotsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Lots not implemented")
+}
+func (UnimplementedExportServiceServer) Balances(*ExportRequest, ExportService_BalancesServer) error {
+	return status.Errorf(codes.Unimplemented, "method Balances not implemented")
+}
+func (UnimplementedExportServiceServer) Withdrawals(*ExportRequest, ExportService_WithdrawalsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Withdrawals not implemented")
+}
+func (UnimplementedExportServiceServer) Count(*ExportRequest, ExportService_CountServer) error {
+	return status.Errorf(codes.Unimplemented, "method Count not implemented")
+}
+func (UnimplementedExportServiceServer) mustEmbedUnimplementedExportServiceServer() {}
+
+// UnsafeExportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExportServiceServer will
+// result in compilation errors.
+type UnsafeExportServiceServer interface {
+	mustEmbedUnimplementedExportServiceServer()
+}
+
+func RegisterExportServiceServer(s grpc.ServiceRegistrar, srv ExportServiceServer) {
+	s.RegisterService(&ExportService_ServiceDesc, srv)
+}
+
+func _ExportService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExportServiceServer).Export(m, &exportServiceExportServer{stream})
+}
+
+type ExportService_ExportServer interface {
+	Send(*Transaction) error
+	grpc.ServerStream
+}
+
+type exportServiceExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *exportServiceExportServer) Send(m *Transaction) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExportService_Appearances_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExportServiceServer).Appearances(m, &exportServiceAppearancesServer{stream})
+}
+
+type ExportService_AppearancesServer interface {
+	Send(*Appearance) error
+	grpc.ServerStream
+}
+
+type exportServiceAppearancesServer struct {
+	grpc.ServerStream
+}
+
+func (x *exportServiceAppearancesServer) Send(m *Appearance) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExportService_Receipts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExportServiceServer).Receipts(m, &exportServiceReceiptsServer{stream})
+}
+
+type ExportService_ReceiptsServer interface {
+	Send(*Receipt) error
+	grpc.ServerStream
+}
+
+type exportServiceReceiptsServer struct {
+	grpc.ServerStream
+}
+
+func (x *exportServiceReceiptsServer) Send(m *Receipt) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExportService_Logs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExportServiceServer).Logs(m, &exportServiceLogsServer{stream})
+}
+
+type ExportService_LogsServer interface {
+	Send(*Log) error
+	grpc.ServerStream
+}
+
+type exportServiceLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *exportServiceLogsServer) Send(m *Log) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExportService_Traces_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExportServiceServer).Traces(m, &exportServiceTracesServer{stream})
+}
+
+type ExportService_TracesServer interface {
+	Send(*Trace) error
+	grpc.ServerStream
+}
+
+type exportServiceTracesServer struct {
+	grpc.ServerStream
+}
+
+func (x *exportServiceTracesServer) Send(m *Trace) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExportService_Neighbors_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExportServiceServer).Neighbors(m, &exportServiceNeighborsServer{stream})
+}
+
+type ExportService_NeighborsServer interface {
+	Send(*Message) error
+	grpc.ServerStream
+}
+
+type exportServiceNeighborsServer struct {
+	grpc.ServerStream
+}
+
+func (x *exportServiceNeighborsServer) Send(m *Message) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExportService_Statements_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExportServiceServer).Statements(m, &exportServiceStatementsServer{stream})
+}
+
+type ExportService_StatementsServer interface {
+	Send(*Statement) error
+	grpc.ServerStream
+}
+
+type exportServiceStatementsServer struct {
+	grpc.ServerStream
+}
+
+func (x *exportServiceStatementsServer) Send(m *Statement) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExportService_Lots_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExportServiceServer).Lots(m, &exportServiceLotsServer{stream})
+}
+
+type ExportService_LotsServer interface {
+	Send(*Disposal) error
+	grpc.ServerStream
+}
+
+type exportServiceLotsServer struct {
+	grpc.ServerStream
+}
+
+func (x *exportServiceLotsServer) Send(m *Disposal) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExportService_Balances_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExportServiceServer).Balances(m, &exportServiceBalancesServer{stream})
+}
+
+type ExportService_BalancesServer interface {
+	Send(*State) error
+	grpc.ServerStream
+}
+
+type exportServiceBalancesServer struct {
+	grpc.ServerStream
+}
+
+func (x *exportServiceBalancesServer) Send(m *State) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExportService_Withdrawals_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExportServiceServer).Withdrawals(m, &exportServiceWithdrawalsServer{stream})
+}
+
+type ExportService_WithdrawalsServer interface {
+	Send(*Withdrawal) error
+	grpc.ServerStream
+}
+
+type exportServiceWithdrawalsServer struct {
+	grpc.ServerStream
+}
+
+func (x *exportServiceWithdrawalsServer) Send(m *Withdrawal) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExportService_Count_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExportServiceServer).Count(m, &exportServiceCountServer{stream})
+}
+
+type ExportService_CountServer interface {
+	Send(*Monitor) error
+	grpc.ServerStream
+}
+
+type exportServiceCountServer struct {
+	grpc.ServerStream
+}
+
+func (x *exportServiceCountServer) Send(m *Monitor) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// ExportService_ServiceDesc is the grpc.ServiceDesc for ExportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ExportService",
+	HandlerType: (*ExportServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _ExportService_Export_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Appearances",
+			Handler:       _ExportService_Appearances_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Receipts",
+			Handler:       _ExportService_Receipts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Logs",
+			Handler:       _ExportService_Logs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Traces",
+			Handler:       _ExportService_Traces_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Neighbors",
+			Handler:       _ExportService_Neighbors_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Statements",
+			Handler:       _ExportService_Statements_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Lots",
+			Handler:       _ExportService_Lots_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Balances",
+			Handler:       _ExportService_Balances_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Withdrawals",
+			Handler:       _ExportService_Withdrawals_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Count",
+			Handler:       _ExportService_Count_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "services.proto",
+}
+
+const (
+	BlocksService_Blocks_FullMethodName      = "/BlocksService/Blocks"
+	BlocksService_Hashes_FullMethodName      = "/BlocksService/Hashes"
+	BlocksService_Uncles_FullMethodName      = "/BlocksService/Uncles"
+	BlocksService_Traces_FullMethodName      = "/BlocksService/Traces"
+	BlocksService_Uniq_FullMethodName        = "/BlocksService/Uniq"
+	BlocksService_Logs_FullMethodName        = "/BlocksService/Logs"
+	BlocksService_Withdrawals_FullMethodName = "/BlocksService/Withdrawals"
+	BlocksService_Count_FullMethodName       = "/BlocksService/Count"
+)
+
+// BlocksServiceClient is the client API for BlocksService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BlocksServiceClient interface {
+	// chifra blocks
+	Blocks(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (BlocksService_BlocksClient, error)
+	// chifra blocks --hashes
+	Hashes(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (BlocksService_HashesClient, error)
+	// chifra blocks --uncles
+	Uncles(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (BlocksService_UnclesClient, error)
+	// chifra blocks --traces
+	Traces(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (BlocksService_TracesClient, error)
+	// chifra blocks --uniq
+	Uniq(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (BlocksService_UniqClient, error)
+	// chifra blocks --logs
+	Logs(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (BlocksService_LogsClient, error)
+	// chifra blocks --withdrawals
+	Withdrawals(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (BlocksService_WithdrawalsClient, error)
+	// chifra blocks --count
+	Count(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (BlocksService_CountClient, error)
+}
+
+type blocksServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBlocksServiceClient(cc grpc.ClientConnInterface) BlocksServiceClient {
+	return &blocksServiceClient{cc}
+}
+
+func (c *blocksServiceClient) Blocks(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (BlocksService_BlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BlocksService_ServiceDesc.Streams[0], BlocksService_Blocks_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blocksServiceBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlocksService_BlocksClient interface {
+	Recv() (*Block, error)
+	grpc.ClientStream
+}
+
+type blocksServiceBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *blocksServiceBlocksClient) Recv() (*Block, error) {
+	m := new(Block)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blocksServiceClient) Hashes(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (BlocksService_HashesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BlocksService_ServiceDesc.Streams[1], BlocksService_Hashes_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blocksServiceHashesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlocksService_HashesClient interface {
+	Recv() (*LightBlock, error)
+	grpc.ClientStream
+}
+
+type blocksServiceHashesClient struct {
+	grpc.ClientStream
+}
+
+func (x *blocksServiceHashesClient) Recv() (*LightBlock, error) {
+	m := new(LightBlock)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blocksServiceClient) Uncles(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (BlocksService_UnclesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BlocksService_ServiceDesc.Streams[2], BlocksService_Uncles_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blocksServiceUnclesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlocksService_UnclesClient interface {
+	Recv() (*LightBlock, error)
+	grpc.ClientStream
+}
+
+type blocksServiceUnclesClient struct {
+	grpc.ClientStream
+}
+
+func (x *blocksServiceUnclesClient) Recv() (*LightBlock, error) {
+	m := new(LightBlock)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blocksServiceClient) Traces(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (BlocksService_TracesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BlocksService_ServiceDesc.Streams[3], BlocksService_Traces_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blocksServiceTracesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlocksService_TracesClient interface {
+	Recv() (*Trace, error)
+	grpc.ClientStream
+}
+
+type blocksServiceTracesClient struct {
+	grpc.ClientStream
+}
+
+func (x *blocksServiceTracesClient) Recv() (*Trace, error) {
+	m := new(Trace)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blocksServiceClient) Uniq(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (BlocksService_UniqClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BlocksService_ServiceDesc.Streams[4], BlocksService_Uniq_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blocksServiceUniqClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlocksService_UniqClient interface {
+	Recv() (*Appearance, error)
+	grpc.ClientStream
+}
+
+type blocksServiceUniqClient struct {
+	grpc.ClientStream
+}
+
+func (x *blocksServiceUniqClient) Recv() (*Appearance, error) {
+	m := new(Appearance)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blocksServiceClient) Logs(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (BlocksService_LogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BlocksService_ServiceDesc.Streams[5], BlocksService_Logs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blocksServiceLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlocksService_LogsClient interface {
+	Recv() (*Log, error)
+	grpc.ClientStream
+}
+
+type blocksServiceLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *blocksServiceLogsClient) Recv() (*Log, error) {
+	m := new(Log)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blocksServiceClient) Withdrawals(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (BlocksService_WithdrawalsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BlocksService_ServiceDesc.Streams[6], BlocksService_Withdrawals_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blocksServiceWithdrawalsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlocksService_WithdrawalsClient interface {
+	Recv() (*Withdrawal, error)
+	grpc.ClientStream
+}
+
+type blocksServiceWithdrawalsClient struct {
+	grpc.ClientStream
+}
+
+func (x *blocksServiceWithdrawalsClient) Recv() (*Withdrawal, error) {
+	m := new(Withdrawal)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blocksServiceClient) Count(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (BlocksService_CountClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BlocksService_ServiceDesc.Streams[7], BlocksService_Count_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blocksServiceCountClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlocksService_CountClient interface {
+	Recv() (*BlockCount, error)
+	grpc.ClientStream
+}
+
+type blocksServiceCountClient struct {
+	grpc.ClientStream
+}
+
+func (x *blocksServiceCountClient) Recv() (*BlockCount, error) {
+	m := new(BlockCount)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// BlocksServiceServer is the server API for BlocksService service.
+// All implementations must embed UnimplementedBlocksServiceServer
+// for forward compatibility
+type BlocksServiceServer interface {
+	// chifra blocks
+	Blocks(*BlocksRequest, BlocksService_BlocksServer) error
+	// chifra blocks --hashes
+	Hashes(*BlocksRequest, BlocksService_HashesServer) error
+	// chifra blocks --uncles
+	Uncles(*BlocksRequest, BlocksService_UnclesServer) error
+	// chifra blocks --traces
+	Traces(*BlocksRequest, BlocksService_TracesServer) error
+	// chifra blocks --uniq
+	Uniq(*BlocksRequest, BlocksService_UniqServer) error
+	// chifra blocks --logs
+	Logs(*BlocksRequest, BlocksService_LogsServer) error
+	// chifra blocks --withdrawals
+	Withdrawals(*BlocksRequest, BlocksService_WithdrawalsServer) error
+	// chifra blocks --count
+	Count(*BlocksRequest, BlocksService_CountServer) error
+	mustEmbedUnimplementedBlocksServiceServer()
+}
+
+// UnimplementedBlocksServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBlocksServiceServer struct {
+}
+
+func (UnimplementedBlocksServiceServer) Blocks(*BlocksRequest, BlocksService_BlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method Blocks not implemented")
+}
+func (UnimplementedBlocksServiceServer) Hashes(*BlocksRequest, BlocksService_HashesServer) error {
+	return status.Errorf(codes.Unimplemented, "method Hashes not implemented")
+}
+func (UnimplementedBlocksServiceServer) Uncles(*BlocksRequest, BlocksService_UnclesServer) error {
+	return status.Errorf(codes.Unimplemented, "method Uncles not implemented")
+}
+func (UnimplementedBlocksServiceServer) Traces(*BlocksRequest, BlocksService_TracesServer) error {
+	return status.Errorf(codes.Unimplemented, "method Traces not implemented")
+}
+func (UnimplementedBlocksServiceServer) Uniq(*BlocksRequest, BlocksService_UniqServer) error {
+	return status.Errorf(codes.Unimplemented, "method Uniq not implemented")
+}
+func (UnimplementedBlocksServiceServer) Logs(*BlocksRequest, BlocksService_LogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Logs not implemented")
+}
+func (UnimplementedBlocksServiceServer) Withdrawals(*BlocksRequest, BlocksService_WithdrawalsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Withdrawals not implemented")
+}
+func (UnimplementedBlocksServiceServer) Count(*BlocksRequest, BlocksService_CountServer) error {
+	return status.Errorf(codes.Unimplemented, "method Count not implemented")
+}
+func (UnimplementedBlocksServiceServer) mustEmbedUnimplementedBlocksServiceServer() {}
+
+// UnsafeBlocksServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BlocksServiceServer will
+// result in compilation errors.
+type UnsafeBlocksServiceServer interface {
+	mustEmbedUnimplementedBlocksServiceServer()
+}
+
+func RegisterBlocksServiceServer(s grpc.ServiceRegistrar, srv BlocksServiceServer) {
+	s.RegisterService(&BlocksService_ServiceDesc, srv)
+}
+
+func _BlocksService_Blocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlocksServiceServer).Blocks(m, &blocksServiceBlocksServer{stream})
+}
+
+type BlocksService_BlocksServer interface {
+	Send(*Block) error
+	grpc.ServerStream
+}
+
+type blocksServiceBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *blocksServiceBlocksServer) Send(m *Block) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlocksService_Hashes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlocksServiceServer).Hashes(m, &blocksServiceHashesServer{stream})
+}
+
+type BlocksService_HashesServer interface {
+	Send(*LightBlock) error
+	grpc.ServerStream
+}
+
+type blocksServiceHashesServer struct {
+	grpc.ServerStream
+}
+
+func (x *blocksServiceHashesServer) Send(m *LightBlock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlocksService_Uncles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlocksServiceServer).Uncles(m, &blocksServiceUnclesServer{stream})
+}
+
+type BlocksService_UnclesServer interface {
+	Send(*LightBlock) error
+	grpc.ServerStream
+}
+
+type blocksServiceUnclesServer struct {
+	grpc.ServerStream
+}
+
+func (x *blocksServiceUnclesServer) Send(m *LightBlock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlocksService_Traces_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlocksServiceServer).Traces(m, &blocksServiceTracesServer{stream})
+}
+
+type BlocksService_TracesServer interface {
+	Send(*Trace) error
+	grpc.ServerStream
+}
+
+type blocksServiceTracesServer struct {
+	grpc.ServerStream
+}
+
+func (x *blocksServiceTracesServer) Send(m *Trace) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlocksService_Uniq_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlocksServiceServer).Uniq(m, &blocksServiceUniqServer{stream})
+}
+
+type BlocksService_UniqServer interface {
+	Send(*Appearance) error
+	grpc.ServerStream
+}
+
+type blocksServiceUniqServer struct {
+	grpc.ServerStream
+}
+
+func (x *blocksServiceUniqServer) Send(m *Appearance) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlocksService_Logs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlocksServiceServer).Logs(m, &blocksServiceLogsServer{stream})
+}
+
+type BlocksService_LogsServer interface {
+	Send(*Log) error
+	grpc.ServerStream
+}
+
+type blocksServiceLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *blocksServiceLogsServer) Send(m *Log) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlocksService_Withdrawals_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlocksServiceServer).Withdrawals(m, &blocksServiceWithdrawalsServer{stream})
+}
+
+type BlocksService_WithdrawalsServer interface {
+	Send(*Withdrawal) error
+	grpc.ServerStream
+}
+
+type blocksServiceWithdrawalsServer struct {
+	grpc.ServerStream
+}
+
+func (x *blocksServiceWithdrawalsServer) Send(m *Withdrawal) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlocksService_Count_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlocksServiceServer).Count(m, &blocksServiceCountServer{stream})
+}
+
+type BlocksService_CountServer interface {
+	Send(*BlockCount) error
+	grpc.ServerStream
+}
+
+type blocksServiceCountServer struct {
+	grpc.ServerStream
+}
+
+func (x *blocksServiceCountServer) Send(m *BlockCount) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// BlocksService_ServiceDesc is the grpc.ServiceDesc for BlocksService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BlocksService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "BlocksService",
+	HandlerType: (*BlocksServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Blocks",
+			Handler:       _BlocksService_Blocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Hashes",
+			Handler:       _BlocksService_Hashes_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Uncles",
+			Handler:       _BlocksService_Uncles_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Traces",
+			Handler:       _BlocksService_Traces_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Uniq",
+			Handler:       _BlocksService_Uniq_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Logs",
+			Handler:       _BlocksService_Logs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Withdrawals",
+			Handler:       _BlocksService_Withdrawals_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Count",
+			Handler:       _BlocksService_Count_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "services.proto",
+}
+
+const (
+	TransactionsService_Transactions_FullMethodName = "/TransactionsService/Transactions"
+	TransactionsService_Traces_FullMethodName       = "/TransactionsService/Traces"
+	TransactionsService_Uniq_FullMethodName         = "/TransactionsService/Uniq"
+	TransactionsService_Logs_FullMethodName         = "/TransactionsService/Logs"
+)
+
+// TransactionsServiceClient is the client API for TransactionsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TransactionsServiceClient interface {
+	// chifra transactions
+	Transactions(ctx context.Context, in *TransactionsRequest, opts ...grpc.CallOption) (TransactionsService_TransactionsClient, error)
+	// chifra transactions --traces
+	Traces(ctx context.Context, in *TransactionsRequest, opts ...grpc.CallOption) (TransactionsService_TracesClient, error)
+	// chifra transactions --uniq
+	Uniq(ctx context.Context, in *TransactionsRequest, opts ...grpc.CallOption) (TransactionsService_UniqClient, error)
+	// chifra transactions --logs
+	Logs(ctx context.Context, in *TransactionsRequest, opts ...grpc.CallOption) (TransactionsService_LogsClient, error)
+}
+
+type transactionsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTransactionsServiceClient(cc grpc.ClientConnInterface) TransactionsServiceClient {
+	return &transactionsServiceClient{cc}
+}
+
+func (c *transactionsServiceClient) Transactions(ctx context.Context, in *TransactionsRequest, opts ...grpc.CallOption) (TransactionsService_TransactionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TransactionsService_ServiceDesc.Streams[0], TransactionsService_Transactions_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &transactionsServiceTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TransactionsService_TransactionsClient interface {
+	Recv() (*Transaction, error)
+	grpc.ClientStream
+}
+
+type transactionsServiceTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *transactionsServiceTransactionsClient) Recv() (*Transaction, error) {
+	m := new(Transaction)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *transactionsServiceClient) Traces(ctx context.Context, in *TransactionsRequest, opts ...grpc.CallOption) (TransactionsService_TracesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TransactionsService_ServiceDesc.Streams[1], TransactionsService_Traces_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &transactionsServiceTracesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TransactionsService_TracesClient interface {
+	Recv() (*Trace, error)
+	grpc.ClientStream
+}
+
+type transactionsServiceTracesClient struct {
+	grpc.ClientStream
+}
+
+func (x *transactionsServiceTracesClient) Recv() (*Trace, error) {
+	m := new(Trace)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *transactionsServiceClient) Uniq(ctx context.Context, in *TransactionsRequest, opts ...grpc.CallOption) (TransactionsService_UniqClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TransactionsService_ServiceDesc.Streams[2], TransactionsService_Uniq_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &transactionsServiceUniqClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TransactionsService_UniqClient interface {
+	Recv() (*Appearance, error)
+	grpc.ClientStream
+}
+
+type transactionsServiceUniqClient struct {
+	grpc.ClientStream
+}
+
+func (x *transactionsServiceUniqClient) Recv() (*Appearance, error) {
+	m := new(Appearance)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *transactionsServiceClient) Logs(ctx context.Context, in *TransactionsRequest, opts ...grpc.CallOption) (TransactionsService_LogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TransactionsService_ServiceDesc.Streams[3], TransactionsService_Logs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &transactionsServiceLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TransactionsService_LogsClient interface {
+	Recv() (*Log, error)
+	grpc.ClientStream
+}
+
+type transactionsServiceLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *transactionsServiceLogsClient) Recv() (*Log, error) {
+	m := new(Log)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TransactionsServiceServer is the server API for TransactionsService service.
+// All implementations must embed UnimplementedTransactionsServiceServer
+// for forward compatibility
+type TransactionsServiceServer interface {
+	// chifra transactions
+	Transactions(*TransactionsRequest, TransactionsService_TransactionsServer) error
+	// chifra transactions --traces
+	Traces(*TransactionsRequest, TransactionsService_TracesServer) error
+	// chifra transactions --uniq
+	Uniq(*TransactionsRequest, TransactionsService_UniqServer) error
+	// chifra transactions --logs
+	Logs(*TransactionsRequest, TransactionsService_LogsServer) error
+	mustEmbedUnimplementedTransactionsServiceServer()
+}
+
+// UnimplementedTransactionsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTransactionsServiceServer struct {
+}
+
+func (UnimplementedTransactionsServiceServer) Transactions(*TransactionsRequest, TransactionsService_TransactionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Transactions not implemented")
+}
+func (UnimplementedTransactionsServiceServer) Traces(*TransactionsRequest, TransactionsService_TracesServer) error {
+	return status.Errorf(codes.Unimplemented, "method Traces not implemented")
+}
+func (UnimplementedTransactionsServiceServer) Uniq(*TransactionsRequest, TransactionsService_UniqServer) error {
+	return status.Errorf(codes.Unimplemented, "method Uniq not implemented")
+}
+func (UnimplementedTransactionsServiceServer) Logs(*TransactionsRequest, TransactionsService_LogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Logs not implemented")
+}
+func (UnimplementedTransactionsServiceServer) mustEmbedUnimplementedTransactionsServiceServer() {}
+
+// UnsafeTransactionsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TransactionsServiceServer will
+// result in compilation errors.
+type UnsafeTransactionsServiceServer interface {
+	mustEmbedUnimplementedTransactionsServiceServer()
+}
+
+func RegisterTransactionsServiceServer(s grpc.ServiceRegistrar, srv TransactionsServiceServer) {
+	s.RegisterService(&TransactionsService_ServiceDesc, srv)
+}
+
+func _TransactionsService_Transactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransactionsServiceServer).Transactions(m, &transactionsServiceTransactionsServer{stream})
+}
+
+type TransactionsService_TransactionsServer interface {
+	Send(*Transaction) error
+	grpc.ServerStream
+}
+
+type transactionsServiceTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *transactionsServiceTransactionsServer) Send(m *Transaction) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TransactionsService_Traces_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransactionsServiceServer).Traces(m, &transactionsServiceTracesServer{stream})
+}
+
+type TransactionsService_TracesServer interface {
+	Send(*Trace) error
+	grpc.ServerStream
+}
+
+type transactionsServiceTracesServer struct {
+	grpc.ServerStream
+}
+
+func (x *transactionsServiceTracesServer) Send(m *Trace) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TransactionsService_Uniq_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransactionsServiceServer).Uniq(m, &transactionsServiceUniqServer{stream})
+}
+
+type TransactionsService_UniqServer interface {
+	Send(*Appearance) error
+	grpc.ServerStream
+}
+
+type transactionsServiceUniqServer struct {
+	grpc.ServerStream
+}
+
+func (x *transactionsServiceUniqServer) Send(m *Appearance) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TransactionsService_Logs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransactionsServiceServer).Logs(m, &transactionsServiceLogsServer{stream})
+}
+
+type TransactionsService_LogsServer interface {
+	Send(*Log) error
+	grpc.ServerStream
+}
+
+type transactionsServiceLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *transactionsServiceLogsServer) Send(m *Log) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// TransactionsService_ServiceDesc is the grpc.ServiceDesc for TransactionsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TransactionsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "TransactionsService",
+	HandlerType: (*TransactionsServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Transactions",
+			Handler:       _TransactionsService_Transactions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Traces",
+			Handler:       _TransactionsService_Traces_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Uniq",
+			Handler:       _TransactionsService_Uniq_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Logs",
+			Handler:       _TransactionsService_Logs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "services.proto",
+}
+
+const (
+	LogsService_Logs_FullMethodName = "/LogsService/Logs"
+)
+
+// LogsServiceClient is the client API for LogsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LogsServiceClient interface {
+	// chifra logs
+	Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (LogsService_LogsClient, error)
+}
+
+type logsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLogsServiceClient(cc grpc.ClientConnInterface) LogsServiceClient {
+	return &logsServiceClient{cc}
+}
+
+func (c *logsServiceClient) Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (LogsService_LogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LogsService_ServiceDesc.Streams[0], LogsService_Logs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &logsServiceLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LogsService_LogsClient interface {
+	Recv() (*Log, error)
+	grpc.ClientStream
+}
+
+type logsServiceLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *logsServiceLogsClient) Recv() (*Log, error) {
+	m := new(Log)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// LogsServiceServer is the server API for LogsService service.
+// All implementations must embed UnimplementedLogsServiceServer
+// for forward compatibility
+type LogsServiceServer interface {
+	// chifra logs
+	Logs(*LogsRequest, LogsService_LogsServer) error
+	mustEmbedUnimplementedLogsServiceServer()
+}
+
+// UnimplementedLogsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedLogsServiceServer struct {
+}
+
+func (UnimplementedLogsServiceServer) Logs(*LogsRequest, LogsService_LogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Logs not implemented")
+}
+func (UnimplementedLogsServiceServer) mustEmbedUnimplementedLogsServiceServer() {}
+
+// UnsafeLogsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LogsServiceServer will
+// result in compilation errors.
+type UnsafeLogsServiceServer interface {
+	mustEmbedUnimplementedLogsServiceServer()
+}
+
+func RegisterLogsServiceServer(s grpc.ServiceRegistrar, srv LogsServiceServer) {
+	s.RegisterService(&LogsService_ServiceDesc, srv)
+}
+
+func _LogsService_Logs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LogsServiceServer).Logs(m, &logsServiceLogsServer{stream})
+}
+
+type LogsService_LogsServer interface {
+	Send(*Log) error
+	grpc.ServerStream
+}
+
+type logsServiceLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *logsServiceLogsServer) Send(m *Log) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// LogsService_ServiceDesc is the grpc.ServiceDesc for LogsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LogsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "LogsService",
+	HandlerType: (*LogsServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Logs",
+			Handler:       _LogsService_Logs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "services.proto",
+}
+
+const (
+	TracesService_Traces_FullMethodName = "/TracesService/Traces"
+	TracesService_Count_FullMethodName  = "/TracesService/Count"
+)
+
+// TracesServiceClient is the client API for TracesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TracesServiceClient interface {
+	// chifra traces
+	Traces(ctx context.Context, in *TracesRequest, opts ...grpc.CallOption) (TracesService_TracesClient, error)
+	// chifra traces --count
+	Count(ctx context.Context, in *TracesRequest, opts ...grpc.CallOption) (TracesService_CountClient, error)
+}
+
+type tracesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTracesServiceClient(cc grpc.ClientConnInterface) TracesServiceClient {
+	return &tracesServiceClient{cc}
+}
+
+func (c *tracesServiceClient) Traces(ctx context.Context, in *TracesRequest, opts ...grpc.CallOption) (TracesService_TracesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TracesService_ServiceDesc.Streams[0], TracesService_Traces_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tracesServiceTracesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TracesService_TracesClient interface {
+	Recv() (*Trace, error)
+	grpc.ClientStream
+}
+
+type tracesServiceTracesClient struct {
+	grpc.ClientStream
+}
+
+func (x *tracesServiceTracesClient) Recv() (*Trace, error) {
+	m := new(Trace)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tracesServiceClient) Count(ctx context.Context, in *TracesRequest, opts ...grpc.CallOption) (TracesService_CountClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TracesService_ServiceDesc.Streams[1], TracesService_Count_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tracesServiceCountClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TracesService_CountClient interface {
+	Recv() (*TraceCount, error)
+	grpc.ClientStream
+}
+
+type tracesServiceCountClient struct {
+	grpc.ClientStream
+}
+
+func (x *tracesServiceCountClient) Recv() (*TraceCount, error) {
+	m := new(TraceCount)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TracesServiceServer is the server API for TracesService service.
+// All implementations must embed UnimplementedTracesServiceServer
+// for forward compatibility
+type TracesServiceServer interface {
+	// chifra traces
+	Traces(*TracesRequest, TracesService_TracesServer) error
+	// chifra traces --count
+	Count(*TracesRequest, TracesService_CountServer) error
+	mustEmbedUnimplementedTracesServiceServer()
+}
+
+// UnimplementedTracesServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTracesServiceServer struct {
+}
+
+func (UnimplementedTracesServiceServer) Traces(*TracesRequest, TracesService_TracesServer) error {
+	return status.Errorf(codes.Unimplemented, "method Traces not implemented")
+}
+func (UnimplementedTracesServiceServer) Count(*TracesRequest, TracesService_CountServer) error {
+	return status.Errorf(codes.Unimplemented, "method Count not implemented")
+}
+func (UnimplementedTracesServiceServer) mustEmbedUnimplementedTracesServiceServer() {}
+
+// UnsafeTracesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TracesServiceServer will
+// result in compilation errors.
+type UnsafeTracesServiceServer interface {
+	mustEmbedUnimplementedTracesServiceServer()
+}
+
+func RegisterTracesServiceServer(s grpc.ServiceRegistrar, srv TracesServiceServer) {
+	s.RegisterService(&TracesService_ServiceDesc, srv)
+}
+
+func _TracesService_Traces_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TracesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TracesServiceServer).Traces(m, &tracesServiceTracesServer{stream})
+}
+
+type TracesService_TracesServer interface {
+	Send(*Trace) error
+	grpc.ServerStream
+}
+
+type tracesServiceTracesServer struct {
+	grpc.ServerStream
+}
+
+func (x *tracesServiceTracesServer) Send(m *Trace) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TracesService_Count_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TracesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TracesServiceServer).Count(m, &tracesServiceCountServer{stream})
+}
+
+type TracesService_CountServer interface {
+	Send(*TraceCount) error
+	grpc.ServerStream
+}
+
+type tracesServiceCountServer struct {
+	grpc.ServerStream
+}
+
+func (x *tracesServiceCountServer) Send(m *TraceCount) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// TracesService_ServiceDesc is the grpc.ServiceDesc for TracesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TracesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "TracesService",
+	HandlerType: (*TracesServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Traces",
+			Handler:       _TracesService_Traces_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Count",
+			Handler:       _TracesService_Count_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "services.proto",
+}
+
+const (
+	WhenService_When_FullMethodName       = "/WhenService/When"
+	WhenService_List_FullMethodName       = "/WhenService/List"
+	WhenService_Timestamps_FullMethodName = "/WhenService/Timestamps"
+	WhenService_Count_FullMethodName      = "/WhenService/Count"
+)
+
+// WhenServiceClient is the client API for WhenService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WhenServiceClient interface {
+	// chifra when
+	When(ctx context.Context, in *WhenRequest, opts ...grpc.CallOption) (WhenService_WhenClient, error)
+	// chifra when --list
+	List(ctx context.Context, in *WhenRequest, opts ...grpc.CallOption) (WhenService_ListClient, error)
+	// chifra when --timestamps
+	Timestamps(ctx context.Context, in *WhenRequest, opts ...grpc.CallOption) (WhenService_TimestampsClient, error)
+	// chifra when --count
+	Count(ctx context.Context, in *WhenRequest, opts ...grpc.CallOption) (WhenService_CountClient, error)
+}
+
+type whenServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWhenServiceClient(cc grpc.ClientConnInterface) WhenServiceClient {
+	return &whenServiceClient{cc}
+}
+
+func (c *whenServiceClient) When(ctx context.Context, in *WhenRequest, opts ...grpc.CallOption) (WhenService_WhenClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WhenService_ServiceDesc.Streams[0], WhenService_When_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &whenServiceWhenClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WhenService_WhenClient interface {
+	Recv() (*NamedBlock, error)
+	grpc.ClientStream
+}
+
+type whenServiceWhenClient struct {
+	grpc.ClientStream
+}
+
+func (x *whenServiceWhenClient) Recv() (*NamedBlock, error) {
+	m := new(NamedBlock)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *whenServiceClient) List(ctx context.Context, in *WhenRequest, opts ...grpc.CallOption) (WhenService_ListClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WhenService_ServiceDesc.Streams[1], WhenService_List_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &whenServiceListClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WhenService_ListClient interface {
+	Recv() (*NamedBlock, error)
+	grpc.ClientStream
+}
+
+type whenServiceListClient struct {
+	grpc.ClientStream
+}
+
+func (x *whenServiceListClient) Recv() (*NamedBlock, error) {
+	m := new(NamedBlock)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *whenServiceClient) Timestamps(ctx context.Context, in *WhenRequest, opts ...grpc.CallOption) (WhenService_TimestampsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WhenService_ServiceDesc.Streams[2], WhenService_Timestamps_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &whenServiceTimestampsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WhenService_TimestampsClient interface {
+	Recv() (*Timestamp, error)
+	grpc.ClientStream
+}
+
+type whenServiceTimestampsClient struct {
+	grpc.ClientStream
+}
+
+func (x *whenServiceTimestampsClient) Recv() (*Timestamp, error) {
+	m := new(Timestamp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *whenServiceClient) Count(ctx context.Context, in *WhenRequest, opts ...grpc.CallOption) (WhenService_CountClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WhenService_ServiceDesc.Streams[3], WhenService_Count_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &whenServiceCountClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WhenService_CountClient interface {
+	Recv() (*TimestampCount, error)
+	grpc.ClientStream
+}
+
+type whenServiceCountClient struct {
+	grpc.ClientStream
+}
+
+func (x *whenServiceCountClient) Recv() (*TimestampCount, error) {
+	m := new(TimestampCount)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WhenServiceServer is the server API for WhenService service.
+// All implementations must embed UnimplementedWhenServiceServer
+// for forward compatibility
+type WhenServiceServer interface {
+	// chifra when
+	When(*WhenRequest, WhenService_WhenServer) error
+	// chifra when --list
+	List(*WhenRequest, WhenService_ListServer) error
+	// chifra when --timestamps
+	Timestamps(*WhenRequest, WhenService_TimestampsServer) error
+	// chifra when --count
+	Count(*WhenRequest, WhenService_CountServer) error
+	mustEmbedUnimplementedWhenServiceServer()
+}
+
+// UnimplementedWhenServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWhenServiceServer struct {
+}
+
+func (UnimplementedWhenServiceServer) When(*WhenRequest, WhenService_WhenServer) error {
+	return status.Errorf(codes.Unimplemented, "method When not implemented")
+}
+func (UnimplementedWhenServiceServer) List(*WhenRequest, WhenService_ListServer) error {
+	return status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedWhenServiceServer) Timestamps(*WhenRequest, WhenService_TimestampsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Timestamps not implemented")
+}
+func (UnimplementedWhenServiceServer) Count(*WhenRequest, WhenService_CountServer) error {
+	return status.Errorf(codes.Unimplemented, "method Count not implemented")
+}
+func (UnimplementedWhenServiceServer) mustEmbedUnimplementedWhenServiceServer() {}
+
+// UnsafeWhenServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WhenServiceServer will
+// result in compilation errors.
+type UnsafeWhenServiceServer interface {
+	mustEmbedUnimplementedWhenServiceServer()
+}
+
+func RegisterWhenServiceServer(s grpc.ServiceRegistrar, srv WhenServiceServer) {
+	s.RegisterService(&WhenService_ServiceDesc, srv)
+}
+
+func _WhenService_When_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WhenRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WhenServiceServer).When(m, &whenServiceWhenServer{stream})
+}
+
+type WhenService_WhenServer interface {
+	Send(*NamedBlock) error
+	grpc.ServerStream
+}
+
+type whenServiceWhenServer struct {
+	grpc.ServerStream
+}
+
+func (x *whenServiceWhenServer) Send(m *NamedBlock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WhenService_List_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WhenRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WhenServiceServer).List(m, &whenServiceListServer{stream})
+}
+
+type WhenService_ListServer interface {
+	Send(*NamedBlock) error
+	grpc.ServerStream
+}
+
+type whenServiceListServer struct {
+	grpc.ServerStream
+}
+
+func (x *whenServiceListServer) Send(m *NamedBlock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WhenService_Timestamps_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WhenRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WhenServiceServer).Timestamps(m, &whenServiceTimestampsServer{stream})
+}
+
+type WhenService_TimestampsServer interface {
+	Send(*Timestamp) error
+	grpc.ServerStream
+}
+
+type whenServiceTimestampsServer struct {
+	grpc.ServerStream
+}
+
+func (x *whenServiceTimestampsServer) Send(m *Timestamp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WhenService_Count_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WhenRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WhenServiceServer).Count(m, &whenServiceCountServer{stream})
+}
+
+type WhenService_CountServer interface {
+	Send(*TimestampCount) error
+	grpc.ServerStream
+}
+
+type whenServiceCountServer struct {
+	grpc.ServerStream
+}
+
+func (x *whenServiceCountServer) Send(m *TimestampCount) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// WhenService_ServiceDesc is the grpc.ServiceDesc for WhenService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WhenService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "WhenService",
+	HandlerType: (*WhenServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "When",
+			Handler:       _WhenService_When_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "List",
+			Handler:       _WhenService_List_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Timestamps",
+			Handler:       _WhenService_Timestamps_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Count",
+			Handler:       _WhenService_Count_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "services.proto",
+}
+
+const (
+	StateService_State_FullMethodName = "/StateService/State"
+	StateService_Call_FullMethodName  = "/StateService/Call"
+)
+
+// StateServiceClient is the client API for StateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StateServiceClient interface {
+	// chifra state
+	State(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (StateService_StateClient, error)
+	// chifra state --call
+	Call(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (StateService_CallClient, error)
+}
+
+type stateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStateServiceClient(cc grpc.ClientConnInterface) StateServiceClient {
+	return &stateServiceClient{cc}
+}
+
+func (c *stateServiceClient) State(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (StateService_StateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StateService_ServiceDesc.Streams[0], StateService_State_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stateServiceStateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StateService_StateClient interface {
+	Recv() (*State, error)
+	grpc.ClientStream
+}
+
+type stateServiceStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *stateServiceStateClient) Recv() (*State, error) {
+	m := new(State)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *stateServiceClient) Call(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (StateService_CallClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StateService_ServiceDesc.Streams[1], StateService_Call_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stateServiceCallClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StateService_CallClient interface {
+	Recv() (*Result, error)
+	grpc.ClientStream
+}
+
+type stateServiceCallClient struct {
+	grpc.ClientStream
+}
+
+func (x *stateServiceCallClient) Recv() (*Result, error) {
+	m := new(Result)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StateServiceServer is the server API for StateService service.
+// All implementations must embed UnimplementedStateServiceServer
+// for forward compatibility
+type StateServiceServer interface {
+	// chifra state
+	State(*StateRequest, StateService_StateServer) error
+	// chifra state --call
+	Call(*StateRequest, StateService_CallServer) error
+	mustEmbedUnimplementedStateServiceServer()
+}
+
+// UnimplementedStateServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedStateServiceServer struct {
+}
+
+func (UnimplementedStateServiceServer) State(*StateRequest, StateService_StateServer) error {
+	return status.Errorf(codes.Unimplemented, "method State not implemented")
+}
+func (UnimplementedStateServiceServer) Call(*StateRequest, StateService_CallServer) error {
+	return status.Errorf(codes.Unimplemented, "method Call not implemented")
+}
+func (UnimplementedStateServiceServer) mustEmbedUnimplementedStateServiceServer() {}
+
+// UnsafeStateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StateServiceServer will
+// result in compilation errors.
+type UnsafeStateServiceServer interface {
+	mustEmbedUnimplementedStateServiceServer()
+}
+
+func RegisterStateServiceServer(s grpc.ServiceRegistrar, srv StateServiceServer) {
+	s.RegisterService(&StateService_ServiceDesc, srv)
+}
+
+func _StateService_State_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StateServiceServer).State(m, &stateServiceStateServer{stream})
+}
+
+type StateService_StateServer interface {
+	Send(*State) error
+	grpc.ServerStream
+}
+
+type stateServiceStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *stateServiceStateServer) Send(m *State) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _StateService_Call_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StateServiceServer).Call(m, &stateServiceCallServer{stream})
+}
+
+type StateService_CallServer interface {
+	Send(*Result) error
+	grpc.ServerStream
+}
+
+type stateServiceCallServer struct {
+	grpc.ServerStream
+}
+
+func (x *stateServiceCallServer) Send(m *Result) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// StateService_ServiceDesc is the grpc.ServiceDesc for StateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "StateService",
+	HandlerType: (*StateServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "State",
+			Handler:       _StateService_State_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Call",
+			Handler:       _StateService_Call_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "services.proto",
+}
+
+const (
+	TokensService_Tokens_FullMethodName = "/TokensService/Tokens"
+)
+
+// TokensServiceClient is the client API for TokensService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TokensServiceClient interface {
+	// chifra tokens
+	Tokens(ctx context.Context, in *TokensRequest, opts ...grpc.CallOption) (TokensService_TokensClient, error)
+}
+
+type tokensServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTokensServiceClient(cc grpc.ClientConnInterface) TokensServiceClient {
+	return &tokensServiceClient{cc}
+}
+
+func (c *tokensServiceClient) Tokens(ctx context.Context, in *TokensRequest, opts ...grpc.CallOption) (TokensService_TokensClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TokensService_ServiceDesc.Streams[0], TokensService_Tokens_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tokensServiceTokensClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TokensService_TokensClient interface {
+	Recv() (*Token, error)
+	grpc.ClientStream
+}
+
+type tokensServiceTokensClient struct {
+	grpc.ClientStream
+}
+
+func (x *tokensServiceTokensClient) Recv() (*Token, error) {
+	m := new(Token)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TokensServiceServer is the server API for TokensService service.
+// All implementations must embed UnimplementedTokensServiceServer
+// for forward compatibility
+type TokensServiceServer interface {
+	// chifra tokens
+	Tokens(*TokensRequest, TokensService_TokensServer) error
+	mustEmbedUnimplementedTokensServiceServer()
+}
+
+// UnimplementedTokensServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTokensServiceServer struct {
+}
+
+func (UnimplementedTokensServiceServer) Tokens(*TokensRequest, TokensService_TokensServer) error {
+	return status.Errorf(codes.Unimplemented, "method Tokens not implemented")
+}
+func (UnimplementedTokensServiceServer) mustEmbedUnimplementedTokensServiceServer() {}
+
+// UnsafeTokensServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TokensServiceServer will
+// result in compilation errors.
+type UnsafeTokensServiceServer interface {
+	mustEmbedUnimplementedTokensServiceServer()
+}
+
+func RegisterTokensServiceServer(s grpc.ServiceRegistrar, srv TokensServiceServer) {
+	s.RegisterService(&TokensService_ServiceDesc, srv)
+}
+
+func _TokensService_Tokens_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TokensRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TokensServiceServer).Tokens(m, &tokensServiceTokensServer{stream})
+}
+
+type TokensService_TokensServer interface {
+	Send(*Token) error
+	grpc.ServerStream
+}
+
+type tokensServiceTokensServer struct {
+	grpc.ServerStream
+}
+
+func (x *tokensServiceTokensServer) Send(m *Token) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// TokensService_ServiceDesc is the grpc.ServiceDesc for TokensService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TokensService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "TokensService",
+	HandlerType: (*TokensServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Tokens",
+			Handler:       _TokensService_Tokens_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "services.proto",
+}
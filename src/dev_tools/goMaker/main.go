@@ -29,6 +29,8 @@ var cbTemplates = []types.Generator{
 			"src_dev+tools_goMaker_generated_readme+chifra.md.tmpl",
 			// "src_dev+tools_goMaker_generated_handlers.csv.tmpl",
 			"src_apps_chifra_pkg_types_modeler.go.tmpl",
+			"src_apps_chifra_proto_services.proto.tmpl",
+			"src_apps_chifra_internal_daemon_grpc+services.go.tmpl",
 		},
 	},
 	{
@@ -0,0 +1,286 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
+)
+
+// The gRPC services (src/apps/chifra/proto/services.proto) are generated from the same data as
+// the rest of the code. A command provides a service if its command row carries the `grpc`
+// attribute. Each of its endpoints (as for the SDK, options with a return_type) becomes a
+// streaming rpc returning the endpoint's data model. Field numbers follow the order of the
+// options and the order of the rows in the model's csv file, so new rows should be added at the
+// end of those files.
+
+// GrpcEndpoint is a single rpc of a command's gRPC service
+type GrpcEndpoint struct {
+	Name     string
+	Route    string
+	Returns  string
+	Switches []string
+	Required string
+	Comment  string
+}
+
+// grpcImplied lists, for an endpoint's switch, the switches it is only valid with. The rpc sets
+// them along with its own switch, so callers need not (and, for switches with their own
+// endpoint, cannot) set them in the request.
+var grpcImplied = map[string][]string{
+	"export.statements": {"accounting"},
+	"export.lots":       {"statements", "accounting"},
+}
+
+// SwitchList returns the Go expression for the switches the rpc sets
+func (ep GrpcEndpoint) SwitchList() string {
+	if len(ep.Switches) == 0 {
+		return "nil"
+	}
+	return `[]string{"` + strings.Join(ep.Switches, `", "`) + `"}`
+}
+
+// GrpcField is a single field of a generated proto message
+type GrpcField struct {
+	Name     string
+	Type     string
+	Num      int
+	Repeated bool
+	Optional bool
+}
+
+// String returns the field's declaration
+func (f GrpcField) String() string {
+	ret := f.Type + " " + f.Name + " = " + fmt.Sprint(f.Num) + ";"
+	if f.Repeated {
+		ret = "repeated " + ret
+	} else if f.Optional {
+		ret = "optional " + ret
+	}
+	return ret
+}
+
+// GoName returns the name protoc-gen-go gives to the field
+func (f GrpcField) GoName() string {
+	return FirstUpper(f.Name)
+}
+
+// IsGrpc returns true if the command provides a gRPC service
+func (c *Command) IsGrpc() bool {
+	return strings.Contains(c.Attributes, "grpc")
+}
+
+// GrpcService returns the name of the command's gRPC service
+func (c *Command) GrpcService() string {
+	return Proper(c.Route) + "Service"
+}
+
+// GrpcRequest returns the name of the message carrying the command's options
+func (c *Command) GrpcRequest() string {
+	return Proper(c.Route) + "Request"
+}
+
+// GrpcRequestFields returns the fields of the command's request message. The endpoints'
+// switches are not included as they are chosen by the rpc.
+func (c *Command) GrpcRequestFields() []GrpcField {
+	ret := []GrpcField{{Name: "chain", Type: "string", Num: 1}}
+	for _, op := range c.Options {
+		if op.IsMode() {
+			logger.Fatal("gRPC services do not support mode options:", c.Route)
+		}
+		if !op.IsGrpcField() {
+			continue
+		}
+		ret = append(ret, GrpcField{
+			Name:     CamelCase(op.LongName),
+			Type:     op.ProtoType(),
+			Num:      len(ret) + 1,
+			Repeated: op.IsArray(),
+		})
+	}
+	if strings.Contains(c.Capabilities, "caching") {
+		ret = append(ret, GrpcField{Name: "cache", Type: "bool", Num: len(ret) + 1})
+	}
+	return ret
+}
+
+// GrpcEndpoints returns the rpcs of the command's gRPC service
+func (c *Command) GrpcEndpoints() []GrpcEndpoint {
+	ret := []GrpcEndpoint{}
+	for _, op := range c.Options {
+		if len(op.ReturnType) == 0 || op.IsDeprecated() {
+			continue
+		}
+		ep := GrpcEndpoint{
+			Name:    op.GoName,
+			Route:   c.Route,
+			Returns: FirstUpper(op.ReturnType),
+			Comment: "chifra " + c.Route + " --" + op.LongName,
+		}
+		if op.IsPositional() {
+			ep.Name = Proper(c.Route)
+			ep.Comment = "chifra " + c.Route
+		} else if op.IsBool() {
+			ep.Switches = append([]string{CamelCase(op.LongName)}, grpcImplied[c.Route+"."+op.LongName]...)
+		} else {
+			ep.Required = CamelCase(op.LongName)
+		}
+		ret = append(ret, ep)
+	}
+	return ret
+}
+
+// IsGrpcField returns true if the option is carried in its command's gRPC request
+func (op *Option) IsGrpcField() bool {
+	if op.OptionType != "positional" && !op.IsFlag() {
+		return false
+	}
+	if op.IsApiHidden() || op.IsDeprecated() || op.IsConfig() {
+		return false
+	}
+	return !(op.IsBool() && len(op.ReturnType) > 0)
+}
+
+// ProtoType returns the type of the option's field in its command's gRPC request
+func (op *Option) ProtoType() string {
+	switch {
+	case op.IsArray() || op.IsEnum() || op.IsStringLike():
+		return "string"
+	case op.IsBool():
+		return "bool"
+	case op.DataType == "<uint64>" || op.DataType == "<blknum>":
+		return "uint64"
+	case op.IsFloat():
+		return "double"
+	}
+	logger.Fatal(fmt.Sprintf("unknown data type '%s' for gRPC option '%s'", op.DataType, op.LongName))
+	return ""
+}
+
+// GrpcCommands returns the commands that provide a gRPC service
+func (cb *CodeBase) GrpcCommands() []Command {
+	ret := []Command{}
+	for _, c := range cb.Commands {
+		if c.IsGrpc() {
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}
+
+// GrpcStructures returns the data models returned by the gRPC services along with the models
+// they contain, sorted by name
+func (cb *CodeBase) GrpcStructures() []Structure {
+	byClass := make(map[string]*Structure, len(cb.Structures))
+	for i := 0; i < len(cb.Structures); i++ {
+		byClass[cb.Structures[i].Class] = &cb.Structures[i]
+	}
+
+	found := make(map[string]bool)
+	var visit func(class string)
+	visit = func(class string) {
+		if found[class] || byClass[class] == nil {
+			return
+		}
+		found[class] = true
+		for _, m := range byClass[class].Members {
+			if m.IsProtoField() && m.IsObject() {
+				visit(m.Type)
+			}
+		}
+	}
+	for _, c := range cb.GrpcCommands() {
+		for _, ep := range c.GrpcEndpoints() {
+			if byClass[ep.Returns] == nil {
+				logger.Fatal("unknown return type for gRPC endpoint:", ep.Returns)
+			}
+			visit(ep.Returns)
+		}
+	}
+
+	ret := make([]Structure, 0, len(found))
+	for class := range found {
+		ret = append(ret, *byClass[class])
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Class < ret[j].Class
+	})
+	return ret
+}
+
+// ProtoFields returns the fields of the model's proto message, numbered by their row in the csv file
+func (s *Structure) ProtoFields() []GrpcField {
+	members := append([]Member{}, s.Members...)
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Num < members[j].Num
+	})
+
+	ret := []GrpcField{}
+	for _, m := range members {
+		if m.IsProtoField() {
+			ret = append(ret, GrpcField{
+				Name:     m.Name,
+				Type:     m.ProtoType(),
+				Num:      m.Num,
+				Repeated: m.IsArray,
+				Optional: m.IsOmitEmpty() && !m.IsArray && !m.IsObject() && m.GoType() != "any",
+			})
+		}
+	}
+	return ret
+}
+
+// ProtoReserved returns the field numbers of rows that are not carried in the model's proto message
+func (s *Structure) ProtoReserved() string {
+	nums := []int{}
+	for _, m := range s.Members {
+		if !m.IsProtoField() {
+			nums = append(nums, m.Num)
+		}
+	}
+	sort.Ints(nums)
+
+	ret := make([]string, 0, len(nums))
+	for _, num := range nums {
+		ret = append(ret, fmt.Sprint(num))
+	}
+	return strings.Join(ret, ", ")
+}
+
+// IsProtoField returns true if the member is carried in its model's proto message. These are the
+// members that appear when the Go type is marshalled to JSON.
+func (m *Member) IsProtoField() bool {
+	return m.IsSimpField() && !m.IsNoTag()
+}
+
+// ProtoType returns the type of the member's field in its model's proto message
+func (m *Member) ProtoType() string {
+	if m.GoType() == "any" || m.GoType() == "[]any" {
+		return "google.protobuf.Value"
+	}
+	if m.IsObject() {
+		if strings.HasPrefix(m.TypeToGroup(m.Type), "unknown") {
+			// enumerations such as TokenType are marshalled as their underlying integer
+			return "int64"
+		}
+		return m.Type
+	}
+	switch m.Type {
+	case "address", "blkrange", "bytes", "datetime", "ether", "hash", "int256", "ipfshash",
+		"string", "topic", "uint256", "wei":
+		return "string"
+	case "blknum", "gas", "lognum", "txnum", "uint64", "value":
+		return "uint64"
+	case "int64", "timestamp":
+		return "int64"
+	case "uint32":
+		return "uint32"
+	case "float", "float64":
+		return "double"
+	case "bool":
+		return "bool"
+	}
+	logger.Fatal(fmt.Sprintf("unknown type '%s' in Member '%s'\n", m.Type, m.Name))
+	return ""
+}
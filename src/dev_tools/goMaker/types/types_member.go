@@ -371,7 +371,16 @@ func (m *Member) UnmarshalCode() string {
 	code := m.executeTemplate(tmplName, tmpl)
 
 	if m.HasUpgrade() {
-		if wasRemoved {
+		if wasAdded := strings.HasSuffix(m.Upgrades, ":none"); wasAdded {
+			tmplName = "upgradeAdded"
+			tmpl = `	// Added after version ++VERS++
+	v{{.GoName}} := version.NewVersion("++VERS++")
+	if vers > v{{.GoName}}.Uint64() {
+		++CODE++
+	}
+
+`
+		} else if wasRemoved {
 			tmplName = "upgrageRemoved"
 			tmpl = `	// Used to be {{.GoName}}, since removed
 	v{{.GoName}} := version.NewVersion("++VERS++")
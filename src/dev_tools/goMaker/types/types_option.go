@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"go/token"
 	"strings"
 
 	"github.com/TrueBlocks/trueblocks-core/src/apps/chifra/pkg/logger"
@@ -893,19 +894,35 @@ func (opts *{{firstUpper .Route}}Options) {{firstUpper .Route}}{{.GoName}}({{.To
 {{if not .IsPositional}}	in.{{.AssignReceive}} = {{.ToolAssignment}}
 {{end}}	return query{{firstUpper .Route}}[{{.SdkCoreType}}](in)
 }
+
+// {{firstUpper .Route}}{{.GoName}}Stream is like {{firstUpper .Route}}{{.GoName}}, but delivers each item as it is produced.
+func (opts *{{firstUpper .Route}}Options) {{firstUpper .Route}}{{.GoName}}Stream(ctx context.Context{{if .ToolParameters false}}, {{.ToolParameters false}}{{end}}) Seq2[{{.SdkCoreType}}] {
+	in := opts.toInternal()
+{{if not .IsPositional}}	in.{{.AssignReceive}} = {{.ToolAssignment}}
+{{end}}	return stream{{firstUpper .Route}}[{{.SdkCoreType}}](ctx, in)
+}
 `
 
 var fuzzerSwitch = `	case "{{.Tool}}":
-if {{.Tool}}, _, err := opts.{{firstUpper .Route}}{{.GoName}}({{.ToolParameters true}}); err != nil {
+if {{.ToolVar}}, _, err := opts.{{firstUpper .Route}}{{.GoName}}({{.ToolParameters true}}); err != nil {
 	ReportError(fn, opts, err)
 } else {
-	if err := SaveToFile[{{.SdkCoreType}}](fn, {{.Tool}}); err != nil {
+	if err := SaveToFile[{{.SdkCoreType}}](fn, {{.ToolVar}}); err != nil {
 		ReportError2(fn, err)
 	} else {
 		ReportOkay(fn)
 	}
 }`
 
+// ToolVar for tag {{.ToolVar}} is the name of the variable holding the tool's results
+func (op *Option) ToolVar() string {
+	if token.IsKeyword(op.Tool) {
+		// import (for example) is a GoLang reserved word
+		return op.Tool + "Val"
+	}
+	return op.Tool
+}
+
 func (op *Option) FuzzerSwitch() string {
 	tmplName := "fuzzerSwitch"
 	tmpl := fuzzerSwitch
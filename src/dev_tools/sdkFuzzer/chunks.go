@@ -181,6 +181,16 @@ func TestChunks(which, value, fn string, opts *sdk.ChunksOptions) {
 				ReportOkay(fn)
 			}
 		}
+	case "shard":
+		if shard, _, err := opts.ChunksShard(); err != nil {
+			ReportError(fn, opts, err)
+		} else {
+			if err := SaveToFile[types.Message](fn, shard); err != nil {
+				ReportError2(fn, err)
+			} else {
+				ReportOkay(fn)
+			}
+		}
 	case "diff":
 		if diff, _, err := opts.ChunksDiff(); err != nil {
 			ReportError(fn, opts, err)
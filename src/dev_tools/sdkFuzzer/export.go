@@ -30,6 +30,7 @@ func DoExport() {
 	topics := fuzzTopics
 	fourbytes := fuzzFourbytes
 	accounting := []bool{false, true}
+	// Option 'basis.enum' is an emum
 	articulate := []bool{false, true}
 	cacheTraces := []bool{false, true}
 	relevant := []bool{false, true}
@@ -164,6 +165,16 @@ func TestExport(which, value, fn string, opts *sdk.ExportOptions) {
 				ReportOkay(fn)
 			}
 		}
+	case "lots":
+		if lots, _, err := opts.ExportLots(); err != nil {
+			ReportError(fn, opts, err)
+		} else {
+			if err := SaveToFile[types.Disposal](fn, lots); err != nil {
+				ReportError2(fn, err)
+			} else {
+				ReportOkay(fn)
+			}
+		}
 	case "balances":
 		if balances, _, err := opts.ExportBalances(); err != nil {
 			ReportError(fn, opts, err)
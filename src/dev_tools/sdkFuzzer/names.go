@@ -35,9 +35,12 @@ func DoNames() {
 	prefund := []bool{false, true}
 	regular := []bool{false, true}
 	dryRun := []bool{false, true}
+	neighbors := []bool{false, true}
+	// Option 'merge.enum' is an emum
 	// Fuzz Loop
 	// EXISTING_CODE
 	_ = dryRun
+	_ = neighbors
 	opts = sdk.NamesOptions{
 		Terms: []string{"0xf"},
 	}
@@ -145,6 +148,16 @@ func TestNames(which, value, fn string, opts *sdk.NamesOptions) {
 				ReportOkay(fn)
 			}
 		}
+	case "autonamelist":
+		if autonamelist, _, err := opts.NamesAutonameList(value); err != nil {
+			ReportError(fn, opts, err)
+		} else {
+			if err := SaveToFile[types.Message](fn, autonamelist); err != nil {
+				ReportError2(fn, err)
+			} else {
+				ReportOkay(fn)
+			}
+		}
 	case "create":
 		if create, _, err := opts.NamesCreate(); err != nil {
 			ReportError(fn, opts, err)
@@ -195,6 +208,26 @@ func TestNames(which, value, fn string, opts *sdk.NamesOptions) {
 				ReportOkay(fn)
 			}
 		}
+	case "import":
+		if importVal, _, err := opts.NamesImport(value); err != nil {
+			ReportError(fn, opts, err)
+		} else {
+			if err := SaveToFile[types.Message](fn, importVal); err != nil {
+				ReportError2(fn, err)
+			} else {
+				ReportOkay(fn)
+			}
+		}
+	case "export":
+		if export, _, err := opts.NamesExport(value); err != nil {
+			ReportError(fn, opts, err)
+		} else {
+			if err := SaveToFile[types.Message](fn, export); err != nil {
+				ReportError2(fn, err)
+			} else {
+				ReportOkay(fn)
+			}
+		}
 	default:
 		ReportError(fn, opts, fmt.Errorf("unknown which: %s", which))
 		logger.Fatal("Quitting...")
@@ -64,6 +64,7 @@ func DoStatus() {
 					TestStatus("traces", "", fn, &opts)
 					TestStatus("logs", "", fn, &opts)
 					TestStatus("statements", "", fn, &opts)
+					TestStatus("prices", "", fn, &opts)
 					TestStatus("results", "", fn, &opts)
 					TestStatus("state", "", fn, &opts)
 					TestStatus("tokens", "", fn, &opts)
@@ -260,6 +261,16 @@ func TestStatus(which, value, fn string, opts *sdk.StatusOptions) {
 				ReportOkay(fn)
 			}
 		}
+	case "prices":
+		if prices, _, err := opts.StatusPrices(); err != nil {
+			ReportError(fn, opts, err)
+		} else {
+			if err := SaveToFile[types.Status](fn, prices); err != nil {
+				ReportError2(fn, err)
+			} else {
+				ReportOkay(fn)
+			}
+		}
 	case "some":
 		if some, _, err := opts.StatusSome(); err != nil {
 			ReportError(fn, opts, err)